@@ -343,6 +343,10 @@ func (m *RequestEvent) Validate() error {
 
 	}
 
+	// no validation rules for RequestId
+
+	// no validation rules for TokenId
+
 	return nil
 }
 
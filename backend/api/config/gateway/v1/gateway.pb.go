@@ -11,6 +11,7 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	any "github.com/golang/protobuf/ptypes/any"
 	duration "github.com/golang/protobuf/ptypes/duration"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -28,6 +29,52 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type Stats_PrometheusReporter_TimerType int32
+
+const (
+	Stats_PrometheusReporter_SUMMARY   Stats_PrometheusReporter_TimerType = 0
+	Stats_PrometheusReporter_HISTOGRAM Stats_PrometheusReporter_TimerType = 1
+)
+
+// Enum value maps for Stats_PrometheusReporter_TimerType.
+var (
+	Stats_PrometheusReporter_TimerType_name = map[int32]string{
+		0: "SUMMARY",
+		1: "HISTOGRAM",
+	}
+	Stats_PrometheusReporter_TimerType_value = map[string]int32{
+		"SUMMARY":   0,
+		"HISTOGRAM": 1,
+	}
+)
+
+func (x Stats_PrometheusReporter_TimerType) Enum() *Stats_PrometheusReporter_TimerType {
+	p := new(Stats_PrometheusReporter_TimerType)
+	*p = x
+	return p
+}
+
+func (x Stats_PrometheusReporter_TimerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Stats_PrometheusReporter_TimerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_gateway_v1_gateway_proto_enumTypes[0].Descriptor()
+}
+
+func (Stats_PrometheusReporter_TimerType) Type() protoreflect.EnumType {
+	return &file_config_gateway_v1_gateway_proto_enumTypes[0]
+}
+
+func (x Stats_PrometheusReporter_TimerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Stats_PrometheusReporter_TimerType.Descriptor instead.
+func (Stats_PrometheusReporter_TimerType) EnumDescriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{6, 2, 0}
+}
+
 type Logger_Level int32
 
 const (
@@ -73,11 +120,11 @@ func (x Logger_Level) String() string {
 }
 
 func (Logger_Level) Descriptor() protoreflect.EnumDescriptor {
-	return file_config_gateway_v1_gateway_proto_enumTypes[0].Descriptor()
+	return file_config_gateway_v1_gateway_proto_enumTypes[1].Descriptor()
 }
 
 func (Logger_Level) Type() protoreflect.EnumType {
-	return &file_config_gateway_v1_gateway_proto_enumTypes[0]
+	return &file_config_gateway_v1_gateway_proto_enumTypes[1]
 }
 
 func (x Logger_Level) Number() protoreflect.EnumNumber {
@@ -86,7 +133,7 @@ func (x Logger_Level) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Logger_Level.Descriptor instead.
 func (Logger_Level) EnumDescriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{6, 0}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{12, 0}
 }
 
 type Config struct {
@@ -98,6 +145,12 @@ type Config struct {
 	Services  []*Service      `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
 	Resolvers []*Resolver     `protobuf:"bytes,3,rep,name=resolvers,proto3" json:"resolvers,omitempty"`
 	Modules   []*Module       `protobuf:"bytes,4,rep,name=modules,proto3" json:"modules,omitempty"`
+	// Configuration files that this file is layered on top of, merged in order before this file. Relative paths are
+	// resolved from the directory of this file. See the configuration guide for how files are merged.
+	Includes []string `protobuf:"bytes,5,rep,name=includes,proto3" json:"includes,omitempty"`
+	// Providers for resolving secret references of the form ${secret:<provider>:<key>} in string values. A provider named
+	// "file" that reads secrets from absolute paths is always available.
+	SecretProviders []*SecretProvider `protobuf:"bytes,6,rep,name=secret_providers,json=secretProviders,proto3" json:"secret_providers,omitempty"`
 }
 
 func (x *Config) Reset() {
@@ -160,6 +213,206 @@ func (x *Config) GetModules() []*Module {
 	return nil
 }
 
+func (x *Config) GetIncludes() []string {
+	if x != nil {
+		return x.Includes
+	}
+	return nil
+}
+
+func (x *Config) GetSecretProviders() []*SecretProvider {
+	if x != nil {
+		return x.SecretProviders
+	}
+	return nil
+}
+
+type SecretProvider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name used to refer to the provider in secret references.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Type:
+	//	*SecretProvider_File_
+	//	*SecretProvider_Exec_
+	Type isSecretProvider_Type `protobuf_oneof:"type"`
+}
+
+func (x *SecretProvider) Reset() {
+	*x = SecretProvider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretProvider) ProtoMessage() {}
+
+func (x *SecretProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretProvider.ProtoReflect.Descriptor instead.
+func (*SecretProvider) Descriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SecretProvider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *SecretProvider) GetType() isSecretProvider_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *SecretProvider) GetFile() *SecretProvider_File {
+	if x, ok := x.GetType().(*SecretProvider_File_); ok {
+		return x.File
+	}
+	return nil
+}
+
+func (x *SecretProvider) GetExec() *SecretProvider_Exec {
+	if x, ok := x.GetType().(*SecretProvider_Exec_); ok {
+		return x.Exec
+	}
+	return nil
+}
+
+type isSecretProvider_Type interface {
+	isSecretProvider_Type()
+}
+
+type SecretProvider_File_ struct {
+	File *SecretProvider_File `protobuf:"bytes,2,opt,name=file,proto3,oneof"`
+}
+
+type SecretProvider_Exec_ struct {
+	Exec *SecretProvider_Exec `protobuf:"bytes,3,opt,name=exec,proto3,oneof"`
+}
+
+func (*SecretProvider_File_) isSecretProvider_Type() {}
+
+func (*SecretProvider_Exec_) isSecretProvider_Type() {}
+
+type TLS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path to the PEM-encoded certificate chain presented by the listener.
+	CertFile string `protobuf:"bytes,1,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
+	// Path to the PEM-encoded private key for the certificate.
+	KeyFile string `protobuf:"bytes,2,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	// Path to a PEM-encoded bundle of certificate authorities. If set, clients are required to present a certificate
+	// signed by one of these authorities (i.e. mutual TLS).
+	ClientCaFile string `protobuf:"bytes,3,opt,name=client_ca_file,json=clientCaFile,proto3" json:"client_ca_file,omitempty"`
+	// How often the files above are checked for changes so that rotated certificates are picked up without a restart.
+	// If not specified, defaults to one minute.
+	ReloadInterval *duration.Duration `protobuf:"bytes,4,opt,name=reload_interval,json=reloadInterval,proto3" json:"reload_interval,omitempty"`
+	// Paths to the PEM-encoded certificate chain and private key that the gateway presents when connecting to its own
+	// listener, e.g. for the JSON gateway, if `client_ca_file` is set. The certificate must be signed by one of the
+	// client certificate authorities and allow client authentication. If not specified, the listener's certificate is
+	// presented, which requires it to allow client authentication in addition to server authentication.
+	ClientCertFile string `protobuf:"bytes,5,opt,name=client_cert_file,json=clientCertFile,proto3" json:"client_cert_file,omitempty"`
+	ClientKeyFile  string `protobuf:"bytes,6,opt,name=client_key_file,json=clientKeyFile,proto3" json:"client_key_file,omitempty"`
+}
+
+func (x *TLS) Reset() {
+	*x = TLS{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLS) ProtoMessage() {}
+
+func (x *TLS) ProtoReflect() protoreflect.Message {
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLS.ProtoReflect.Descriptor instead.
+func (*TLS) Descriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TLS) GetCertFile() string {
+	if x != nil {
+		return x.CertFile
+	}
+	return ""
+}
+
+func (x *TLS) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *TLS) GetClientCaFile() string {
+	if x != nil {
+		return x.ClientCaFile
+	}
+	return ""
+}
+
+func (x *TLS) GetReloadInterval() *duration.Duration {
+	if x != nil {
+		return x.ReloadInterval
+	}
+	return nil
+}
+
+func (x *TLS) GetClientCertFile() string {
+	if x != nil {
+		return x.ClientCertFile
+	}
+	return ""
+}
+
+func (x *TLS) GetClientKeyFile() string {
+	if x != nil {
+		return x.ClientKeyFile
+	}
+	return ""
+}
+
 type TCPSocket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -167,13 +420,15 @@ type TCPSocket struct {
 
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Port    uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	Secure  bool   `protobuf:"varint,3,opt,name=secure,proto3" json:"secure,omitempty"`
+	// If true, the listener terminates TLS using the settings in `tls`.
+	Secure bool `protobuf:"varint,3,opt,name=secure,proto3" json:"secure,omitempty"`
+	Tls    *TLS `protobuf:"bytes,4,opt,name=tls,proto3" json:"tls,omitempty"`
 }
 
 func (x *TCPSocket) Reset() {
 	*x = TCPSocket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[1]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -186,7 +441,7 @@ func (x *TCPSocket) String() string {
 func (*TCPSocket) ProtoMessage() {}
 
 func (x *TCPSocket) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[1]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -199,7 +454,7 @@ func (x *TCPSocket) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TCPSocket.ProtoReflect.Descriptor instead.
 func (*TCPSocket) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{1}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TCPSocket) GetAddress() string {
@@ -223,6 +478,70 @@ func (x *TCPSocket) GetSecure() bool {
 	return false
 }
 
+func (x *TCPSocket) GetTls() *TLS {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+type UnixSocket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path to the socket file. A socket left at the path by a previous process is removed before listening.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The permissions of the socket file, e.g. 0660. If not specified, defaults to 0660.
+	Mode uint32 `protobuf:"varint,2,opt,name=mode,proto3" json:"mode,omitempty"`
+}
+
+func (x *UnixSocket) Reset() {
+	*x = UnixSocket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnixSocket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnixSocket) ProtoMessage() {}
+
+func (x *UnixSocket) ProtoReflect() protoreflect.Message {
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnixSocket.ProtoReflect.Descriptor instead.
+func (*UnixSocket) Descriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UnixSocket) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *UnixSocket) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
 type Listener struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -230,13 +549,23 @@ type Listener struct {
 
 	// Types that are assignable to Socket:
 	//	*Listener_Tcp
+	//	*Listener_Unix
 	Socket isListener_Socket `protobuf_oneof:"socket"`
+	// The name of the listener used in logs. Required for additional listeners, and defaults to "default" for the primary
+	// listener.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The names of the modules served by the listener. If empty, all modules are served.
+	Modules []string `protobuf:"bytes,4,rep,name=modules,proto3" json:"modules,omitempty"`
+	// The names of the middleware from the gateway options that are applied to requests on the listener, which are
+	// applied in the order they are configured in the gateway options. If empty, all middleware is applied. Built-in
+	// middleware such as timeouts and tracing is always applied.
+	Middleware []string `protobuf:"bytes,5,rep,name=middleware,proto3" json:"middleware,omitempty"`
 }
 
 func (x *Listener) Reset() {
 	*x = Listener{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[2]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -249,7 +578,7 @@ func (x *Listener) String() string {
 func (*Listener) ProtoMessage() {}
 
 func (x *Listener) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[2]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -262,7 +591,7 @@ func (x *Listener) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Listener.ProtoReflect.Descriptor instead.
 func (*Listener) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{2}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{5}
 }
 
 func (m *Listener) GetSocket() isListener_Socket {
@@ -279,6 +608,34 @@ func (x *Listener) GetTcp() *TCPSocket {
 	return nil
 }
 
+func (x *Listener) GetUnix() *UnixSocket {
+	if x, ok := x.GetSocket().(*Listener_Unix); ok {
+		return x.Unix
+	}
+	return nil
+}
+
+func (x *Listener) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Listener) GetModules() []string {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+func (x *Listener) GetMiddleware() []string {
+	if x != nil {
+		return x.Middleware
+	}
+	return nil
+}
+
 type isListener_Socket interface {
 	isListener_Socket()
 }
@@ -287,8 +644,14 @@ type Listener_Tcp struct {
 	Tcp *TCPSocket `protobuf:"bytes,1,opt,name=tcp,proto3,oneof"`
 }
 
+type Listener_Unix struct {
+	Unix *UnixSocket `protobuf:"bytes,2,opt,name=unix,proto3,oneof"`
+}
+
 func (*Listener_Tcp) isListener_Socket() {}
 
+func (*Listener_Unix) isListener_Socket() {}
+
 type Stats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -301,13 +664,14 @@ type Stats struct {
 	// Types that are assignable to Reporter:
 	//	*Stats_LogReporter_
 	//	*Stats_StatsdReporter_
+	//	*Stats_PrometheusReporter_
 	Reporter isStats_Reporter `protobuf_oneof:"reporter"`
 }
 
 func (x *Stats) Reset() {
 	*x = Stats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[3]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -320,7 +684,7 @@ func (x *Stats) String() string {
 func (*Stats) ProtoMessage() {}
 
 func (x *Stats) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[3]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -333,7 +697,7 @@ func (x *Stats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Stats.ProtoReflect.Descriptor instead.
 func (*Stats) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{3}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Stats) GetFlushInterval() *duration.Duration {
@@ -364,6 +728,13 @@ func (x *Stats) GetStatsdReporter() *Stats_StatsdReporter {
 	return nil
 }
 
+func (x *Stats) GetPrometheusReporter() *Stats_PrometheusReporter {
+	if x, ok := x.GetReporter().(*Stats_PrometheusReporter_); ok {
+		return x.PrometheusReporter
+	}
+	return nil
+}
+
 type isStats_Reporter interface {
 	isStats_Reporter()
 }
@@ -373,19 +744,26 @@ type Stats_LogReporter_ struct {
 }
 
 type Stats_StatsdReporter_ struct {
-	StatsdReporter *Stats_StatsdReporter `protobuf:"bytes,3,opt,name=statsd_reporter,json=statsdReporter,proto3,oneof"` //  TODO: Prometheus support.
+	StatsdReporter *Stats_StatsdReporter `protobuf:"bytes,3,opt,name=statsd_reporter,json=statsdReporter,proto3,oneof"`
+}
+
+type Stats_PrometheusReporter_ struct {
+	PrometheusReporter *Stats_PrometheusReporter `protobuf:"bytes,4,opt,name=prometheus_reporter,json=prometheusReporter,proto3,oneof"`
 }
 
 func (*Stats_LogReporter_) isStats_Reporter() {}
 
 func (*Stats_StatsdReporter_) isStats_Reporter() {}
 
+func (*Stats_PrometheusReporter_) isStats_Reporter() {}
+
 type Timeouts struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Default timeout to apply to all requests.
+	// Default timeout to apply to all unary requests. Streaming requests are long-lived and are only subject to a
+	// timeout if an override is present for the method.
 	Default   *duration.Duration `protobuf:"bytes,1,opt,name=default,proto3" json:"default,omitempty"`
 	Overrides []*Timeouts_Entry  `protobuf:"bytes,2,rep,name=overrides,proto3" json:"overrides,omitempty"`
 }
@@ -393,7 +771,7 @@ type Timeouts struct {
 func (x *Timeouts) Reset() {
 	*x = Timeouts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[4]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -406,7 +784,7 @@ func (x *Timeouts) String() string {
 func (*Timeouts) ProtoMessage() {}
 
 func (x *Timeouts) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[4]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +797,7 @@ func (x *Timeouts) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Timeouts.ProtoReflect.Descriptor instead.
 func (*Timeouts) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{4}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Timeouts) GetDefault() *duration.Duration {
@@ -436,23 +814,144 @@ func (x *Timeouts) GetOverrides() []*Timeouts_Entry {
 	return nil
 }
 
+type Tracing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the service reported with spans. If not specified, defaults to clutch.
+	ServiceName string `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// The fraction of new traces that are sampled, between 0 and 1. Requests carrying a W3C traceparent header follow the
+	// caller's sampling decision instead. If not specified, all traces are sampled.
+	SampleRatio *wrappers.DoubleValue `protobuf:"bytes,2,opt,name=sample_ratio,json=sampleRatio,proto3" json:"sample_ratio,omitempty"`
+	// Types that are assignable to Exporter:
+	//	*Tracing_OtlpExporter
+	//	*Tracing_FileExporter_
+	Exporter isTracing_Exporter `protobuf_oneof:"exporter"`
+}
+
+func (x *Tracing) Reset() {
+	*x = Tracing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tracing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tracing) ProtoMessage() {}
+
+func (x *Tracing) ProtoReflect() protoreflect.Message {
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tracing.ProtoReflect.Descriptor instead.
+func (*Tracing) Descriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Tracing) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *Tracing) GetSampleRatio() *wrappers.DoubleValue {
+	if x != nil {
+		return x.SampleRatio
+	}
+	return nil
+}
+
+func (m *Tracing) GetExporter() isTracing_Exporter {
+	if m != nil {
+		return m.Exporter
+	}
+	return nil
+}
+
+func (x *Tracing) GetOtlpExporter() *Tracing_OTLPExporter {
+	if x, ok := x.GetExporter().(*Tracing_OtlpExporter); ok {
+		return x.OtlpExporter
+	}
+	return nil
+}
+
+func (x *Tracing) GetFileExporter() *Tracing_FileExporter {
+	if x, ok := x.GetExporter().(*Tracing_FileExporter_); ok {
+		return x.FileExporter
+	}
+	return nil
+}
+
+type isTracing_Exporter interface {
+	isTracing_Exporter()
+}
+
+type Tracing_OtlpExporter struct {
+	OtlpExporter *Tracing_OTLPExporter `protobuf:"bytes,3,opt,name=otlp_exporter,json=otlpExporter,proto3,oneof"`
+}
+
+type Tracing_FileExporter_ struct {
+	FileExporter *Tracing_FileExporter `protobuf:"bytes,4,opt,name=file_exporter,json=fileExporter,proto3,oneof"`
+}
+
+func (*Tracing_OtlpExporter) isTracing_Exporter() {}
+
+func (*Tracing_FileExporter_) isTracing_Exporter() {}
+
 type GatewayOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Listener                 *Listener     `protobuf:"bytes,1,opt,name=listener,proto3" json:"listener,omitempty"`
+	// The primary listener. Prometheus metrics are only served on this listener.
+	Listener *Listener `protobuf:"bytes,1,opt,name=listener,proto3" json:"listener,omitempty"`
+	// The socket the JSON gateway uses to connect to the gRPC server of the primary listener. If not specified, an
+	// in-memory connection is used, which is also the case for additional listeners. The name, modules, and middleware
+	// of the loopback listener can't be set.
 	JsonGrpcLoopbackListener *Listener     `protobuf:"bytes,2,opt,name=json_grpc_loopback_listener,json=jsonGrpcLoopbackListener,proto3" json:"json_grpc_loopback_listener,omitempty"`
 	Logger                   *Logger       `protobuf:"bytes,3,opt,name=logger,proto3" json:"logger,omitempty"`
 	Stats                    *Stats        `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
 	Timeouts                 *Timeouts     `protobuf:"bytes,5,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
 	Middleware               []*Middleware `protobuf:"bytes,6,rep,name=middleware,proto3" json:"middleware,omitempty"`
+	// Upon receiving SIGINT or SIGTERM, the maximum amount of time to wait for in-flight requests to complete before
+	// connections are closed. Components are then given the same amount of time to stop.
+	// If not specified, defaults to 15s.
+	ShutdownTimeout *duration.Duration `protobuf:"bytes,7,opt,name=shutdown_timeout,json=shutdownTimeout,proto3" json:"shutdown_timeout,omitempty"`
+	// If set, the configuration file is checked for changes at this interval and reloaded when it changes. The
+	// configuration can also be reloaded at any time by sending SIGHUP to the gateway. Only components that support
+	// reconfiguration apply changes while running, other changes require a restart.
+	ConfigWatchInterval *duration.Duration `protobuf:"bytes,8,opt,name=config_watch_interval,json=configWatchInterval,proto3" json:"config_watch_interval,omitempty"`
+	// If set, a span is started for each RPC and propagated to the built-in services so that their outbound calls are
+	// traced.
+	Tracing *Tracing `protobuf:"bytes,9,opt,name=tracing,proto3" json:"tracing,omitempty"`
+	// Listeners in addition to the primary listener, each with a unique name, e.g. for serving some modules on an
+	// internal port with mutual TLS.
+	AdditionalListeners []*Listener `protobuf:"bytes,10,rep,name=additional_listeners,json=additionalListeners,proto3" json:"additional_listeners,omitempty"`
+	// If set, an OpenAPI v3 document describing the JSON endpoints of the modules served on each listener is served.
+	Openapi *OpenAPI `protobuf:"bytes,11,opt,name=openapi,proto3" json:"openapi,omitempty"`
+	// If set, browser apps on the allowed origins can call the JSON gateway and gRPC-Web endpoints.
+	Cors *CORS `protobuf:"bytes,12,opt,name=cors,proto3" json:"cors,omitempty"`
 }
 
 func (x *GatewayOptions) Reset() {
 	*x = GatewayOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[5]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -465,7 +964,7 @@ func (x *GatewayOptions) String() string {
 func (*GatewayOptions) ProtoMessage() {}
 
 func (x *GatewayOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[5]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +977,7 @@ func (x *GatewayOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewayOptions.ProtoReflect.Descriptor instead.
 func (*GatewayOptions) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{5}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GatewayOptions) GetListener() *Listener {
@@ -523,6 +1022,185 @@ func (x *GatewayOptions) GetMiddleware() []*Middleware {
 	return nil
 }
 
+func (x *GatewayOptions) GetShutdownTimeout() *duration.Duration {
+	if x != nil {
+		return x.ShutdownTimeout
+	}
+	return nil
+}
+
+func (x *GatewayOptions) GetConfigWatchInterval() *duration.Duration {
+	if x != nil {
+		return x.ConfigWatchInterval
+	}
+	return nil
+}
+
+func (x *GatewayOptions) GetTracing() *Tracing {
+	if x != nil {
+		return x.Tracing
+	}
+	return nil
+}
+
+func (x *GatewayOptions) GetAdditionalListeners() []*Listener {
+	if x != nil {
+		return x.AdditionalListeners
+	}
+	return nil
+}
+
+func (x *GatewayOptions) GetOpenapi() *OpenAPI {
+	if x != nil {
+		return x.Openapi
+	}
+	return nil
+}
+
+func (x *GatewayOptions) GetCors() *CORS {
+	if x != nil {
+		return x.Cors
+	}
+	return nil
+}
+
+type CORS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The origins that are allowed to make cross-origin requests, e.g. `https://app.example.com`. Wildcards are allowed
+	// within a domain label, e.g. `https://*.example.com`, and `*` allows any origin.
+	AllowedOrigins []string `protobuf:"bytes,1,rep,name=allowed_origins,json=allowedOrigins,proto3" json:"allowed_origins,omitempty"`
+	// Request headers that are allowed in addition to those used by the JSON gateway and gRPC-Web clients, e.g.
+	// `Content-Type`, `Authorization`, and `X-Grpc-Web`.
+	AllowedHeaders []string `protobuf:"bytes,2,rep,name=allowed_headers,json=allowedHeaders,proto3" json:"allowed_headers,omitempty"`
+	// Response headers that are exposed to the app in addition to the gRPC status headers, `X-Request-Id`, and
+	// `Retry-After`.
+	ExposedHeaders []string `protobuf:"bytes,3,rep,name=exposed_headers,json=exposedHeaders,proto3" json:"exposed_headers,omitempty"`
+	// If true, the app can include cookies in requests. This can't be combined with allowing any origin.
+	AllowCredentials bool `protobuf:"varint,4,opt,name=allow_credentials,json=allowCredentials,proto3" json:"allow_credentials,omitempty"`
+	// How long the result of a preflight request can be cached by the browser. If not specified, browsers apply their own
+	// default.
+	MaxAge *duration.Duration `protobuf:"bytes,5,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+}
+
+func (x *CORS) Reset() {
+	*x = CORS{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CORS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CORS) ProtoMessage() {}
+
+func (x *CORS) ProtoReflect() protoreflect.Message {
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CORS.ProtoReflect.Descriptor instead.
+func (*CORS) Descriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CORS) GetAllowedOrigins() []string {
+	if x != nil {
+		return x.AllowedOrigins
+	}
+	return nil
+}
+
+func (x *CORS) GetAllowedHeaders() []string {
+	if x != nil {
+		return x.AllowedHeaders
+	}
+	return nil
+}
+
+func (x *CORS) GetExposedHeaders() []string {
+	if x != nil {
+		return x.ExposedHeaders
+	}
+	return nil
+}
+
+func (x *CORS) GetAllowCredentials() bool {
+	if x != nil {
+		return x.AllowCredentials
+	}
+	return false
+}
+
+func (x *CORS) GetMaxAge() *duration.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+type OpenAPI struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The HTTP path that the document is served on. The path is not subject to authentication or other middleware.
+	// If not specified, defaults to /openapi.json.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *OpenAPI) Reset() {
+	*x = OpenAPI{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenAPI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenAPI) ProtoMessage() {}
+
+func (x *OpenAPI) ProtoReflect() protoreflect.Message {
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenAPI.ProtoReflect.Descriptor instead.
+func (*OpenAPI) Descriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *OpenAPI) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
 type Logger struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -537,7 +1215,7 @@ type Logger struct {
 func (x *Logger) Reset() {
 	*x = Logger{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[6]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -550,7 +1228,7 @@ func (x *Logger) String() string {
 func (*Logger) ProtoMessage() {}
 
 func (x *Logger) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[6]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -563,7 +1241,7 @@ func (x *Logger) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Logger.ProtoReflect.Descriptor instead.
 func (*Logger) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{6}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Logger) GetLevel() Logger_Level {
@@ -609,7 +1287,7 @@ type Middleware struct {
 func (x *Middleware) Reset() {
 	*x = Middleware{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[7]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -622,7 +1300,7 @@ func (x *Middleware) String() string {
 func (*Middleware) ProtoMessage() {}
 
 func (x *Middleware) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[7]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,7 +1313,7 @@ func (x *Middleware) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Middleware.ProtoReflect.Descriptor instead.
 func (*Middleware) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{7}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Middleware) GetName() string {
@@ -664,7 +1342,7 @@ type Service struct {
 func (x *Service) Reset() {
 	*x = Service{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[8]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -677,7 +1355,7 @@ func (x *Service) String() string {
 func (*Service) ProtoMessage() {}
 
 func (x *Service) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[8]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -690,7 +1368,7 @@ func (x *Service) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Service.ProtoReflect.Descriptor instead.
 func (*Service) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{8}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Service) GetName() string {
@@ -719,7 +1397,7 @@ type Resolver struct {
 func (x *Resolver) Reset() {
 	*x = Resolver{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[9]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -732,7 +1410,7 @@ func (x *Resolver) String() string {
 func (*Resolver) ProtoMessage() {}
 
 func (x *Resolver) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[9]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,7 +1423,7 @@ func (x *Resolver) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Resolver.ProtoReflect.Descriptor instead.
 func (*Resolver) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{9}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Resolver) GetName() string {
@@ -774,7 +1452,7 @@ type Module struct {
 func (x *Module) Reset() {
 	*x = Module{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[10]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -787,7 +1465,7 @@ func (x *Module) String() string {
 func (*Module) ProtoMessage() {}
 
 func (x *Module) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[10]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,7 +1478,7 @@ func (x *Module) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Module.ProtoReflect.Descriptor instead.
 func (*Module) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{10}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Module) GetName() string {
@@ -817,29 +1495,33 @@ func (x *Module) GetTypedConfig() *any.Any {
 	return nil
 }
 
-type Stats_LogReporter struct {
+// Reads secrets from files, e.g. those mounted from a secret volume. Trailing newlines are removed from the value.
+type SecretProvider_File struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The directory that keys are relative to. Keys can't refer to files outside of the directory.
+	Directory string `protobuf:"bytes,1,opt,name=directory,proto3" json:"directory,omitempty"`
 }
 
-func (x *Stats_LogReporter) Reset() {
-	*x = Stats_LogReporter{}
+func (x *SecretProvider_File) Reset() {
+	*x = SecretProvider_File{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[11]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Stats_LogReporter) String() string {
+func (x *SecretProvider_File) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Stats_LogReporter) ProtoMessage() {}
+func (*SecretProvider_File) ProtoMessage() {}
 
-func (x *Stats_LogReporter) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[11]
+func (x *SecretProvider_File) ProtoReflect() protoreflect.Message {
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -850,39 +1532,49 @@ func (x *Stats_LogReporter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Stats_LogReporter.ProtoReflect.Descriptor instead.
-func (*Stats_LogReporter) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use SecretProvider_File.ProtoReflect.Descriptor instead.
+func (*SecretProvider_File) Descriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{1, 0}
 }
 
-type Stats_StatsdReporter struct {
+func (x *SecretProvider_File) GetDirectory() string {
+	if x != nil {
+		return x.Directory
+	}
+	return ""
+}
+
+// Runs a command with the key as its final argument and uses its output as the value. Trailing newlines are removed
+// from the value.
+type SecretProvider_Exec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// Types that are assignable to TagMode:
-	//	*Stats_StatsdReporter_PointTags_
-	TagMode isStats_StatsdReporter_TagMode `protobuf_oneof:"tag_mode"`
+	Command string   `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	Args    []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	// The maximum amount of time to wait for the command to complete.
+	// If not specified, defaults to 10s.
+	Timeout *duration.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
-func (x *Stats_StatsdReporter) Reset() {
-	*x = Stats_StatsdReporter{}
+func (x *SecretProvider_Exec) Reset() {
+	*x = SecretProvider_Exec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[12]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Stats_StatsdReporter) String() string {
+func (x *SecretProvider_Exec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Stats_StatsdReporter) ProtoMessage() {}
+func (*SecretProvider_Exec) ProtoMessage() {}
 
-func (x *Stats_StatsdReporter) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[12]
+func (x *SecretProvider_Exec) ProtoReflect() protoreflect.Message {
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -893,35 +1585,137 @@ func (x *Stats_StatsdReporter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Stats_StatsdReporter.ProtoReflect.Descriptor instead.
-func (*Stats_StatsdReporter) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{3, 1}
+// Deprecated: Use SecretProvider_Exec.ProtoReflect.Descriptor instead.
+func (*SecretProvider_Exec) Descriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{1, 1}
 }
 
-func (x *Stats_StatsdReporter) GetAddress() string {
+func (x *SecretProvider_Exec) GetCommand() string {
 	if x != nil {
-		return x.Address
+		return x.Command
 	}
 	return ""
 }
 
-func (m *Stats_StatsdReporter) GetTagMode() isStats_StatsdReporter_TagMode {
-	if m != nil {
-		return m.TagMode
+func (x *SecretProvider_Exec) GetArgs() []string {
+	if x != nil {
+		return x.Args
 	}
 	return nil
 }
 
-func (x *Stats_StatsdReporter) GetPointTags() *Stats_StatsdReporter_PointTags {
-	if x, ok := x.GetTagMode().(*Stats_StatsdReporter_PointTags_); ok {
-		return x.PointTags
+func (x *SecretProvider_Exec) GetTimeout() *duration.Duration {
+	if x != nil {
+		return x.Timeout
 	}
 	return nil
 }
 
-type isStats_StatsdReporter_TagMode interface {
-	isStats_StatsdReporter_TagMode()
-}
+type Stats_LogReporter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Stats_LogReporter) Reset() {
+	*x = Stats_LogReporter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stats_LogReporter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stats_LogReporter) ProtoMessage() {}
+
+func (x *Stats_LogReporter) ProtoReflect() protoreflect.Message {
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stats_LogReporter.ProtoReflect.Descriptor instead.
+func (*Stats_LogReporter) Descriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type Stats_StatsdReporter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Types that are assignable to TagMode:
+	//	*Stats_StatsdReporter_PointTags_
+	TagMode isStats_StatsdReporter_TagMode `protobuf_oneof:"tag_mode"`
+}
+
+func (x *Stats_StatsdReporter) Reset() {
+	*x = Stats_StatsdReporter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stats_StatsdReporter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stats_StatsdReporter) ProtoMessage() {}
+
+func (x *Stats_StatsdReporter) ProtoReflect() protoreflect.Message {
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stats_StatsdReporter.ProtoReflect.Descriptor instead.
+func (*Stats_StatsdReporter) Descriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *Stats_StatsdReporter) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (m *Stats_StatsdReporter) GetTagMode() isStats_StatsdReporter_TagMode {
+	if m != nil {
+		return m.TagMode
+	}
+	return nil
+}
+
+func (x *Stats_StatsdReporter) GetPointTags() *Stats_StatsdReporter_PointTags {
+	if x, ok := x.GetTagMode().(*Stats_StatsdReporter_PointTags_); ok {
+		return x.PointTags
+	}
+	return nil
+}
+
+type isStats_StatsdReporter_TagMode interface {
+	isStats_StatsdReporter_TagMode()
+}
 
 type Stats_StatsdReporter_PointTags_ struct {
 	PointTags *Stats_StatsdReporter_PointTags `protobuf:"bytes,2,opt,name=point_tags,json=pointTags,proto3,oneof"`
@@ -929,6 +1723,64 @@ type Stats_StatsdReporter_PointTags_ struct {
 
 func (*Stats_StatsdReporter_PointTags_) isStats_StatsdReporter_TagMode() {}
 
+type Stats_PrometheusReporter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The HTTP path that metrics are served on for scraping. The path is not subject to authentication.
+	// If not specified, defaults to /metrics.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The Prometheus metric type that timers are reported as.
+	TimerType Stats_PrometheusReporter_TimerType `protobuf:"varint,2,opt,name=timer_type,json=timerType,proto3,enum=clutch.config.gateway.v1.Stats_PrometheusReporter_TimerType" json:"timer_type,omitempty"`
+}
+
+func (x *Stats_PrometheusReporter) Reset() {
+	*x = Stats_PrometheusReporter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stats_PrometheusReporter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stats_PrometheusReporter) ProtoMessage() {}
+
+func (x *Stats_PrometheusReporter) ProtoReflect() protoreflect.Message {
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stats_PrometheusReporter.ProtoReflect.Descriptor instead.
+func (*Stats_PrometheusReporter) Descriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{6, 2}
+}
+
+func (x *Stats_PrometheusReporter) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Stats_PrometheusReporter) GetTimerType() Stats_PrometheusReporter_TimerType {
+	if x != nil {
+		return x.TimerType
+	}
+	return Stats_PrometheusReporter_SUMMARY
+}
+
 type Stats_StatsdReporter_PointTags struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -940,7 +1792,7 @@ type Stats_StatsdReporter_PointTags struct {
 func (x *Stats_StatsdReporter_PointTags) Reset() {
 	*x = Stats_StatsdReporter_PointTags{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[13]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -953,7 +1805,7 @@ func (x *Stats_StatsdReporter_PointTags) String() string {
 func (*Stats_StatsdReporter_PointTags) ProtoMessage() {}
 
 func (x *Stats_StatsdReporter_PointTags) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[13]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -966,7 +1818,7 @@ func (x *Stats_StatsdReporter_PointTags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Stats_StatsdReporter_PointTags.ProtoReflect.Descriptor instead.
 func (*Stats_StatsdReporter_PointTags) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{3, 1, 0}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{6, 1, 0}
 }
 
 func (x *Stats_StatsdReporter_PointTags) GetSeparator() string {
@@ -989,7 +1841,7 @@ type Timeouts_Entry struct {
 func (x *Timeouts_Entry) Reset() {
 	*x = Timeouts_Entry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[14]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1002,7 +1854,7 @@ func (x *Timeouts_Entry) String() string {
 func (*Timeouts_Entry) ProtoMessage() {}
 
 func (x *Timeouts_Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[14]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1015,7 +1867,7 @@ func (x *Timeouts_Entry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Timeouts_Entry.ProtoReflect.Descriptor instead.
 func (*Timeouts_Entry) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{4, 0}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *Timeouts_Entry) GetService() string {
@@ -1039,6 +1891,123 @@ func (x *Timeouts_Entry) GetTimeout() *duration.Duration {
 	return nil
 }
 
+// Exports spans using the OpenTelemetry protocol (OTLP) over HTTP with JSON encoding.
+type Tracing_OTLPExporter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The base URL of the collector, e.g. http://localhost:4318. Spans are sent to the /v1/traces path.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Additional headers sent with each export request, e.g. for authentication.
+	Headers map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The maximum amount of time to wait for the collector to accept a batch of spans.
+	// If not specified, defaults to 10s.
+	Timeout *duration.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *Tracing_OTLPExporter) Reset() {
+	*x = Tracing_OTLPExporter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tracing_OTLPExporter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tracing_OTLPExporter) ProtoMessage() {}
+
+func (x *Tracing_OTLPExporter) ProtoReflect() protoreflect.Message {
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tracing_OTLPExporter.ProtoReflect.Descriptor instead.
+func (*Tracing_OTLPExporter) Descriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *Tracing_OTLPExporter) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Tracing_OTLPExporter) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Tracing_OTLPExporter) GetTimeout() *duration.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// Writes spans as JSON, one per line. Intended for local development and testing.
+type Tracing_FileExporter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The file that spans are appended to. If not specified, spans are written to stdout.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *Tracing_FileExporter) Reset() {
+	*x = Tracing_FileExporter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tracing_FileExporter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tracing_FileExporter) ProtoMessage() {}
+
+func (x *Tracing_FileExporter) ProtoReflect() protoreflect.Message {
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tracing_FileExporter.ProtoReflect.Descriptor instead.
+func (*Tracing_FileExporter) Descriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *Tracing_FileExporter) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
 var File_config_gateway_v1_gateway_proto protoreflect.FileDescriptor
 
 var file_config_gateway_v1_gateway_proto_rawDesc = []byte{
@@ -1049,9 +2018,11 @@ var file_config_gateway_v1_gateway_proto_rawDesc = []byte{
 	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
 	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x93, 0x02, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4c, 0x0a, 0x07, 0x67, 0x61,
+	0x84, 0x03, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4c, 0x0a, 0x07, 0x67, 0x61,
 	0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6c,
 	0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65,
 	0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x70,
@@ -1068,133 +2039,298 @@ var file_config_gateway_v1_gateway_proto_rawDesc = []byte{
 	0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x75,
 	0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
 	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x6d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x09, 0x54, 0x43, 0x50, 0x53, 0x6f, 0x63, 0x6b,
-	0x65, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0xff, 0xff, 0x03, 0x52, 0x04,
-	0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x22, 0x52, 0x0a, 0x08,
-	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x43, 0x50, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63,
-	0x70, 0x42, 0x0d, 0x0a, 0x06, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x03, 0xf8, 0x42, 0x01,
-	0x22, 0xe8, 0x03, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x66, 0x6c,
-	0x75, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xfa,
-	0x42, 0x0a, 0xaa, 0x01, 0x07, 0x32, 0x05, 0x10, 0x80, 0xc2, 0xd7, 0x2f, 0x52, 0x0d, 0x66, 0x6c,
-	0x75, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x50, 0x0a, 0x0c, 0x6c,
-	0x6f, 0x67, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x48, 0x00,
-	0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x59, 0x0a,
-	0x0f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x64, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x73, 0x64,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x1a, 0x0d, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x1a, 0xc5, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x59, 0x0a, 0x0a, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x61,
-	0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
-	0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x64,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x61,
-	0x67, 0x73, 0x48, 0x00, 0x52, 0x09, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x1a,
-	0x32, 0x0a, 0x09, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x25, 0x0a, 0x09,
-	0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x74, 0x61, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42,
-	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0x93, 0x02, 0x0a, 0x08,
-	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0xaa, 0x01, 0x06, 0x08, 0x01, 0x32, 0x02,
-	0x08, 0x01, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x46, 0x0a, 0x09, 0x6f,
-	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
-	0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x73, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
-	0x64, 0x65, 0x73, 0x1a, 0x7c, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x41,
+	0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x73, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6c,
+	0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0xf3, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x43, 0x0a, 0x04, 0x65,
+	0x78, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x48, 0x00, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63,
+	0x1a, 0x2d, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x20, 0x01, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x1a,
+	0x7e, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x21, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20,
+	0x01, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72,
+	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x3f,
 	0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0xaa,
-	0x01, 0x06, 0x08, 0x01, 0x32, 0x02, 0x08, 0x01, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x22, 0xc8, 0x03, 0x0a, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x48, 0x0a, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a,
-	0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x61,
-	0x0a, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x6f, 0x6f, 0x70,
-	0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x47, 0x72, 0x70,
-	0x63, 0x4c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
-	0x72, 0x12, 0x42, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67,
-	0x67, 0x65, 0x72, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x6c,
-	0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0xaa,
+	0x01, 0x04, 0x32, 0x02, 0x08, 0x01, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42,
+	0x0b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x97, 0x02, 0x0a,
+	0x03, 0x54, 0x4c, 0x53, 0x12, 0x24, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01,
+	0x52, 0x08, 0x63, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x08, 0x6b, 0x65,
+	0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x24,
+	0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x61,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0xaa, 0x01, 0x04,
+	0x32, 0x02, 0x08, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63,
+	0x65, 0x72, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x26,
+	0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b,
+	0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x09, 0x54, 0x43, 0x50, 0x53, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0xff, 0xff, 0x03,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x2f,
+	0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6c,
+	0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x4c, 0x53, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x22,
+	0x47, 0x0a, 0x0a, 0x55, 0x6e, 0x69, 0x78, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1b, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x18,
+	0xff, 0x03, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0xdc, 0x01, 0x0a, 0x08, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x43,
+	0x50, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x70, 0x12, 0x3a,
+	0x0a, 0x04, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x78, 0x53, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x48, 0x00, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x69,
+	0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x42, 0x0d, 0x0a, 0x06, 0x73, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x80, 0x06, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xfa, 0x42, 0x0a, 0xaa, 0x01, 0x07, 0x32, 0x05, 0x10, 0x80,
+	0xc2, 0xd7, 0x2f, 0x52, 0x0d, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x50, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
 	0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
-	0x77, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x75,
-	0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65,
-	0x52, 0x0a, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x22, 0xc4, 0x01, 0x0a,
-	0x06, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x12, 0x59, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x64, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52,
+	0x0e, 0x73, 0x74, 0x61, 0x74, 0x73, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12,
+	0x65, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x5f, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x50, 0x72,
+	0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x1a, 0x0d, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x1a, 0xc5, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x64,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x59, 0x0a, 0x0a, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
 	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x74, 0x74, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x70, 0x72, 0x65, 0x74, 0x74, 0x79, 0x22,
-	0x58, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42,
-	0x55, 0x47, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x02, 0x12, 0x08,
-	0x0a, 0x04, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f,
-	0x52, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x4e, 0x49, 0x43, 0x10, 0x05, 0x12, 0x09,
-	0x0a, 0x05, 0x46, 0x41, 0x54, 0x41, 0x4c, 0x10, 0x06, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x22, 0x62, 0x0a, 0x0a, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72,
-	0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37,
-	0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b, 0x74, 0x79, 0x70, 0x65,
-	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x5f, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x37, 0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b, 0x74, 0x79, 0x70,
-	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x60, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b, 0x74,
-	0x79, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x5e, 0x0a, 0x06, 0x4d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b, 0x74,
-	0x79, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x0b, 0x5a, 0x09, 0x67, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x64, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73,
+	0x48, 0x00, 0x52, 0x09, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x1a, 0x32, 0x0a,
+	0x09, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x25, 0x0a, 0x09, 0x73, 0x65,
+	0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x42, 0x0a, 0x0a, 0x08, 0x74, 0x61, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x1a, 0xae, 0x01,
+	0x0a, 0x12, 0x50, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x5b, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65,
+	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x50, 0x72,
+	0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0x27, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x4d, 0x4d, 0x41, 0x52, 0x59, 0x10, 0x00, 0x12,
+	0x0d, 0x0a, 0x09, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x47, 0x52, 0x41, 0x4d, 0x10, 0x01, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0x93, 0x02, 0x0a, 0x08, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0xaa, 0x01, 0x06, 0x08, 0x01, 0x32, 0x02, 0x08,
+	0x01, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x46, 0x0a, 0x09, 0x6f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x73, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x73, 0x1a, 0x7c, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x41, 0x0a,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0xaa, 0x01,
+	0x06, 0x08, 0x01, 0x32, 0x02, 0x08, 0x01, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x22, 0xe7, 0x04, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x58, 0x0a, 0x0c, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0xf0, 0x3f, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x0b, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x55, 0x0a, 0x0d, 0x6f, 0x74, 0x6c,
+	0x70, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63,
+	0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x54, 0x4c, 0x50, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x0c, 0x6f, 0x74, 0x6c, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x12, 0x55, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x1a, 0xfb, 0x01, 0x0a, 0x0c, 0x4f, 0x54, 0x4c, 0x50,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x20, 0x01, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x55, 0x0a,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b,
+	0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x69, 0x6e,
+	0x67, 0x2e, 0x4f, 0x54, 0x4c, 0x50, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x22, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0f, 0x0a, 0x08, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0xee, 0x06, 0x0a, 0x0e, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x48, 0x0a,
+	0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x61, 0x0a, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f,
+	0x67, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x52, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x47, 0x72, 0x70, 0x63, 0x4c, 0x6f, 0x6f, 0x70, 0x62, 0x61,
+	0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x06, 0x6c, 0x6f,
+	0x67, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x75,
+	0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x3f,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x3e, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12,
+	0x44, 0x0a, 0x0a, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x52, 0x0a, 0x6d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x77, 0x61, 0x72, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x68, 0x75, 0x74,
+	0x64, 0x6f, 0x77, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x59, 0x0a, 0x15, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0xaa, 0x01, 0x04, 0x32, 0x02, 0x08,
+	0x01, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x57, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x3b, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x69, 0x6e,
+	0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63,
+	0x69, 0x6e, 0x67, 0x12, 0x55, 0x0a, 0x14, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x13, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x6f, 0x70,
+	0x65, 0x6e, 0x61, 0x70, 0x69, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c,
+	0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x50, 0x49, 0x52, 0x07,
+	0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x12, 0x32, 0x0a, 0x04, 0x63, 0x6f, 0x72, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x4f, 0x52, 0x53, 0x52, 0x04, 0x63, 0x6f, 0x72, 0x73, 0x22, 0xf6, 0x01, 0x0a, 0x04,
+	0x43, 0x4f, 0x52, 0x53, 0x12, 0x31, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x6f, 0x73,
+	0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x06, 0x6d, 0x61,
+	0x78, 0x41, 0x67, 0x65, 0x22, 0x1d, 0x0a, 0x07, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x50, 0x49, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x22, 0xc4, 0x01, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x3c,
+	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x2e,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x06,
+	0x70, 0x72, 0x65, 0x74, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06,
+	0x70, 0x72, 0x65, 0x74, 0x74, 0x79, 0x22, 0x58, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49,
+	0x4e, 0x46, 0x4f, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x03, 0x12,
+	0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x41,
+	0x4e, 0x49, 0x43, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x41, 0x54, 0x41, 0x4c, 0x10, 0x06,
+	0x42, 0x08, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x62, 0x0a, 0x0a, 0x4d, 0x69,
+	0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x52, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x5f,
+	0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
+	0x6e, 0x79, 0x52, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0x60, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x20, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x22, 0x5e, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x20, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x42, 0x0b, 0x5a, 0x09, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1209,57 +2345,92 @@ func file_config_gateway_v1_gateway_proto_rawDescGZIP() []byte {
 	return file_config_gateway_v1_gateway_proto_rawDescData
 }
 
-var file_config_gateway_v1_gateway_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_config_gateway_v1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_config_gateway_v1_gateway_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_config_gateway_v1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_config_gateway_v1_gateway_proto_goTypes = []interface{}{
-	(Logger_Level)(0),                      // 0: clutch.config.gateway.v1.Logger.Level
-	(*Config)(nil),                         // 1: clutch.config.gateway.v1.Config
-	(*TCPSocket)(nil),                      // 2: clutch.config.gateway.v1.TCPSocket
-	(*Listener)(nil),                       // 3: clutch.config.gateway.v1.Listener
-	(*Stats)(nil),                          // 4: clutch.config.gateway.v1.Stats
-	(*Timeouts)(nil),                       // 5: clutch.config.gateway.v1.Timeouts
-	(*GatewayOptions)(nil),                 // 6: clutch.config.gateway.v1.GatewayOptions
-	(*Logger)(nil),                         // 7: clutch.config.gateway.v1.Logger
-	(*Middleware)(nil),                     // 8: clutch.config.gateway.v1.Middleware
-	(*Service)(nil),                        // 9: clutch.config.gateway.v1.Service
-	(*Resolver)(nil),                       // 10: clutch.config.gateway.v1.Resolver
-	(*Module)(nil),                         // 11: clutch.config.gateway.v1.Module
-	(*Stats_LogReporter)(nil),              // 12: clutch.config.gateway.v1.Stats.LogReporter
-	(*Stats_StatsdReporter)(nil),           // 13: clutch.config.gateway.v1.Stats.StatsdReporter
-	(*Stats_StatsdReporter_PointTags)(nil), // 14: clutch.config.gateway.v1.Stats.StatsdReporter.PointTags
-	(*Timeouts_Entry)(nil),                 // 15: clutch.config.gateway.v1.Timeouts.Entry
-	(*duration.Duration)(nil),              // 16: google.protobuf.Duration
-	(*any.Any)(nil),                        // 17: google.protobuf.Any
+	(Stats_PrometheusReporter_TimerType)(0), // 0: clutch.config.gateway.v1.Stats.PrometheusReporter.TimerType
+	(Logger_Level)(0),                       // 1: clutch.config.gateway.v1.Logger.Level
+	(*Config)(nil),                          // 2: clutch.config.gateway.v1.Config
+	(*SecretProvider)(nil),                  // 3: clutch.config.gateway.v1.SecretProvider
+	(*TLS)(nil),                             // 4: clutch.config.gateway.v1.TLS
+	(*TCPSocket)(nil),                       // 5: clutch.config.gateway.v1.TCPSocket
+	(*UnixSocket)(nil),                      // 6: clutch.config.gateway.v1.UnixSocket
+	(*Listener)(nil),                        // 7: clutch.config.gateway.v1.Listener
+	(*Stats)(nil),                           // 8: clutch.config.gateway.v1.Stats
+	(*Timeouts)(nil),                        // 9: clutch.config.gateway.v1.Timeouts
+	(*Tracing)(nil),                         // 10: clutch.config.gateway.v1.Tracing
+	(*GatewayOptions)(nil),                  // 11: clutch.config.gateway.v1.GatewayOptions
+	(*CORS)(nil),                            // 12: clutch.config.gateway.v1.CORS
+	(*OpenAPI)(nil),                         // 13: clutch.config.gateway.v1.OpenAPI
+	(*Logger)(nil),                          // 14: clutch.config.gateway.v1.Logger
+	(*Middleware)(nil),                      // 15: clutch.config.gateway.v1.Middleware
+	(*Service)(nil),                         // 16: clutch.config.gateway.v1.Service
+	(*Resolver)(nil),                        // 17: clutch.config.gateway.v1.Resolver
+	(*Module)(nil),                          // 18: clutch.config.gateway.v1.Module
+	(*SecretProvider_File)(nil),             // 19: clutch.config.gateway.v1.SecretProvider.File
+	(*SecretProvider_Exec)(nil),             // 20: clutch.config.gateway.v1.SecretProvider.Exec
+	(*Stats_LogReporter)(nil),               // 21: clutch.config.gateway.v1.Stats.LogReporter
+	(*Stats_StatsdReporter)(nil),            // 22: clutch.config.gateway.v1.Stats.StatsdReporter
+	(*Stats_PrometheusReporter)(nil),        // 23: clutch.config.gateway.v1.Stats.PrometheusReporter
+	(*Stats_StatsdReporter_PointTags)(nil),  // 24: clutch.config.gateway.v1.Stats.StatsdReporter.PointTags
+	(*Timeouts_Entry)(nil),                  // 25: clutch.config.gateway.v1.Timeouts.Entry
+	(*Tracing_OTLPExporter)(nil),            // 26: clutch.config.gateway.v1.Tracing.OTLPExporter
+	(*Tracing_FileExporter)(nil),            // 27: clutch.config.gateway.v1.Tracing.FileExporter
+	nil,                                     // 28: clutch.config.gateway.v1.Tracing.OTLPExporter.HeadersEntry
+	(*duration.Duration)(nil),               // 29: google.protobuf.Duration
+	(*wrappers.DoubleValue)(nil),            // 30: google.protobuf.DoubleValue
+	(*any.Any)(nil),                         // 31: google.protobuf.Any
 }
 var file_config_gateway_v1_gateway_proto_depIdxs = []int32{
-	6,  // 0: clutch.config.gateway.v1.Config.gateway:type_name -> clutch.config.gateway.v1.GatewayOptions
-	9,  // 1: clutch.config.gateway.v1.Config.services:type_name -> clutch.config.gateway.v1.Service
-	10, // 2: clutch.config.gateway.v1.Config.resolvers:type_name -> clutch.config.gateway.v1.Resolver
-	11, // 3: clutch.config.gateway.v1.Config.modules:type_name -> clutch.config.gateway.v1.Module
-	2,  // 4: clutch.config.gateway.v1.Listener.tcp:type_name -> clutch.config.gateway.v1.TCPSocket
-	16, // 5: clutch.config.gateway.v1.Stats.flush_interval:type_name -> google.protobuf.Duration
-	12, // 6: clutch.config.gateway.v1.Stats.log_reporter:type_name -> clutch.config.gateway.v1.Stats.LogReporter
-	13, // 7: clutch.config.gateway.v1.Stats.statsd_reporter:type_name -> clutch.config.gateway.v1.Stats.StatsdReporter
-	16, // 8: clutch.config.gateway.v1.Timeouts.default:type_name -> google.protobuf.Duration
-	15, // 9: clutch.config.gateway.v1.Timeouts.overrides:type_name -> clutch.config.gateway.v1.Timeouts.Entry
-	3,  // 10: clutch.config.gateway.v1.GatewayOptions.listener:type_name -> clutch.config.gateway.v1.Listener
-	3,  // 11: clutch.config.gateway.v1.GatewayOptions.json_grpc_loopback_listener:type_name -> clutch.config.gateway.v1.Listener
-	7,  // 12: clutch.config.gateway.v1.GatewayOptions.logger:type_name -> clutch.config.gateway.v1.Logger
-	4,  // 13: clutch.config.gateway.v1.GatewayOptions.stats:type_name -> clutch.config.gateway.v1.Stats
-	5,  // 14: clutch.config.gateway.v1.GatewayOptions.timeouts:type_name -> clutch.config.gateway.v1.Timeouts
-	8,  // 15: clutch.config.gateway.v1.GatewayOptions.middleware:type_name -> clutch.config.gateway.v1.Middleware
-	0,  // 16: clutch.config.gateway.v1.Logger.level:type_name -> clutch.config.gateway.v1.Logger.Level
-	17, // 17: clutch.config.gateway.v1.Middleware.typed_config:type_name -> google.protobuf.Any
-	17, // 18: clutch.config.gateway.v1.Service.typed_config:type_name -> google.protobuf.Any
-	17, // 19: clutch.config.gateway.v1.Resolver.typed_config:type_name -> google.protobuf.Any
-	17, // 20: clutch.config.gateway.v1.Module.typed_config:type_name -> google.protobuf.Any
-	14, // 21: clutch.config.gateway.v1.Stats.StatsdReporter.point_tags:type_name -> clutch.config.gateway.v1.Stats.StatsdReporter.PointTags
-	16, // 22: clutch.config.gateway.v1.Timeouts.Entry.timeout:type_name -> google.protobuf.Duration
-	23, // [23:23] is the sub-list for method output_type
-	23, // [23:23] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	11, // 0: clutch.config.gateway.v1.Config.gateway:type_name -> clutch.config.gateway.v1.GatewayOptions
+	16, // 1: clutch.config.gateway.v1.Config.services:type_name -> clutch.config.gateway.v1.Service
+	17, // 2: clutch.config.gateway.v1.Config.resolvers:type_name -> clutch.config.gateway.v1.Resolver
+	18, // 3: clutch.config.gateway.v1.Config.modules:type_name -> clutch.config.gateway.v1.Module
+	3,  // 4: clutch.config.gateway.v1.Config.secret_providers:type_name -> clutch.config.gateway.v1.SecretProvider
+	19, // 5: clutch.config.gateway.v1.SecretProvider.file:type_name -> clutch.config.gateway.v1.SecretProvider.File
+	20, // 6: clutch.config.gateway.v1.SecretProvider.exec:type_name -> clutch.config.gateway.v1.SecretProvider.Exec
+	29, // 7: clutch.config.gateway.v1.TLS.reload_interval:type_name -> google.protobuf.Duration
+	4,  // 8: clutch.config.gateway.v1.TCPSocket.tls:type_name -> clutch.config.gateway.v1.TLS
+	5,  // 9: clutch.config.gateway.v1.Listener.tcp:type_name -> clutch.config.gateway.v1.TCPSocket
+	6,  // 10: clutch.config.gateway.v1.Listener.unix:type_name -> clutch.config.gateway.v1.UnixSocket
+	29, // 11: clutch.config.gateway.v1.Stats.flush_interval:type_name -> google.protobuf.Duration
+	21, // 12: clutch.config.gateway.v1.Stats.log_reporter:type_name -> clutch.config.gateway.v1.Stats.LogReporter
+	22, // 13: clutch.config.gateway.v1.Stats.statsd_reporter:type_name -> clutch.config.gateway.v1.Stats.StatsdReporter
+	23, // 14: clutch.config.gateway.v1.Stats.prometheus_reporter:type_name -> clutch.config.gateway.v1.Stats.PrometheusReporter
+	29, // 15: clutch.config.gateway.v1.Timeouts.default:type_name -> google.protobuf.Duration
+	25, // 16: clutch.config.gateway.v1.Timeouts.overrides:type_name -> clutch.config.gateway.v1.Timeouts.Entry
+	30, // 17: clutch.config.gateway.v1.Tracing.sample_ratio:type_name -> google.protobuf.DoubleValue
+	26, // 18: clutch.config.gateway.v1.Tracing.otlp_exporter:type_name -> clutch.config.gateway.v1.Tracing.OTLPExporter
+	27, // 19: clutch.config.gateway.v1.Tracing.file_exporter:type_name -> clutch.config.gateway.v1.Tracing.FileExporter
+	7,  // 20: clutch.config.gateway.v1.GatewayOptions.listener:type_name -> clutch.config.gateway.v1.Listener
+	7,  // 21: clutch.config.gateway.v1.GatewayOptions.json_grpc_loopback_listener:type_name -> clutch.config.gateway.v1.Listener
+	14, // 22: clutch.config.gateway.v1.GatewayOptions.logger:type_name -> clutch.config.gateway.v1.Logger
+	8,  // 23: clutch.config.gateway.v1.GatewayOptions.stats:type_name -> clutch.config.gateway.v1.Stats
+	9,  // 24: clutch.config.gateway.v1.GatewayOptions.timeouts:type_name -> clutch.config.gateway.v1.Timeouts
+	15, // 25: clutch.config.gateway.v1.GatewayOptions.middleware:type_name -> clutch.config.gateway.v1.Middleware
+	29, // 26: clutch.config.gateway.v1.GatewayOptions.shutdown_timeout:type_name -> google.protobuf.Duration
+	29, // 27: clutch.config.gateway.v1.GatewayOptions.config_watch_interval:type_name -> google.protobuf.Duration
+	10, // 28: clutch.config.gateway.v1.GatewayOptions.tracing:type_name -> clutch.config.gateway.v1.Tracing
+	7,  // 29: clutch.config.gateway.v1.GatewayOptions.additional_listeners:type_name -> clutch.config.gateway.v1.Listener
+	13, // 30: clutch.config.gateway.v1.GatewayOptions.openapi:type_name -> clutch.config.gateway.v1.OpenAPI
+	12, // 31: clutch.config.gateway.v1.GatewayOptions.cors:type_name -> clutch.config.gateway.v1.CORS
+	29, // 32: clutch.config.gateway.v1.CORS.max_age:type_name -> google.protobuf.Duration
+	1,  // 33: clutch.config.gateway.v1.Logger.level:type_name -> clutch.config.gateway.v1.Logger.Level
+	31, // 34: clutch.config.gateway.v1.Middleware.typed_config:type_name -> google.protobuf.Any
+	31, // 35: clutch.config.gateway.v1.Service.typed_config:type_name -> google.protobuf.Any
+	31, // 36: clutch.config.gateway.v1.Resolver.typed_config:type_name -> google.protobuf.Any
+	31, // 37: clutch.config.gateway.v1.Module.typed_config:type_name -> google.protobuf.Any
+	29, // 38: clutch.config.gateway.v1.SecretProvider.Exec.timeout:type_name -> google.protobuf.Duration
+	24, // 39: clutch.config.gateway.v1.Stats.StatsdReporter.point_tags:type_name -> clutch.config.gateway.v1.Stats.StatsdReporter.PointTags
+	0,  // 40: clutch.config.gateway.v1.Stats.PrometheusReporter.timer_type:type_name -> clutch.config.gateway.v1.Stats.PrometheusReporter.TimerType
+	29, // 41: clutch.config.gateway.v1.Timeouts.Entry.timeout:type_name -> google.protobuf.Duration
+	28, // 42: clutch.config.gateway.v1.Tracing.OTLPExporter.headers:type_name -> clutch.config.gateway.v1.Tracing.OTLPExporter.HeadersEntry
+	29, // 43: clutch.config.gateway.v1.Tracing.OTLPExporter.timeout:type_name -> google.protobuf.Duration
+	44, // [44:44] is the sub-list for method output_type
+	44, // [44:44] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_config_gateway_v1_gateway_proto_init() }
@@ -1281,7 +2452,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TCPSocket); i {
+			switch v := v.(*SecretProvider); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1293,7 +2464,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Listener); i {
+			switch v := v.(*TLS); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1305,7 +2476,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stats); i {
+			switch v := v.(*TCPSocket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1317,7 +2488,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Timeouts); i {
+			switch v := v.(*UnixSocket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1329,7 +2500,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewayOptions); i {
+			switch v := v.(*Listener); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1341,7 +2512,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Logger); i {
+			switch v := v.(*Stats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1353,7 +2524,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Middleware); i {
+			switch v := v.(*Timeouts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1365,7 +2536,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Service); i {
+			switch v := v.(*Tracing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1377,7 +2548,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Resolver); i {
+			switch v := v.(*GatewayOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1389,7 +2560,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Module); i {
+			switch v := v.(*CORS); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1401,7 +2572,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stats_LogReporter); i {
+			switch v := v.(*OpenAPI); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1413,7 +2584,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stats_StatsdReporter); i {
+			switch v := v.(*Logger); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1425,7 +2596,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stats_StatsdReporter_PointTags); i {
+			switch v := v.(*Middleware); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1437,6 +2608,114 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Service); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_gateway_v1_gateway_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Resolver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_gateway_v1_gateway_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Module); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_gateway_v1_gateway_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretProvider_File); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_gateway_v1_gateway_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretProvider_Exec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_gateway_v1_gateway_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stats_LogReporter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_gateway_v1_gateway_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stats_StatsdReporter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_gateway_v1_gateway_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stats_PrometheusReporter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_gateway_v1_gateway_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stats_StatsdReporter_PointTags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_gateway_v1_gateway_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Timeouts_Entry); i {
 			case 0:
 				return &v.state
@@ -1448,18 +2727,52 @@ func file_config_gateway_v1_gateway_proto_init() {
 				return nil
 			}
 		}
+		file_config_gateway_v1_gateway_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tracing_OTLPExporter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_gateway_v1_gateway_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tracing_FileExporter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_config_gateway_v1_gateway_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*SecretProvider_File_)(nil),
+		(*SecretProvider_Exec_)(nil),
 	}
-	file_config_gateway_v1_gateway_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_config_gateway_v1_gateway_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*Listener_Tcp)(nil),
+		(*Listener_Unix)(nil),
 	}
-	file_config_gateway_v1_gateway_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_config_gateway_v1_gateway_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*Stats_LogReporter_)(nil),
 		(*Stats_StatsdReporter_)(nil),
+		(*Stats_PrometheusReporter_)(nil),
 	}
-	file_config_gateway_v1_gateway_proto_msgTypes[6].OneofWrappers = []interface{}{
-		(*Logger_Pretty)(nil),
+	file_config_gateway_v1_gateway_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*Tracing_OtlpExporter)(nil),
+		(*Tracing_FileExporter_)(nil),
 	}
 	file_config_gateway_v1_gateway_proto_msgTypes[12].OneofWrappers = []interface{}{
+		(*Logger_Pretty)(nil),
+	}
+	file_config_gateway_v1_gateway_proto_msgTypes[20].OneofWrappers = []interface{}{
 		(*Stats_StatsdReporter_PointTags_)(nil),
 	}
 	type x struct{}
@@ -1467,8 +2780,8 @@ func file_config_gateway_v1_gateway_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_gateway_v1_gateway_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   15,
+			NumEnums:      2,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
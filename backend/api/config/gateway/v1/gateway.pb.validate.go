@@ -105,6 +105,21 @@ func (m *Config) Validate() error {
 
 	}
 
+	for idx, item := range m.GetSecretProviders() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  fmt.Sprintf("SecretProviders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -162,6 +177,217 @@ var _ interface {
 	ErrorName() string
 } = ConfigValidationError{}
 
+// Validate checks the field values on SecretProvider with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *SecretProvider) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetName()) < 1 {
+		return SecretProviderValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
+
+	switch m.Type.(type) {
+
+	case *SecretProvider_File_:
+
+		if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecretProviderValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SecretProvider_Exec_:
+
+		if v, ok := interface{}(m.GetExec()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecretProviderValidationError{
+					field:  "Exec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		return SecretProviderValidationError{
+			field:  "Type",
+			reason: "value is required",
+		}
+
+	}
+
+	return nil
+}
+
+// SecretProviderValidationError is the validation error returned by
+// SecretProvider.Validate if the designated constraints aren't met.
+type SecretProviderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecretProviderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecretProviderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecretProviderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecretProviderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecretProviderValidationError) ErrorName() string { return "SecretProviderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SecretProviderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecretProvider.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecretProviderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecretProviderValidationError{}
+
+// Validate checks the field values on TLS with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *TLS) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetCertFile()) < 1 {
+		return TLSValidationError{
+			field:  "CertFile",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
+
+	if len(m.GetKeyFile()) < 1 {
+		return TLSValidationError{
+			field:  "KeyFile",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
+
+	// no validation rules for ClientCaFile
+
+	if d := m.GetReloadInterval(); d != nil {
+		dur, err := ptypes.Duration(d)
+		if err != nil {
+			return TLSValidationError{
+				field:  "ReloadInterval",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+		}
+
+		gte := time.Duration(1*time.Second + 0*time.Nanosecond)
+
+		if dur < gte {
+			return TLSValidationError{
+				field:  "ReloadInterval",
+				reason: "value must be greater than or equal to 1s",
+			}
+		}
+
+	}
+
+	// no validation rules for ClientCertFile
+
+	// no validation rules for ClientKeyFile
+
+	return nil
+}
+
+// TLSValidationError is the validation error returned by TLS.Validate if the
+// designated constraints aren't met.
+type TLSValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TLSValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TLSValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TLSValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TLSValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TLSValidationError) ErrorName() string { return "TLSValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TLSValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTLS.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TLSValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TLSValidationError{}
+
 // Validate checks the field values on TCPSocket with the rules defined in the
 // proto definition for this message. If any rules are violated, an error is returned.
 func (m *TCPSocket) Validate() error {
@@ -185,6 +411,16 @@ func (m *TCPSocket) Validate() error {
 
 	// no validation rules for Secure
 
+	if v, ok := interface{}(m.GetTls()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TCPSocketValidationError{
+				field:  "Tls",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -242,6 +478,84 @@ var _ interface {
 	ErrorName() string
 } = TCPSocketValidationError{}
 
+// Validate checks the field values on UnixSocket with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *UnixSocket) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetPath()) < 1 {
+		return UnixSocketValidationError{
+			field:  "Path",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
+
+	if m.GetMode() > 511 {
+		return UnixSocketValidationError{
+			field:  "Mode",
+			reason: "value must be less than or equal to 511",
+		}
+	}
+
+	return nil
+}
+
+// UnixSocketValidationError is the validation error returned by
+// UnixSocket.Validate if the designated constraints aren't met.
+type UnixSocketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnixSocketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnixSocketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnixSocketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnixSocketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnixSocketValidationError) ErrorName() string { return "UnixSocketValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnixSocketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnixSocket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnixSocketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnixSocketValidationError{}
+
 // Validate checks the field values on Listener with the rules defined in the
 // proto definition for this message. If any rules are violated, an error is returned.
 func (m *Listener) Validate() error {
@@ -249,6 +563,8 @@ func (m *Listener) Validate() error {
 		return nil
 	}
 
+	// no validation rules for Name
+
 	switch m.Socket.(type) {
 
 	case *Listener_Tcp:
@@ -263,6 +579,18 @@ func (m *Listener) Validate() error {
 			}
 		}
 
+	case *Listener_Unix:
+
+		if v, ok := interface{}(m.GetUnix()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListenerValidationError{
+					field:  "Unix",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		return ListenerValidationError{
 			field:  "Socket",
@@ -382,6 +710,18 @@ func (m *Stats) Validate() error {
 			}
 		}
 
+	case *Stats_PrometheusReporter_:
+
+		if v, ok := interface{}(m.GetPrometheusReporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StatsValidationError{
+					field:  "PrometheusReporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
 	return nil
@@ -548,10 +888,121 @@ var _ interface {
 	ErrorName() string
 } = TimeoutsValidationError{}
 
-// Validate checks the field values on GatewayOptions with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *GatewayOptions) Validate() error {
+// Validate checks the field values on Tracing with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Tracing) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ServiceName
+
+	if wrapper := m.GetSampleRatio(); wrapper != nil {
+
+		if val := wrapper.GetValue(); val < 0 || val > 1 {
+			return TracingValidationError{
+				field:  "SampleRatio",
+				reason: "value must be inside range [0, 1]",
+			}
+		}
+
+	}
+
+	switch m.Exporter.(type) {
+
+	case *Tracing_OtlpExporter:
+
+		if v, ok := interface{}(m.GetOtlpExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TracingValidationError{
+					field:  "OtlpExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Tracing_FileExporter_:
+
+		if v, ok := interface{}(m.GetFileExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TracingValidationError{
+					field:  "FileExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		return TracingValidationError{
+			field:  "Exporter",
+			reason: "value is required",
+		}
+
+	}
+
+	return nil
+}
+
+// TracingValidationError is the validation error returned by Tracing.Validate
+// if the designated constraints aren't met.
+type TracingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TracingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TracingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TracingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TracingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TracingValidationError) ErrorName() string { return "TracingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TracingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTracing.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TracingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TracingValidationError{}
+
+// Validate checks the field values on GatewayOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *GatewayOptions) Validate() error {
 	if m == nil {
 		return nil
 	}
@@ -642,6 +1093,82 @@ func (m *GatewayOptions) Validate() error {
 
 	}
 
+	if v, ok := interface{}(m.GetShutdownTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GatewayOptionsValidationError{
+				field:  "ShutdownTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if d := m.GetConfigWatchInterval(); d != nil {
+		dur, err := ptypes.Duration(d)
+		if err != nil {
+			return GatewayOptionsValidationError{
+				field:  "ConfigWatchInterval",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+		}
+
+		gte := time.Duration(1*time.Second + 0*time.Nanosecond)
+
+		if dur < gte {
+			return GatewayOptionsValidationError{
+				field:  "ConfigWatchInterval",
+				reason: "value must be greater than or equal to 1s",
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetTracing()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GatewayOptionsValidationError{
+				field:  "Tracing",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetAdditionalListeners() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GatewayOptionsValidationError{
+					field:  fmt.Sprintf("AdditionalListeners[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetOpenapi()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GatewayOptionsValidationError{
+				field:  "Openapi",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetCors()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GatewayOptionsValidationError{
+				field:  "Cors",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -699,6 +1226,166 @@ var _ interface {
 	ErrorName() string
 } = GatewayOptionsValidationError{}
 
+// Validate checks the field values on CORS with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *CORS) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetAllowedOrigins()) < 1 {
+		return CORSValidationError{
+			field:  "AllowedOrigins",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	// no validation rules for AllowCredentials
+
+	if d := m.GetMaxAge(); d != nil {
+		dur, err := ptypes.Duration(d)
+		if err != nil {
+			return CORSValidationError{
+				field:  "MaxAge",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+		}
+
+		gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+		if dur < gte {
+			return CORSValidationError{
+				field:  "MaxAge",
+				reason: "value must be greater than or equal to 0s",
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// CORSValidationError is the validation error returned by CORS.Validate if the
+// designated constraints aren't met.
+type CORSValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CORSValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CORSValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CORSValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CORSValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CORSValidationError) ErrorName() string { return "CORSValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CORSValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCORS.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CORSValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CORSValidationError{}
+
+// Validate checks the field values on OpenAPI with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *OpenAPI) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Path
+
+	return nil
+}
+
+// OpenAPIValidationError is the validation error returned by OpenAPI.Validate
+// if the designated constraints aren't met.
+type OpenAPIValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenAPIValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenAPIValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenAPIValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenAPIValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenAPIValidationError) ErrorName() string { return "OpenAPIValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OpenAPIValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenAPI.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenAPIValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenAPIValidationError{}
+
 // Validate checks the field values on Logger with the rules defined in the
 // proto definition for this message. If any rules are violated, an error is returned.
 func (m *Logger) Validate() error {
@@ -899,13 +1586,175 @@ func (e ServiceValidationError) Reason() string { return e.reason }
 func (e ServiceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ServiceValidationError) Key() bool { return e.key }
+func (e ServiceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServiceValidationError) ErrorName() string { return "ServiceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ServiceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sService.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServiceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServiceValidationError{}
+
+// Validate checks the field values on Resolver with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Resolver) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetName()) < 1 {
+		return ResolverValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
+
+	if v, ok := interface{}(m.GetTypedConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResolverValidationError{
+				field:  "TypedConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ResolverValidationError is the validation error returned by
+// Resolver.Validate if the designated constraints aren't met.
+type ResolverValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolverValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolverValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolverValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolverValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolverValidationError) ErrorName() string { return "ResolverValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResolverValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolver.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolverValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolverValidationError{}
+
+// Validate checks the field values on Module with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Module) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetName()) < 1 {
+		return ModuleValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
+
+	if v, ok := interface{}(m.GetTypedConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModuleValidationError{
+				field:  "TypedConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ModuleValidationError is the validation error returned by Module.Validate if
+// the designated constraints aren't met.
+type ModuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModuleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ServiceValidationError) ErrorName() string { return "ServiceValidationError" }
+func (e ModuleValidationError) ErrorName() string { return "ModuleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ServiceValidationError) Error() string {
+func (e ModuleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -917,14 +1766,14 @@ func (e ServiceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sService.%s: %s%s",
+		"invalid %sModule.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ServiceValidationError{}
+var _ error = ModuleValidationError{}
 
 var _ interface {
 	Field() string
@@ -932,38 +1781,29 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ServiceValidationError{}
+} = ModuleValidationError{}
 
-// Validate checks the field values on Resolver with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Resolver) Validate() error {
+// Validate checks the field values on SecretProvider_File with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SecretProvider_File) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if len(m.GetName()) < 1 {
-		return ResolverValidationError{
-			field:  "Name",
+	if len(m.GetDirectory()) < 1 {
+		return SecretProvider_FileValidationError{
+			field:  "Directory",
 			reason: "value length must be at least 1 bytes",
 		}
 	}
 
-	if v, ok := interface{}(m.GetTypedConfig()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ResolverValidationError{
-				field:  "TypedConfig",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	return nil
 }
 
-// ResolverValidationError is the validation error returned by
-// Resolver.Validate if the designated constraints aren't met.
-type ResolverValidationError struct {
+// SecretProvider_FileValidationError is the validation error returned by
+// SecretProvider_File.Validate if the designated constraints aren't met.
+type SecretProvider_FileValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -971,22 +1811,24 @@ type ResolverValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResolverValidationError) Field() string { return e.field }
+func (e SecretProvider_FileValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResolverValidationError) Reason() string { return e.reason }
+func (e SecretProvider_FileValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResolverValidationError) Cause() error { return e.cause }
+func (e SecretProvider_FileValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResolverValidationError) Key() bool { return e.key }
+func (e SecretProvider_FileValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResolverValidationError) ErrorName() string { return "ResolverValidationError" }
+func (e SecretProvider_FileValidationError) ErrorName() string {
+	return "SecretProvider_FileValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ResolverValidationError) Error() string {
+func (e SecretProvider_FileValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -998,14 +1840,14 @@ func (e ResolverValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResolver.%s: %s%s",
+		"invalid %sSecretProvider_File.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResolverValidationError{}
+var _ error = SecretProvider_FileValidationError{}
 
 var _ interface {
 	Field() string
@@ -1013,38 +1855,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResolverValidationError{}
+} = SecretProvider_FileValidationError{}
 
-// Validate checks the field values on Module with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Module) Validate() error {
+// Validate checks the field values on SecretProvider_Exec with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SecretProvider_Exec) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if len(m.GetName()) < 1 {
-		return ModuleValidationError{
-			field:  "Name",
+	if len(m.GetCommand()) < 1 {
+		return SecretProvider_ExecValidationError{
+			field:  "Command",
 			reason: "value length must be at least 1 bytes",
 		}
 	}
 
-	if v, ok := interface{}(m.GetTypedConfig()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ModuleValidationError{
-				field:  "TypedConfig",
-				reason: "embedded message failed validation",
+	if d := m.GetTimeout(); d != nil {
+		dur, err := ptypes.Duration(d)
+		if err != nil {
+			return SecretProvider_ExecValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
 				cause:  err,
 			}
 		}
+
+		gte := time.Duration(1*time.Second + 0*time.Nanosecond)
+
+		if dur < gte {
+			return SecretProvider_ExecValidationError{
+				field:  "Timeout",
+				reason: "value must be greater than or equal to 1s",
+			}
+		}
+
 	}
 
 	return nil
 }
 
-// ModuleValidationError is the validation error returned by Module.Validate if
-// the designated constraints aren't met.
-type ModuleValidationError struct {
+// SecretProvider_ExecValidationError is the validation error returned by
+// SecretProvider_Exec.Validate if the designated constraints aren't met.
+type SecretProvider_ExecValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1052,22 +1906,24 @@ type ModuleValidationError struct {
 }
 
 // Field function returns field value.
-func (e ModuleValidationError) Field() string { return e.field }
+func (e SecretProvider_ExecValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ModuleValidationError) Reason() string { return e.reason }
+func (e SecretProvider_ExecValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ModuleValidationError) Cause() error { return e.cause }
+func (e SecretProvider_ExecValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ModuleValidationError) Key() bool { return e.key }
+func (e SecretProvider_ExecValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ModuleValidationError) ErrorName() string { return "ModuleValidationError" }
+func (e SecretProvider_ExecValidationError) ErrorName() string {
+	return "SecretProvider_ExecValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ModuleValidationError) Error() string {
+func (e SecretProvider_ExecValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1079,14 +1935,14 @@ func (e ModuleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sModule.%s: %s%s",
+		"invalid %sSecretProvider_Exec.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ModuleValidationError{}
+var _ error = SecretProvider_ExecValidationError{}
 
 var _ interface {
 	Field() string
@@ -1094,7 +1950,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ModuleValidationError{}
+} = SecretProvider_ExecValidationError{}
 
 // Validate checks the field values on Stats_LogReporter with the rules defined
 // in the proto definition for this message. If any rules are violated, an
@@ -1248,6 +2104,77 @@ var _ interface {
 	ErrorName() string
 } = Stats_StatsdReporterValidationError{}
 
+// Validate checks the field values on Stats_PrometheusReporter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Stats_PrometheusReporter) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Path
+
+	// no validation rules for TimerType
+
+	return nil
+}
+
+// Stats_PrometheusReporterValidationError is the validation error returned by
+// Stats_PrometheusReporter.Validate if the designated constraints aren't met.
+type Stats_PrometheusReporterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Stats_PrometheusReporterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Stats_PrometheusReporterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Stats_PrometheusReporterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Stats_PrometheusReporterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Stats_PrometheusReporterValidationError) ErrorName() string {
+	return "Stats_PrometheusReporterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Stats_PrometheusReporterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStats_PrometheusReporter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Stats_PrometheusReporterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Stats_PrometheusReporterValidationError{}
+
 // Validate checks the field values on Stats_StatsdReporter_PointTags with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -1419,3 +2346,158 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = Timeouts_EntryValidationError{}
+
+// Validate checks the field values on Tracing_OTLPExporter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Tracing_OTLPExporter) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetEndpoint()) < 1 {
+		return Tracing_OTLPExporterValidationError{
+			field:  "Endpoint",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
+
+	// no validation rules for Headers
+
+	if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Tracing_OTLPExporterValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// Tracing_OTLPExporterValidationError is the validation error returned by
+// Tracing_OTLPExporter.Validate if the designated constraints aren't met.
+type Tracing_OTLPExporterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Tracing_OTLPExporterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Tracing_OTLPExporterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Tracing_OTLPExporterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Tracing_OTLPExporterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Tracing_OTLPExporterValidationError) ErrorName() string {
+	return "Tracing_OTLPExporterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Tracing_OTLPExporterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTracing_OTLPExporter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Tracing_OTLPExporterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Tracing_OTLPExporterValidationError{}
+
+// Validate checks the field values on Tracing_FileExporter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Tracing_FileExporter) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Path
+
+	return nil
+}
+
+// Tracing_FileExporterValidationError is the validation error returned by
+// Tracing_FileExporter.Validate if the designated constraints aren't met.
+type Tracing_FileExporterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Tracing_FileExporterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Tracing_FileExporterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Tracing_FileExporterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Tracing_FileExporterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Tracing_FileExporterValidationError) ErrorName() string {
+	return "Tracing_FileExporterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Tracing_FileExporterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTracing_FileExporter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Tracing_FileExporterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Tracing_FileExporterValidationError{}
@@ -0,0 +1,200 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.4
+// source: config/middleware/accesslog/v1/accesslog.proto
+
+package accesslogv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	proto "github.com/golang/protobuf/proto"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The fraction of requests that are logged, between 0 and 1. If not specified, all requests are logged.
+	SampleRatio *wrappers.DoubleValue `protobuf:"bytes,1,opt,name=sample_ratio,json=sampleRatio,proto3" json:"sample_ratio,omitempty"`
+	// The full methods in the format of `/SERVICE/METHOD` that are logged. Wildcards are allowed, e.g. `/SERVICE/*`. If
+	// left empty, all methods are logged.
+	IncludeMethods []string `protobuf:"bytes,2,rep,name=include_methods,json=includeMethods,proto3" json:"include_methods,omitempty"`
+	// The full methods that are not logged, e.g. `/clutch.healthcheck.v1.HealthcheckAPI/*`. Wildcards are allowed.
+	// Exclusions take precedence over inclusions.
+	ExcludeMethods []string `protobuf:"bytes,3,rep,name=exclude_methods,json=excludeMethods,proto3" json:"exclude_methods,omitempty"`
+	// If true, requests that fail are logged regardless of the sample ratio, as long as their method is logged.
+	AlwaysLogErrors bool `protobuf:"varint,4,opt,name=always_log_errors,json=alwaysLogErrors,proto3" json:"always_log_errors,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_middleware_accesslog_v1_accesslog_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_config_middleware_accesslog_v1_accesslog_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_config_middleware_accesslog_v1_accesslog_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetSampleRatio() *wrappers.DoubleValue {
+	if x != nil {
+		return x.SampleRatio
+	}
+	return nil
+}
+
+func (x *Config) GetIncludeMethods() []string {
+	if x != nil {
+		return x.IncludeMethods
+	}
+	return nil
+}
+
+func (x *Config) GetExcludeMethods() []string {
+	if x != nil {
+		return x.ExcludeMethods
+	}
+	return nil
+}
+
+func (x *Config) GetAlwaysLogErrors() bool {
+	if x != nil {
+		return x.AlwaysLogErrors
+	}
+	return false
+}
+
+var File_config_middleware_accesslog_v1_accesslog_proto protoreflect.FileDescriptor
+
+var file_config_middleware_accesslog_v1_accesslog_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
+	0x61, 0x72, 0x65, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6c, 0x6f, 0x67, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x25, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x6c, 0x6f, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xe0, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x58, 0x0a, 0x0c, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
+	0x17, 0xfa, 0x42, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x29,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6c, 0x77, 0x61, 0x79,
+	0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x4c, 0x6f, 0x67, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x42, 0x0d, 0x5a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6c, 0x6f, 0x67,
+	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_config_middleware_accesslog_v1_accesslog_proto_rawDescOnce sync.Once
+	file_config_middleware_accesslog_v1_accesslog_proto_rawDescData = file_config_middleware_accesslog_v1_accesslog_proto_rawDesc
+)
+
+func file_config_middleware_accesslog_v1_accesslog_proto_rawDescGZIP() []byte {
+	file_config_middleware_accesslog_v1_accesslog_proto_rawDescOnce.Do(func() {
+		file_config_middleware_accesslog_v1_accesslog_proto_rawDescData = protoimpl.X.CompressGZIP(file_config_middleware_accesslog_v1_accesslog_proto_rawDescData)
+	})
+	return file_config_middleware_accesslog_v1_accesslog_proto_rawDescData
+}
+
+var file_config_middleware_accesslog_v1_accesslog_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_config_middleware_accesslog_v1_accesslog_proto_goTypes = []interface{}{
+	(*Config)(nil),               // 0: clutch.config.middleware.accesslog.v1.Config
+	(*wrappers.DoubleValue)(nil), // 1: google.protobuf.DoubleValue
+}
+var file_config_middleware_accesslog_v1_accesslog_proto_depIdxs = []int32{
+	1, // 0: clutch.config.middleware.accesslog.v1.Config.sample_ratio:type_name -> google.protobuf.DoubleValue
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_config_middleware_accesslog_v1_accesslog_proto_init() }
+func file_config_middleware_accesslog_v1_accesslog_proto_init() {
+	if File_config_middleware_accesslog_v1_accesslog_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_config_middleware_accesslog_v1_accesslog_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_config_middleware_accesslog_v1_accesslog_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_middleware_accesslog_v1_accesslog_proto_goTypes,
+		DependencyIndexes: file_config_middleware_accesslog_v1_accesslog_proto_depIdxs,
+		MessageInfos:      file_config_middleware_accesslog_v1_accesslog_proto_msgTypes,
+	}.Build()
+	File_config_middleware_accesslog_v1_accesslog_proto = out.File
+	file_config_middleware_accesslog_v1_accesslog_proto_rawDesc = nil
+	file_config_middleware_accesslog_v1_accesslog_proto_goTypes = nil
+	file_config_middleware_accesslog_v1_accesslog_proto_depIdxs = nil
+}
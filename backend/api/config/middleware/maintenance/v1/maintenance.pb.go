@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.4
+// source: config/middleware/maintenance/v1/maintenance.proto
+
+package maintenancev1
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The full methods in the format of `/SERVICE/METHOD` that are allowed during maintenance. Wildcards are allowed,
+	// e.g. `/SERVICE/*`.
+	ExemptMethods []string `protobuf:"bytes,1,rep,name=exempt_methods,json=exemptMethods,proto3" json:"exempt_methods,omitempty"`
+	// Members of these groups can make any request during maintenance.
+	ExemptGroups []string `protobuf:"bytes,2,rep,name=exempt_groups,json=exemptGroups,proto3" json:"exempt_groups,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_middleware_maintenance_v1_maintenance_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_config_middleware_maintenance_v1_maintenance_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_config_middleware_maintenance_v1_maintenance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetExemptMethods() []string {
+	if x != nil {
+		return x.ExemptMethods
+	}
+	return nil
+}
+
+func (x *Config) GetExemptGroups() []string {
+	if x != nil {
+		return x.ExemptGroups
+	}
+	return nil
+}
+
+var File_config_middleware_maintenance_v1_maintenance_proto protoreflect.FileDescriptor
+
+var file_config_middleware_maintenance_v1_maintenance_proto_rawDesc = []byte{
+	0x0a, 0x32, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
+	0x61, 0x72, 0x65, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x27, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x6d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x22, 0x54, 0x0a,
+	0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x65, 0x6d, 0x70,
+	0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x42, 0x0f, 0x5a, 0x0d, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_config_middleware_maintenance_v1_maintenance_proto_rawDescOnce sync.Once
+	file_config_middleware_maintenance_v1_maintenance_proto_rawDescData = file_config_middleware_maintenance_v1_maintenance_proto_rawDesc
+)
+
+func file_config_middleware_maintenance_v1_maintenance_proto_rawDescGZIP() []byte {
+	file_config_middleware_maintenance_v1_maintenance_proto_rawDescOnce.Do(func() {
+		file_config_middleware_maintenance_v1_maintenance_proto_rawDescData = protoimpl.X.CompressGZIP(file_config_middleware_maintenance_v1_maintenance_proto_rawDescData)
+	})
+	return file_config_middleware_maintenance_v1_maintenance_proto_rawDescData
+}
+
+var file_config_middleware_maintenance_v1_maintenance_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_config_middleware_maintenance_v1_maintenance_proto_goTypes = []interface{}{
+	(*Config)(nil), // 0: clutch.config.middleware.maintenance.v1.Config
+}
+var file_config_middleware_maintenance_v1_maintenance_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_config_middleware_maintenance_v1_maintenance_proto_init() }
+func file_config_middleware_maintenance_v1_maintenance_proto_init() {
+	if File_config_middleware_maintenance_v1_maintenance_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_config_middleware_maintenance_v1_maintenance_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_config_middleware_maintenance_v1_maintenance_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_middleware_maintenance_v1_maintenance_proto_goTypes,
+		DependencyIndexes: file_config_middleware_maintenance_v1_maintenance_proto_depIdxs,
+		MessageInfos:      file_config_middleware_maintenance_v1_maintenance_proto_msgTypes,
+	}.Build()
+	File_config_middleware_maintenance_v1_maintenance_proto = out.File
+	file_config_middleware_maintenance_v1_maintenance_proto_rawDesc = nil
+	file_config_middleware_maintenance_v1_maintenance_proto_goTypes = nil
+	file_config_middleware_maintenance_v1_maintenance_proto_depIdxs = nil
+}
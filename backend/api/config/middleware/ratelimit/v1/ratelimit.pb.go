@@ -0,0 +1,409 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.4
+// source: config/middleware/ratelimit/v1/ratelimit.proto
+
+package ratelimitv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	v1 "github.com/lyft/clutch/backend/api/api/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Limit_Key int32
+
+const (
+	// Each user has their own bucket. Unauthenticated callers share a bucket.
+	Limit_USER Limit_Key = 0
+	// The members of each group share a bucket. A request takes a token from the bucket of each of the caller's groups
+	// that the limit applies to.
+	Limit_GROUP Limit_Key = 1
+	// All callers share a bucket.
+	Limit_GLOBAL Limit_Key = 2
+)
+
+// Enum value maps for Limit_Key.
+var (
+	Limit_Key_name = map[int32]string{
+		0: "USER",
+		1: "GROUP",
+		2: "GLOBAL",
+	}
+	Limit_Key_value = map[string]int32{
+		"USER":   0,
+		"GROUP":  1,
+		"GLOBAL": 2,
+	}
+)
+
+func (x Limit_Key) Enum() *Limit_Key {
+	p := new(Limit_Key)
+	*p = x
+	return p
+}
+
+func (x Limit_Key) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Limit_Key) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_middleware_ratelimit_v1_ratelimit_proto_enumTypes[0].Descriptor()
+}
+
+func (Limit_Key) Type() protoreflect.EnumType {
+	return &file_config_middleware_ratelimit_v1_ratelimit_proto_enumTypes[0]
+}
+
+func (x Limit_Key) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Limit_Key.Descriptor instead.
+func (Limit_Key) EnumDescriptor() ([]byte, []int) {
+	return file_config_middleware_ratelimit_v1_ratelimit_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Limit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// For logging and stats purposes, give the limit a defined name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The full method in the format of `/SERVICE/METHOD` that the limit applies to. Wildcards are allowed, e.g. `*` or
+	// `/SERVICE/*`. If left empty, the limit applies to all methods.
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// The action types that the limit applies to. If left empty, the limit applies to all action types.
+	ActionTypes []v1.ActionType `protobuf:"varint,3,rep,packed,name=action_types,json=actionTypes,proto3,enum=clutch.api.v1.ActionType" json:"action_types,omitempty"`
+	// The users and groups that the limit applies to. If both are left empty, the limit applies to all callers, including
+	// unauthenticated callers.
+	Users  []string `protobuf:"bytes,4,rep,name=users,proto3" json:"users,omitempty"`
+	Groups []string `protobuf:"bytes,5,rep,name=groups,proto3" json:"groups,omitempty"`
+	// How requests are grouped into token buckets.
+	Key Limit_Key `protobuf:"varint,6,opt,name=key,proto3,enum=clutch.config.middleware.ratelimit.v1.Limit_Key" json:"key,omitempty"`
+	// If true, each method that the limit applies to has its own bucket, e.g. to limit every method to the same rate.
+	PerMethod bool `protobuf:"varint,7,opt,name=per_method,json=perMethod,proto3" json:"per_method,omitempty"`
+	// The number of requests allowed per interval, i.e. the rate at which tokens are added to a bucket.
+	Requests uint32             `protobuf:"varint,8,opt,name=requests,proto3" json:"requests,omitempty"`
+	Interval *duration.Duration `protobuf:"bytes,9,opt,name=interval,proto3" json:"interval,omitempty"`
+	// The maximum number of requests that can be made at once, i.e. the size of a bucket.
+	// If not specified, defaults to the number of requests per interval.
+	Burst uint32 `protobuf:"varint,10,opt,name=burst,proto3" json:"burst,omitempty"`
+}
+
+func (x *Limit) Reset() {
+	*x = Limit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_middleware_ratelimit_v1_ratelimit_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Limit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Limit) ProtoMessage() {}
+
+func (x *Limit) ProtoReflect() protoreflect.Message {
+	mi := &file_config_middleware_ratelimit_v1_ratelimit_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Limit.ProtoReflect.Descriptor instead.
+func (*Limit) Descriptor() ([]byte, []int) {
+	return file_config_middleware_ratelimit_v1_ratelimit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Limit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Limit) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Limit) GetActionTypes() []v1.ActionType {
+	if x != nil {
+		return x.ActionTypes
+	}
+	return nil
+}
+
+func (x *Limit) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *Limit) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *Limit) GetKey() Limit_Key {
+	if x != nil {
+		return x.Key
+	}
+	return Limit_USER
+}
+
+func (x *Limit) GetPerMethod() bool {
+	if x != nil {
+		return x.PerMethod
+	}
+	return false
+}
+
+func (x *Limit) GetRequests() uint32 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *Limit) GetInterval() *duration.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Limit) GetBurst() uint32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limits []*Limit `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
+	// The name of a service that stores the token buckets, e.g. so that limits are shared between gateway instances. The
+	// service must implement the ratelimit middleware's Backend interface. If not specified, buckets are stored in
+	// memory.
+	BackendService string `protobuf:"bytes,2,opt,name=backend_service,json=backendService,proto3" json:"backend_service,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_middleware_ratelimit_v1_ratelimit_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_config_middleware_ratelimit_v1_ratelimit_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_config_middleware_ratelimit_v1_ratelimit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Config) GetLimits() []*Limit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *Config) GetBackendService() string {
+	if x != nil {
+		return x.BackendService
+	}
+	return ""
+}
+
+var File_config_middleware_ratelimit_v1_ratelimit_proto protoreflect.FileDescriptor
+
+var file_config_middleware_ratelimit_v1_ratelimit_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
+	0x61, 0x72, 0x65, 0x2f, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x25, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x72, 0x61, 0x74, 0x65, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x13, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbf, 0x03, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x3c, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x6c, 0x75,
+	0x74, 0x63, 0x68, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x12, 0x4c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x6d, 0x69,
+	0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x4b, 0x65, 0x79, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x70, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x23, 0x0a,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x28, 0x01, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x45, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x0e, 0xfa, 0x42, 0x0b, 0xaa, 0x01, 0x08, 0x08, 0x01, 0x32, 0x04, 0x10, 0xc0, 0x84, 0x3d, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x72,
+	0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x22,
+	0x26, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x47,
+	0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x10, 0x02, 0x22, 0x81, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x4e, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x72, 0x61,
+	0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x0d, 0x5a, 0x0b, 0x72,
+	0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_config_middleware_ratelimit_v1_ratelimit_proto_rawDescOnce sync.Once
+	file_config_middleware_ratelimit_v1_ratelimit_proto_rawDescData = file_config_middleware_ratelimit_v1_ratelimit_proto_rawDesc
+)
+
+func file_config_middleware_ratelimit_v1_ratelimit_proto_rawDescGZIP() []byte {
+	file_config_middleware_ratelimit_v1_ratelimit_proto_rawDescOnce.Do(func() {
+		file_config_middleware_ratelimit_v1_ratelimit_proto_rawDescData = protoimpl.X.CompressGZIP(file_config_middleware_ratelimit_v1_ratelimit_proto_rawDescData)
+	})
+	return file_config_middleware_ratelimit_v1_ratelimit_proto_rawDescData
+}
+
+var file_config_middleware_ratelimit_v1_ratelimit_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_config_middleware_ratelimit_v1_ratelimit_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_config_middleware_ratelimit_v1_ratelimit_proto_goTypes = []interface{}{
+	(Limit_Key)(0),            // 0: clutch.config.middleware.ratelimit.v1.Limit.Key
+	(*Limit)(nil),             // 1: clutch.config.middleware.ratelimit.v1.Limit
+	(*Config)(nil),            // 2: clutch.config.middleware.ratelimit.v1.Config
+	(v1.ActionType)(0),        // 3: clutch.api.v1.ActionType
+	(*duration.Duration)(nil), // 4: google.protobuf.Duration
+}
+var file_config_middleware_ratelimit_v1_ratelimit_proto_depIdxs = []int32{
+	3, // 0: clutch.config.middleware.ratelimit.v1.Limit.action_types:type_name -> clutch.api.v1.ActionType
+	0, // 1: clutch.config.middleware.ratelimit.v1.Limit.key:type_name -> clutch.config.middleware.ratelimit.v1.Limit.Key
+	4, // 2: clutch.config.middleware.ratelimit.v1.Limit.interval:type_name -> google.protobuf.Duration
+	1, // 3: clutch.config.middleware.ratelimit.v1.Config.limits:type_name -> clutch.config.middleware.ratelimit.v1.Limit
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_config_middleware_ratelimit_v1_ratelimit_proto_init() }
+func file_config_middleware_ratelimit_v1_ratelimit_proto_init() {
+	if File_config_middleware_ratelimit_v1_ratelimit_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_config_middleware_ratelimit_v1_ratelimit_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Limit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_middleware_ratelimit_v1_ratelimit_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_config_middleware_ratelimit_v1_ratelimit_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_middleware_ratelimit_v1_ratelimit_proto_goTypes,
+		DependencyIndexes: file_config_middleware_ratelimit_v1_ratelimit_proto_depIdxs,
+		EnumInfos:         file_config_middleware_ratelimit_v1_ratelimit_proto_enumTypes,
+		MessageInfos:      file_config_middleware_ratelimit_v1_ratelimit_proto_msgTypes,
+	}.Build()
+	File_config_middleware_ratelimit_v1_ratelimit_proto = out.File
+	file_config_middleware_ratelimit_v1_ratelimit_proto_rawDesc = nil
+	file_config_middleware_ratelimit_v1_ratelimit_proto_goTypes = nil
+	file_config_middleware_ratelimit_v1_ratelimit_proto_depIdxs = nil
+}
@@ -0,0 +1,244 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: config/middleware/ratelimit/v1/ratelimit.proto
+
+package ratelimitv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/ptypes"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = ptypes.DynamicAny{}
+)
+
+// define the regex for a UUID once up-front
+var _ratelimit_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on Limit with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Limit) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetName()) < 1 {
+		return LimitValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
+
+	// no validation rules for Method
+
+	if _, ok := Limit_Key_name[int32(m.GetKey())]; !ok {
+		return LimitValidationError{
+			field:  "Key",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	// no validation rules for PerMethod
+
+	if m.GetRequests() < 1 {
+		return LimitValidationError{
+			field:  "Requests",
+			reason: "value must be greater than or equal to 1",
+		}
+	}
+
+	if m.GetInterval() == nil {
+		return LimitValidationError{
+			field:  "Interval",
+			reason: "value is required",
+		}
+	}
+
+	if d := m.GetInterval(); d != nil {
+		dur, err := ptypes.Duration(d)
+		if err != nil {
+			return LimitValidationError{
+				field:  "Interval",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+		}
+
+		gte := time.Duration(0*time.Second + 1000000*time.Nanosecond)
+
+		if dur < gte {
+			return LimitValidationError{
+				field:  "Interval",
+				reason: "value must be greater than or equal to 1ms",
+			}
+		}
+
+	}
+
+	// no validation rules for Burst
+
+	return nil
+}
+
+// LimitValidationError is the validation error returned by Limit.Validate if
+// the designated constraints aren't met.
+type LimitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LimitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LimitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LimitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LimitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LimitValidationError) ErrorName() string { return "LimitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LimitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLimit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LimitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LimitValidationError{}
+
+// Validate checks the field values on Config with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Config) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetLimits()) < 1 {
+		return ConfigValidationError{
+			field:  "Limits",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetLimits() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  fmt.Sprintf("Limits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for BackendService
+
+	return nil
+}
+
+// ConfigValidationError is the validation error returned by Config.Validate if
+// the designated constraints aren't met.
+type ConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfigValidationError) ErrorName() string { return "ConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfigValidationError{}
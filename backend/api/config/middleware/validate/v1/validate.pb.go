@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.4
+// source: config/middleware/validate/v1/validate.proto
+
+package validatev1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Config_ResponseValidation int32
+
+const (
+	// Responses are not validated, the same as DISABLED.
+	Config_UNSPECIFIED Config_ResponseValidation = 0
+	// Responses are not validated.
+	Config_DISABLED Config_ResponseValidation = 1
+	// Responses that fail validation are logged and counted, but are still returned to the caller.
+	Config_WARN Config_ResponseValidation = 2
+)
+
+// Enum value maps for Config_ResponseValidation.
+var (
+	Config_ResponseValidation_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "DISABLED",
+		2: "WARN",
+	}
+	Config_ResponseValidation_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"DISABLED":    1,
+		"WARN":        2,
+	}
+)
+
+func (x Config_ResponseValidation) Enum() *Config_ResponseValidation {
+	p := new(Config_ResponseValidation)
+	*p = x
+	return p
+}
+
+func (x Config_ResponseValidation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Config_ResponseValidation) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_middleware_validate_v1_validate_proto_enumTypes[0].Descriptor()
+}
+
+func (Config_ResponseValidation) Type() protoreflect.EnumType {
+	return &file_config_middleware_validate_v1_validate_proto_enumTypes[0]
+}
+
+func (x Config_ResponseValidation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Config_ResponseValidation.Descriptor instead.
+func (Config_ResponseValidation) EnumDescriptor() ([]byte, []int) {
+	return file_config_middleware_validate_v1_validate_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How responses from modules are validated against the rules in their proto definition. Responses are not validated
+	// unless this is set. Requests that fail validation are always rejected.
+	ResponseValidation Config_ResponseValidation `protobuf:"varint,1,opt,name=response_validation,json=responseValidation,proto3,enum=clutch.config.middleware.validate.v1.Config_ResponseValidation" json:"response_validation,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_middleware_validate_v1_validate_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_config_middleware_validate_v1_validate_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_config_middleware_validate_v1_validate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetResponseValidation() Config_ResponseValidation {
+	if x != nil {
+		return x.ResponseValidation
+	}
+	return Config_UNSPECIFIED
+}
+
+var File_config_middleware_validate_v1_validate_proto protoreflect.FileDescriptor
+
+var file_config_middleware_validate_v1_validate_proto_rawDesc = []byte{
+	0x0a, 0x2c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
+	0x61, 0x72, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x2f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x24,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x6d, 0x69,
+	0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc3, 0x01,
+	0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x7a, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3f, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65,
+	0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x12, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44,
+	0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x41, 0x52,
+	0x4e, 0x10, 0x02, 0x42, 0x0c, 0x5a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x76,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_config_middleware_validate_v1_validate_proto_rawDescOnce sync.Once
+	file_config_middleware_validate_v1_validate_proto_rawDescData = file_config_middleware_validate_v1_validate_proto_rawDesc
+)
+
+func file_config_middleware_validate_v1_validate_proto_rawDescGZIP() []byte {
+	file_config_middleware_validate_v1_validate_proto_rawDescOnce.Do(func() {
+		file_config_middleware_validate_v1_validate_proto_rawDescData = protoimpl.X.CompressGZIP(file_config_middleware_validate_v1_validate_proto_rawDescData)
+	})
+	return file_config_middleware_validate_v1_validate_proto_rawDescData
+}
+
+var file_config_middleware_validate_v1_validate_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_config_middleware_validate_v1_validate_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_config_middleware_validate_v1_validate_proto_goTypes = []interface{}{
+	(Config_ResponseValidation)(0), // 0: clutch.config.middleware.validate.v1.Config.ResponseValidation
+	(*Config)(nil),                 // 1: clutch.config.middleware.validate.v1.Config
+}
+var file_config_middleware_validate_v1_validate_proto_depIdxs = []int32{
+	0, // 0: clutch.config.middleware.validate.v1.Config.response_validation:type_name -> clutch.config.middleware.validate.v1.Config.ResponseValidation
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_config_middleware_validate_v1_validate_proto_init() }
+func file_config_middleware_validate_v1_validate_proto_init() {
+	if File_config_middleware_validate_v1_validate_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_config_middleware_validate_v1_validate_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_config_middleware_validate_v1_validate_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_middleware_validate_v1_validate_proto_goTypes,
+		DependencyIndexes: file_config_middleware_validate_v1_validate_proto_depIdxs,
+		EnumInfos:         file_config_middleware_validate_v1_validate_proto_enumTypes,
+		MessageInfos:      file_config_middleware_validate_v1_validate_proto_msgTypes,
+	}.Build()
+	File_config_middleware_validate_v1_validate_proto = out.File
+	file_config_middleware_validate_v1_validate_proto_rawDesc = nil
+	file_config_middleware_validate_v1_validate_proto_goTypes = nil
+	file_config_middleware_validate_v1_validate_proto_depIdxs = nil
+}
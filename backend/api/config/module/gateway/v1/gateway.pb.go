@@ -0,0 +1,166 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.4
+// source: config/module/gateway/v1/gateway.proto
+
+package gatewayv1
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Serve the configuration currently in effect, with secrets redacted. It is disabled by default since it exposes
+	// details of the deployment, and access to it should be restricted with clutch.middleware.authz.
+	EnableConfig bool `protobuf:"varint,1,opt,name=enable_config,json=enableConfig,proto3" json:"enable_config,omitempty"`
+	// Allow log levels to be changed with UpdateLogLevel. It is disabled by default since it changes what the gateway
+	// logs, and access to it should be restricted with clutch.middleware.authz.
+	EnableLogLevelUpdates bool `protobuf:"varint,2,opt,name=enable_log_level_updates,json=enableLogLevelUpdates,proto3" json:"enable_log_level_updates,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_module_gateway_v1_gateway_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_config_module_gateway_v1_gateway_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_config_module_gateway_v1_gateway_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetEnableConfig() bool {
+	if x != nil {
+		return x.EnableConfig
+	}
+	return false
+}
+
+func (x *Config) GetEnableLogLevelUpdates() bool {
+	if x != nil {
+		return x.EnableLogLevelUpdates
+	}
+	return false
+}
+
+var File_config_module_gateway_v1_gateway_proto protoreflect.FileDescriptor
+
+var file_config_module_gateway_v1_gateway_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x22, 0x66, 0x0a, 0x06, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a, 0x18, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x42, 0x0b, 0x5a, 0x09, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_config_module_gateway_v1_gateway_proto_rawDescOnce sync.Once
+	file_config_module_gateway_v1_gateway_proto_rawDescData = file_config_module_gateway_v1_gateway_proto_rawDesc
+)
+
+func file_config_module_gateway_v1_gateway_proto_rawDescGZIP() []byte {
+	file_config_module_gateway_v1_gateway_proto_rawDescOnce.Do(func() {
+		file_config_module_gateway_v1_gateway_proto_rawDescData = protoimpl.X.CompressGZIP(file_config_module_gateway_v1_gateway_proto_rawDescData)
+	})
+	return file_config_module_gateway_v1_gateway_proto_rawDescData
+}
+
+var file_config_module_gateway_v1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_config_module_gateway_v1_gateway_proto_goTypes = []interface{}{
+	(*Config)(nil), // 0: clutch.config.module.gateway.v1.Config
+}
+var file_config_module_gateway_v1_gateway_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_config_module_gateway_v1_gateway_proto_init() }
+func file_config_module_gateway_v1_gateway_proto_init() {
+	if File_config_module_gateway_v1_gateway_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_config_module_gateway_v1_gateway_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_config_module_gateway_v1_gateway_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_module_gateway_v1_gateway_proto_goTypes,
+		DependencyIndexes: file_config_module_gateway_v1_gateway_proto_depIdxs,
+		MessageInfos:      file_config_module_gateway_v1_gateway_proto_msgTypes,
+	}.Build()
+	File_config_module_gateway_v1_gateway_proto = out.File
+	file_config_module_gateway_v1_gateway_proto_rawDesc = nil
+	file_config_module_gateway_v1_gateway_proto_goTypes = nil
+	file_config_module_gateway_v1_gateway_proto_depIdxs = nil
+}
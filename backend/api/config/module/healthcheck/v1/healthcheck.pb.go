@@ -0,0 +1,192 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.4
+// source: config/module/healthcheck/v1/healthcheck.proto
+
+package healthcheckv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How long the results of readiness checks are reused before the services are checked again.
+	// If not specified, defaults to 10s.
+	CacheTtl *duration.Duration `protobuf:"bytes,1,opt,name=cache_ttl,json=cacheTtl,proto3" json:"cache_ttl,omitempty"`
+	// How long each service has to complete its checks before they are reported as failed.
+	// If not specified, defaults to 5s.
+	Timeout *duration.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Checks that the gateway is only ready while they pass, by service name (e.g. `clutch.service.db.postgres`) or by
+	// check name (e.g. `clutch.service.k8s/production`). Other checks are reported without affecting readiness, so that
+	// an outage of an upstream system doesn't take the gateway out of service.
+	CriticalChecks []string `protobuf:"bytes,4,rep,name=critical_checks,json=criticalChecks,proto3" json:"critical_checks,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_module_healthcheck_v1_healthcheck_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_config_module_healthcheck_v1_healthcheck_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_config_module_healthcheck_v1_healthcheck_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetCacheTtl() *duration.Duration {
+	if x != nil {
+		return x.CacheTtl
+	}
+	return nil
+}
+
+func (x *Config) GetTimeout() *duration.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Config) GetCriticalChecks() []string {
+	if x != nil {
+		return x.CriticalChecks
+	}
+	return nil
+}
+
+var File_config_module_healthcheck_v1_healthcheck_proto protoreflect.FileDescriptor
+
+var file_config_module_healthcheck_v1_healthcheck_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x23, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc3,
+	0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x36, 0x0a, 0x09, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x63, 0x61, 0x63, 0x68, 0x65, 0x54, 0x74,
+	0x6c, 0x12, 0x3d, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0xaa, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x72, 0x69, 0x74, 0x69,
+	0x63, 0x61, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52,
+	0x13, 0x6e, 0x6f, 0x6e, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x42, 0x0f, 0x5a, 0x0d, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_config_module_healthcheck_v1_healthcheck_proto_rawDescOnce sync.Once
+	file_config_module_healthcheck_v1_healthcheck_proto_rawDescData = file_config_module_healthcheck_v1_healthcheck_proto_rawDesc
+)
+
+func file_config_module_healthcheck_v1_healthcheck_proto_rawDescGZIP() []byte {
+	file_config_module_healthcheck_v1_healthcheck_proto_rawDescOnce.Do(func() {
+		file_config_module_healthcheck_v1_healthcheck_proto_rawDescData = protoimpl.X.CompressGZIP(file_config_module_healthcheck_v1_healthcheck_proto_rawDescData)
+	})
+	return file_config_module_healthcheck_v1_healthcheck_proto_rawDescData
+}
+
+var file_config_module_healthcheck_v1_healthcheck_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_config_module_healthcheck_v1_healthcheck_proto_goTypes = []interface{}{
+	(*Config)(nil),            // 0: clutch.config.module.healthcheck.v1.Config
+	(*duration.Duration)(nil), // 1: google.protobuf.Duration
+}
+var file_config_module_healthcheck_v1_healthcheck_proto_depIdxs = []int32{
+	1, // 0: clutch.config.module.healthcheck.v1.Config.cache_ttl:type_name -> google.protobuf.Duration
+	1, // 1: clutch.config.module.healthcheck.v1.Config.timeout:type_name -> google.protobuf.Duration
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_config_module_healthcheck_v1_healthcheck_proto_init() }
+func file_config_module_healthcheck_v1_healthcheck_proto_init() {
+	if File_config_module_healthcheck_v1_healthcheck_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_config_module_healthcheck_v1_healthcheck_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_config_module_healthcheck_v1_healthcheck_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_module_healthcheck_v1_healthcheck_proto_goTypes,
+		DependencyIndexes: file_config_module_healthcheck_v1_healthcheck_proto_depIdxs,
+		MessageInfos:      file_config_module_healthcheck_v1_healthcheck_proto_msgTypes,
+	}.Build()
+	File_config_module_healthcheck_v1_healthcheck_proto = out.File
+	file_config_module_healthcheck_v1_healthcheck_proto_rawDesc = nil
+	file_config_module_healthcheck_v1_healthcheck_proto_goTypes = nil
+	file_config_module_healthcheck_v1_healthcheck_proto_depIdxs = nil
+}
@@ -9,6 +9,7 @@ package authnv1
 import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -26,6 +27,58 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type ClientCertificate_SubjectAltName int32
+
+const (
+	ClientCertificate_UNSPECIFIED ClientCertificate_SubjectAltName = 0
+	ClientCertificate_URI         ClientCertificate_SubjectAltName = 1
+	ClientCertificate_DNS         ClientCertificate_SubjectAltName = 2
+	ClientCertificate_EMAIL       ClientCertificate_SubjectAltName = 3
+)
+
+// Enum value maps for ClientCertificate_SubjectAltName.
+var (
+	ClientCertificate_SubjectAltName_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "URI",
+		2: "DNS",
+		3: "EMAIL",
+	}
+	ClientCertificate_SubjectAltName_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"URI":         1,
+		"DNS":         2,
+		"EMAIL":       3,
+	}
+)
+
+func (x ClientCertificate_SubjectAltName) Enum() *ClientCertificate_SubjectAltName {
+	p := new(ClientCertificate_SubjectAltName)
+	*p = x
+	return p
+}
+
+func (x ClientCertificate_SubjectAltName) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClientCertificate_SubjectAltName) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_service_authn_v1_authn_proto_enumTypes[0].Descriptor()
+}
+
+func (ClientCertificate_SubjectAltName) Type() protoreflect.EnumType {
+	return &file_config_service_authn_v1_authn_proto_enumTypes[0]
+}
+
+func (x ClientCertificate_SubjectAltName) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClientCertificate_SubjectAltName.Descriptor instead.
+func (ClientCertificate_SubjectAltName) EnumDescriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{3, 0}
+}
+
 type OIDC struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -35,6 +88,17 @@ type OIDC struct {
 	ClientId     string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	ClientSecret string `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
 	RedirectUrl  string `protobuf:"bytes,4,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	// Scopes to request in addition to openid and email, e.g. groups or profile.
+	Scopes []string `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// The claim in the ID token holding the subject, e.g. "preferred_username". Nested claims are separated with dots.
+	// Defaults to email.
+	SubjectClaim string `protobuf:"bytes,6,opt,name=subject_claim,json=subjectClaim,proto3" json:"subject_claim,omitempty"`
+	// The claim in the ID token holding the user's groups, either as a list or a single string, e.g. "groups" or
+	// "realm_access.roles". Nested claims are separated with dots. If empty, users have no groups.
+	GroupsClaim string `protobuf:"bytes,7,opt,name=groups_claim,json=groupsClaim,proto3" json:"groups_claim,omitempty"`
+	// Rules to rewrite group names, e.g. to strip a prefix. Each group is rewritten by the first rule that matches it, and
+	// is kept as is if none do. Groups rewritten to an empty string are dropped.
+	GroupRewrites []*GroupRewrite `protobuf:"bytes,8,rep,name=group_rewrites,json=groupRewrites,proto3" json:"group_rewrites,omitempty"`
 }
 
 func (x *OIDC) Reset() {
@@ -97,6 +161,487 @@ func (x *OIDC) GetRedirectUrl() string {
 	return ""
 }
 
+func (x *OIDC) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OIDC) GetSubjectClaim() string {
+	if x != nil {
+		return x.SubjectClaim
+	}
+	return ""
+}
+
+func (x *OIDC) GetGroupsClaim() string {
+	if x != nil {
+		return x.GroupsClaim
+	}
+	return ""
+}
+
+func (x *OIDC) GetGroupRewrites() []*GroupRewrite {
+	if x != nil {
+		return x.GroupRewrites
+	}
+	return nil
+}
+
+type GroupRewrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A regular expression matched against the group name.
+	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// The replacement for the matched text, which can refer to submatches with $1, $2, etc.
+	Replacement string `protobuf:"bytes,2,opt,name=replacement,proto3" json:"replacement,omitempty"`
+}
+
+func (x *GroupRewrite) Reset() {
+	*x = GroupRewrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupRewrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRewrite) ProtoMessage() {}
+
+func (x *GroupRewrite) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRewrite.ProtoReflect.Descriptor instead.
+func (*GroupRewrite) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GroupRewrite) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *GroupRewrite) GetReplacement() string {
+	if x != nil {
+		return x.Replacement
+	}
+	return ""
+}
+
+// Authenticates requests with headers set by an identity-aware proxy in front of Clutch.
+type TrustedHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The header holding the subject, e.g. X-Forwarded-Email.
+	SubjectHeader string `protobuf:"bytes,1,opt,name=subject_header,json=subjectHeader,proto3" json:"subject_header,omitempty"`
+	// The header holding the user's groups, e.g. X-Forwarded-Groups. If empty, users have no groups.
+	GroupsHeader string `protobuf:"bytes,2,opt,name=groups_header,json=groupsHeader,proto3" json:"groups_header,omitempty"`
+	// Separates the groups in the groups header. Defaults to a comma.
+	GroupsSeparator string `protobuf:"bytes,3,opt,name=groups_separator,json=groupsSeparator,proto3" json:"groups_separator,omitempty"`
+	// The source ranges the headers are accepted from, e.g. 10.0.0.0/8, which should only include the proxy. Requests from
+	// other addresses that set the subject header are rejected.
+	AllowedCidrs []string `protobuf:"bytes,4,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"`
+}
+
+func (x *TrustedHeader) Reset() {
+	*x = TrustedHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrustedHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustedHeader) ProtoMessage() {}
+
+func (x *TrustedHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustedHeader.ProtoReflect.Descriptor instead.
+func (*TrustedHeader) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TrustedHeader) GetSubjectHeader() string {
+	if x != nil {
+		return x.SubjectHeader
+	}
+	return ""
+}
+
+func (x *TrustedHeader) GetGroupsHeader() string {
+	if x != nil {
+		return x.GroupsHeader
+	}
+	return ""
+}
+
+func (x *TrustedHeader) GetGroupsSeparator() string {
+	if x != nil {
+		return x.GroupsSeparator
+	}
+	return ""
+}
+
+func (x *TrustedHeader) GetAllowedCidrs() []string {
+	if x != nil {
+		return x.AllowedCidrs
+	}
+	return nil
+}
+
+// Authenticates requests with the client certificate verified by the gateway's TLS config, e.g. from automation using
+// mTLS.
+type ClientCertificate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The subject alternative name of the certificate used as the subject. Defaults to URI, e.g. a SPIFFE ID.
+	SubjectAltName ClientCertificate_SubjectAltName `protobuf:"varint,1,opt,name=subject_alt_name,json=subjectAltName,proto3,enum=clutch.config.service.authn.v1.ClientCertificate_SubjectAltName" json:"subject_alt_name,omitempty"`
+	// The groups given to every client that authenticates with a certificate.
+	Groups []string `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *ClientCertificate) Reset() {
+	*x = ClientCertificate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientCertificate) ProtoMessage() {}
+
+func (x *ClientCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientCertificate.ProtoReflect.Descriptor instead.
+func (*ClientCertificate) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ClientCertificate) GetSubjectAltName() ClientCertificate_SubjectAltName {
+	if x != nil {
+		return x.SubjectAltName
+	}
+	return ClientCertificate_UNSPECIFIED
+}
+
+func (x *ClientCertificate) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type Provider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//	*Provider_Oidc
+	//	*Provider_TrustedHeader
+	//	*Provider_ClientCertificate
+	Type isProvider_Type `protobuf_oneof:"type"`
+}
+
+func (x *Provider) Reset() {
+	*x = Provider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Provider) ProtoMessage() {}
+
+func (x *Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
+func (*Provider) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *Provider) GetType() isProvider_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *Provider) GetOidc() *OIDC {
+	if x, ok := x.GetType().(*Provider_Oidc); ok {
+		return x.Oidc
+	}
+	return nil
+}
+
+func (x *Provider) GetTrustedHeader() *TrustedHeader {
+	if x, ok := x.GetType().(*Provider_TrustedHeader); ok {
+		return x.TrustedHeader
+	}
+	return nil
+}
+
+func (x *Provider) GetClientCertificate() *ClientCertificate {
+	if x, ok := x.GetType().(*Provider_ClientCertificate); ok {
+		return x.ClientCertificate
+	}
+	return nil
+}
+
+type isProvider_Type interface {
+	isProvider_Type()
+}
+
+type Provider_Oidc struct {
+	Oidc *OIDC `protobuf:"bytes,1,opt,name=oidc,proto3,oneof"`
+}
+
+type Provider_TrustedHeader struct {
+	TrustedHeader *TrustedHeader `protobuf:"bytes,2,opt,name=trusted_header,json=trustedHeader,proto3,oneof"`
+}
+
+type Provider_ClientCertificate struct {
+	ClientCertificate *ClientCertificate `protobuf:"bytes,3,opt,name=client_certificate,json=clientCertificate,proto3,oneof"`
+}
+
+func (*Provider_Oidc) isProvider_Type() {}
+
+func (*Provider_TrustedHeader) isProvider_Type() {}
+
+func (*Provider_ClientCertificate) isProvider_Type() {}
+
+// Tries each provider in turn until one finds credentials on the request.
+type Chain struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// At most one provider can be OIDC, which handles logging in, API tokens, and sessions.
+	Providers []*Provider `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+}
+
+func (x *Chain) Reset() {
+	*x = Chain{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Chain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chain) ProtoMessage() {}
+
+func (x *Chain) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chain.ProtoReflect.Descriptor instead.
+func (*Chain) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Chain) GetProviders() []*Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+type APITokens struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the database service where the IDs of issued tokens are stored, e.g. clutch.service.db.postgres.
+	DbProvider string `protobuf:"bytes,1,opt,name=db_provider,json=dbProvider,proto3" json:"db_provider,omitempty"`
+	// The longest lifetime a token can be created with. Defaults to 365 days.
+	MaxTtl *duration.Duration `protobuf:"bytes,2,opt,name=max_ttl,json=maxTtl,proto3" json:"max_ttl,omitempty"`
+	// Members of these groups can create tokens for other subjects and groups, and list and revoke the tokens of every
+	// subject. Other users can only manage tokens that authenticate as themselves or that they created.
+	AdminGroups []string `protobuf:"bytes,3,rep,name=admin_groups,json=adminGroups,proto3" json:"admin_groups,omitempty"`
+}
+
+func (x *APITokens) Reset() {
+	*x = APITokens{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APITokens) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APITokens) ProtoMessage() {}
+
+func (x *APITokens) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APITokens.ProtoReflect.Descriptor instead.
+func (*APITokens) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *APITokens) GetDbProvider() string {
+	if x != nil {
+		return x.DbProvider
+	}
+	return ""
+}
+
+func (x *APITokens) GetMaxTtl() *duration.Duration {
+	if x != nil {
+		return x.MaxTtl
+	}
+	return nil
+}
+
+func (x *APITokens) GetAdminGroups() []string {
+	if x != nil {
+		return x.AdminGroups
+	}
+	return nil
+}
+
+type Sessions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the database service where refresh tokens and ended sessions are stored, e.g.
+	// clutch.service.db.postgres. Each gateway caches whether a session has ended for 10 seconds, so an ended session can
+	// be accepted by other gateways for that long.
+	DbProvider string `protobuf:"bytes,1,opt,name=db_provider,json=dbProvider,proto3" json:"db_provider,omitempty"`
+}
+
+func (x *Sessions) Reset() {
+	*x = Sessions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sessions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sessions) ProtoMessage() {}
+
+func (x *Sessions) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sessions.ProtoReflect.Descriptor instead.
+func (*Sessions) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Sessions) GetDbProvider() string {
+	if x != nil {
+		return x.DbProvider
+	}
+	return ""
+}
+
 type Config struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -106,13 +651,21 @@ type Config struct {
 	SessionSecret string `protobuf:"bytes,1,opt,name=session_secret,json=sessionSecret,proto3" json:"session_secret,omitempty"`
 	// Types that are assignable to Type:
 	//	*Config_Oidc
+	//	*Config_TrustedHeader
+	//	*Config_ClientCertificate
+	//	*Config_Chain
 	Type isConfig_Type `protobuf_oneof:"type"`
+	// Enables long-lived API tokens. If unset, only session tokens from the provider are accepted.
+	ApiTokens *APITokens `protobuf:"bytes,3,opt,name=api_tokens,json=apiTokens,proto3" json:"api_tokens,omitempty"`
+	// Enables renewing sessions with the refresh token from the provider, and rejecting tokens after logging out. If
+	// unset, sessions last until the token from the provider expires.
+	Sessions *Sessions `protobuf:"bytes,4,opt,name=sessions,proto3" json:"sessions,omitempty"`
 }
 
 func (x *Config) Reset() {
 	*x = Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_service_authn_v1_authn_proto_msgTypes[1]
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -125,7 +678,7 @@ func (x *Config) String() string {
 func (*Config) ProtoMessage() {}
 
 func (x *Config) ProtoReflect() protoreflect.Message {
-	mi := &file_config_service_authn_v1_authn_proto_msgTypes[1]
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -138,7 +691,7 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config.ProtoReflect.Descriptor instead.
 func (*Config) Descriptor() ([]byte, []int) {
-	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{1}
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Config) GetSessionSecret() string {
@@ -162,6 +715,41 @@ func (x *Config) GetOidc() *OIDC {
 	return nil
 }
 
+func (x *Config) GetTrustedHeader() *TrustedHeader {
+	if x, ok := x.GetType().(*Config_TrustedHeader); ok {
+		return x.TrustedHeader
+	}
+	return nil
+}
+
+func (x *Config) GetClientCertificate() *ClientCertificate {
+	if x, ok := x.GetType().(*Config_ClientCertificate); ok {
+		return x.ClientCertificate
+	}
+	return nil
+}
+
+func (x *Config) GetChain() *Chain {
+	if x, ok := x.GetType().(*Config_Chain); ok {
+		return x.Chain
+	}
+	return nil
+}
+
+func (x *Config) GetApiTokens() *APITokens {
+	if x != nil {
+		return x.ApiTokens
+	}
+	return nil
+}
+
+func (x *Config) GetSessions() *Sessions {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
 type isConfig_Type interface {
 	isConfig_Type()
 }
@@ -170,8 +758,26 @@ type Config_Oidc struct {
 	Oidc *OIDC `protobuf:"bytes,2,opt,name=oidc,proto3,oneof"`
 }
 
+type Config_TrustedHeader struct {
+	TrustedHeader *TrustedHeader `protobuf:"bytes,5,opt,name=trusted_header,json=trustedHeader,proto3,oneof"`
+}
+
+type Config_ClientCertificate struct {
+	ClientCertificate *ClientCertificate `protobuf:"bytes,6,opt,name=client_certificate,json=clientCertificate,proto3,oneof"`
+}
+
+type Config_Chain struct {
+	Chain *Chain `protobuf:"bytes,7,opt,name=chain,proto3,oneof"`
+}
+
 func (*Config_Oidc) isConfig_Type() {}
 
+func (*Config_TrustedHeader) isConfig_Type() {}
+
+func (*Config_ClientCertificate) isConfig_Type() {}
+
+func (*Config_Chain) isConfig_Type() {}
+
 var File_config_service_authn_v1_authn_proto protoreflect.FileDescriptor
 
 var file_config_service_authn_v1_authn_proto_rawDesc = []byte{
@@ -179,9 +785,11 @@ var file_config_service_authn_v1_authn_proto_rawDesc = []byte{
 	0x2f, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7,
-	0x01, 0x0a, 0x04, 0x4f, 0x49, 0x44, 0x43, 0x12, 0x1f, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x68, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdc,
+	0x02, 0x0a, 0x04, 0x4f, 0x49, 0x44, 0x43, 0x12, 0x1f, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01,
 	0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
 	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
@@ -191,16 +799,119 @@ var file_config_service_authn_v1_authn_proto_rawDesc = []byte{
 	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x0c,
 	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x22, 0x7c, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x2e, 0x0a, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
-	0x02, 0x20, 0x01, 0x52, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x12, 0x3a, 0x0a, 0x04, 0x6f, 0x69, 0x64, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x24, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5f,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x53, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x48, 0x00, 0x52, 0x04, 0x6f, 0x69, 0x64, 0x63, 0x42, 0x06,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x42, 0x09, 0x5a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x76,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x52, 0x0d,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x22, 0x53, 0x0a,
+	0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x21, 0x0a,
+	0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0xbe, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5f, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x5f, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x53, 0x65, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f,
+	0x63, 0x69, 0x64, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x69,
+	0x64, 0x72, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x11, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x74, 0x0a, 0x10, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x40, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x6c,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x0e, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x6c, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x3e, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x41, 0x6c, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x52,
+	0x49, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x4e, 0x53, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05,
+	0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x03, 0x22, 0x8f, 0x02, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x04, 0x6f, 0x69, 0x64, 0x63, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x48, 0x00, 0x52, 0x04, 0x6f, 0x69, 0x64, 0x63,
+	0x12, 0x56, 0x0a, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65,
+	0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x62, 0x0a, 0x12, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x59, 0x0a, 0x05, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x12, 0x50, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x09, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0b, 0x64, 0x62, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01,
+	0x52, 0x0a, 0x64, 0x62, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x07,
+	0x6d, 0x61, 0x78, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02,
+	0x2a, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x54, 0x74, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x34, 0x0a,
+	0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0b, 0x64, 0x62, 0x5f,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x0a, 0x64, 0x62, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x22, 0x87, 0x04, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e,
+	0x0a, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52,
+	0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3a,
+	0x0a, 0x04, 0x6f, 0x69, 0x64, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x49,
+	0x44, 0x43, 0x48, 0x00, 0x52, 0x04, 0x6f, 0x69, 0x64, 0x63, 0x12, 0x56, 0x0a, 0x0e, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x62, 0x0a, 0x12, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x48, 0x00, 0x52, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x05,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x48, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x50, 0x49, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x52, 0x09, 0x61, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12,
+	0x44, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x08, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x42, 0x09, 0x5a,
+	0x07, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -215,18 +926,40 @@ func file_config_service_authn_v1_authn_proto_rawDescGZIP() []byte {
 	return file_config_service_authn_v1_authn_proto_rawDescData
 }
 
-var file_config_service_authn_v1_authn_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_config_service_authn_v1_authn_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_config_service_authn_v1_authn_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_config_service_authn_v1_authn_proto_goTypes = []interface{}{
-	(*OIDC)(nil),   // 0: clutch.config.service.authn.v1.OIDC
-	(*Config)(nil), // 1: clutch.config.service.authn.v1.Config
+	(ClientCertificate_SubjectAltName)(0), // 0: clutch.config.service.authn.v1.ClientCertificate.SubjectAltName
+	(*OIDC)(nil),                          // 1: clutch.config.service.authn.v1.OIDC
+	(*GroupRewrite)(nil),                  // 2: clutch.config.service.authn.v1.GroupRewrite
+	(*TrustedHeader)(nil),                 // 3: clutch.config.service.authn.v1.TrustedHeader
+	(*ClientCertificate)(nil),             // 4: clutch.config.service.authn.v1.ClientCertificate
+	(*Provider)(nil),                      // 5: clutch.config.service.authn.v1.Provider
+	(*Chain)(nil),                         // 6: clutch.config.service.authn.v1.Chain
+	(*APITokens)(nil),                     // 7: clutch.config.service.authn.v1.APITokens
+	(*Sessions)(nil),                      // 8: clutch.config.service.authn.v1.Sessions
+	(*Config)(nil),                        // 9: clutch.config.service.authn.v1.Config
+	(*duration.Duration)(nil),             // 10: google.protobuf.Duration
 }
 var file_config_service_authn_v1_authn_proto_depIdxs = []int32{
-	0, // 0: clutch.config.service.authn.v1.Config.oidc:type_name -> clutch.config.service.authn.v1.OIDC
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2,  // 0: clutch.config.service.authn.v1.OIDC.group_rewrites:type_name -> clutch.config.service.authn.v1.GroupRewrite
+	0,  // 1: clutch.config.service.authn.v1.ClientCertificate.subject_alt_name:type_name -> clutch.config.service.authn.v1.ClientCertificate.SubjectAltName
+	1,  // 2: clutch.config.service.authn.v1.Provider.oidc:type_name -> clutch.config.service.authn.v1.OIDC
+	3,  // 3: clutch.config.service.authn.v1.Provider.trusted_header:type_name -> clutch.config.service.authn.v1.TrustedHeader
+	4,  // 4: clutch.config.service.authn.v1.Provider.client_certificate:type_name -> clutch.config.service.authn.v1.ClientCertificate
+	5,  // 5: clutch.config.service.authn.v1.Chain.providers:type_name -> clutch.config.service.authn.v1.Provider
+	10, // 6: clutch.config.service.authn.v1.APITokens.max_ttl:type_name -> google.protobuf.Duration
+	1,  // 7: clutch.config.service.authn.v1.Config.oidc:type_name -> clutch.config.service.authn.v1.OIDC
+	3,  // 8: clutch.config.service.authn.v1.Config.trusted_header:type_name -> clutch.config.service.authn.v1.TrustedHeader
+	4,  // 9: clutch.config.service.authn.v1.Config.client_certificate:type_name -> clutch.config.service.authn.v1.ClientCertificate
+	6,  // 10: clutch.config.service.authn.v1.Config.chain:type_name -> clutch.config.service.authn.v1.Chain
+	7,  // 11: clutch.config.service.authn.v1.Config.api_tokens:type_name -> clutch.config.service.authn.v1.APITokens
+	8,  // 12: clutch.config.service.authn.v1.Config.sessions:type_name -> clutch.config.service.authn.v1.Sessions
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_config_service_authn_v1_authn_proto_init() }
@@ -248,6 +981,90 @@ func file_config_service_authn_v1_authn_proto_init() {
 			}
 		}
 		file_config_service_authn_v1_authn_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRewrite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_authn_v1_authn_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrustedHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_authn_v1_authn_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientCertificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_authn_v1_authn_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Provider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_authn_v1_authn_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Chain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_authn_v1_authn_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APITokens); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_authn_v1_authn_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sessions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_authn_v1_authn_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Config); i {
 			case 0:
 				return &v.state
@@ -260,21 +1077,30 @@ func file_config_service_authn_v1_authn_proto_init() {
 			}
 		}
 	}
-	file_config_service_authn_v1_authn_proto_msgTypes[1].OneofWrappers = []interface{}{
+	file_config_service_authn_v1_authn_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*Provider_Oidc)(nil),
+		(*Provider_TrustedHeader)(nil),
+		(*Provider_ClientCertificate)(nil),
+	}
+	file_config_service_authn_v1_authn_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*Config_Oidc)(nil),
+		(*Config_TrustedHeader)(nil),
+		(*Config_ClientCertificate)(nil),
+		(*Config_Chain)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_service_authn_v1_authn_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_config_service_authn_v1_authn_proto_goTypes,
 		DependencyIndexes: file_config_service_authn_v1_authn_proto_depIdxs,
+		EnumInfos:         file_config_service_authn_v1_authn_proto_enumTypes,
 		MessageInfos:      file_config_service_authn_v1_authn_proto_msgTypes,
 	}.Build()
 	File_config_service_authn_v1_authn_proto = out.File
@@ -71,6 +71,25 @@ func (m *OIDC) Validate() error {
 		}
 	}
 
+	// no validation rules for SubjectClaim
+
+	// no validation rules for GroupsClaim
+
+	for idx, item := range m.GetGroupRewrites() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OIDCValidationError{
+					field:  fmt.Sprintf("GroupRewrites[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -128,6 +147,596 @@ var _ interface {
 	ErrorName() string
 } = OIDCValidationError{}
 
+// Validate checks the field values on GroupRewrite with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *GroupRewrite) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetPattern()) < 1 {
+		return GroupRewriteValidationError{
+			field:  "Pattern",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
+
+	// no validation rules for Replacement
+
+	return nil
+}
+
+// GroupRewriteValidationError is the validation error returned by
+// GroupRewrite.Validate if the designated constraints aren't met.
+type GroupRewriteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupRewriteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupRewriteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupRewriteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupRewriteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupRewriteValidationError) ErrorName() string { return "GroupRewriteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupRewriteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupRewrite.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupRewriteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupRewriteValidationError{}
+
+// Validate checks the field values on TrustedHeader with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *TrustedHeader) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetSubjectHeader()) < 1 {
+		return TrustedHeaderValidationError{
+			field:  "SubjectHeader",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
+
+	// no validation rules for GroupsHeader
+
+	// no validation rules for GroupsSeparator
+
+	if len(m.GetAllowedCidrs()) < 1 {
+		return TrustedHeaderValidationError{
+			field:  "AllowedCidrs",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	return nil
+}
+
+// TrustedHeaderValidationError is the validation error returned by
+// TrustedHeader.Validate if the designated constraints aren't met.
+type TrustedHeaderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrustedHeaderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrustedHeaderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrustedHeaderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrustedHeaderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrustedHeaderValidationError) ErrorName() string { return "TrustedHeaderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrustedHeaderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrustedHeader.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrustedHeaderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrustedHeaderValidationError{}
+
+// Validate checks the field values on ClientCertificate with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ClientCertificate) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if _, ok := ClientCertificate_SubjectAltName_name[int32(m.GetSubjectAltName())]; !ok {
+		return ClientCertificateValidationError{
+			field:  "SubjectAltName",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	return nil
+}
+
+// ClientCertificateValidationError is the validation error returned by
+// ClientCertificate.Validate if the designated constraints aren't met.
+type ClientCertificateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClientCertificateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClientCertificateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClientCertificateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClientCertificateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClientCertificateValidationError) ErrorName() string {
+	return "ClientCertificateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClientCertificateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClientCertificate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClientCertificateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClientCertificateValidationError{}
+
+// Validate checks the field values on Provider with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Provider) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	switch m.Type.(type) {
+
+	case *Provider_Oidc:
+
+		if v, ok := interface{}(m.GetOidc()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProviderValidationError{
+					field:  "Oidc",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Provider_TrustedHeader:
+
+		if v, ok := interface{}(m.GetTrustedHeader()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProviderValidationError{
+					field:  "TrustedHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Provider_ClientCertificate:
+
+		if v, ok := interface{}(m.GetClientCertificate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProviderValidationError{
+					field:  "ClientCertificate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		return ProviderValidationError{
+			field:  "Type",
+			reason: "value is required",
+		}
+
+	}
+
+	return nil
+}
+
+// ProviderValidationError is the validation error returned by
+// Provider.Validate if the designated constraints aren't met.
+type ProviderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProviderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProviderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProviderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProviderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProviderValidationError) ErrorName() string { return "ProviderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProviderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProvider.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProviderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProviderValidationError{}
+
+// Validate checks the field values on Chain with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Chain) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetProviders()) < 1 {
+		return ChainValidationError{
+			field:  "Providers",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetProviders() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChainValidationError{
+					field:  fmt.Sprintf("Providers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ChainValidationError is the validation error returned by Chain.Validate if
+// the designated constraints aren't met.
+type ChainValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChainValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChainValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChainValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChainValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChainValidationError) ErrorName() string { return "ChainValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChainValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChain.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChainValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChainValidationError{}
+
+// Validate checks the field values on APITokens with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *APITokens) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetDbProvider()) < 1 {
+		return APITokensValidationError{
+			field:  "DbProvider",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
+
+	if d := m.GetMaxTtl(); d != nil {
+		dur, err := ptypes.Duration(d)
+		if err != nil {
+			return APITokensValidationError{
+				field:  "MaxTtl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+		}
+
+		gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+		if dur <= gt {
+			return APITokensValidationError{
+				field:  "MaxTtl",
+				reason: "value must be greater than 0s",
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// APITokensValidationError is the validation error returned by
+// APITokens.Validate if the designated constraints aren't met.
+type APITokensValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APITokensValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APITokensValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APITokensValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APITokensValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APITokensValidationError) ErrorName() string { return "APITokensValidationError" }
+
+// Error satisfies the builtin error interface
+func (e APITokensValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPITokens.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APITokensValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APITokensValidationError{}
+
+// Validate checks the field values on Sessions with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Sessions) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetDbProvider()) < 1 {
+		return SessionsValidationError{
+			field:  "DbProvider",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
+
+	return nil
+}
+
+// SessionsValidationError is the validation error returned by
+// Sessions.Validate if the designated constraints aren't met.
+type SessionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SessionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SessionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SessionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SessionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SessionsValidationError) ErrorName() string { return "SessionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SessionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSessions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SessionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SessionsValidationError{}
+
 // Validate checks the field values on Config with the rules defined in the
 // proto definition for this message. If any rules are violated, an error is returned.
 func (m *Config) Validate() error {
@@ -142,6 +751,26 @@ func (m *Config) Validate() error {
 		}
 	}
 
+	if v, ok := interface{}(m.GetApiTokens()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "ApiTokens",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetSessions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Sessions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	switch m.Type.(type) {
 
 	case *Config_Oidc:
@@ -156,6 +785,42 @@ func (m *Config) Validate() error {
 			}
 		}
 
+	case *Config_TrustedHeader:
+
+		if v, ok := interface{}(m.GetTrustedHeader()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  "TrustedHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Config_ClientCertificate:
+
+		if v, ok := interface{}(m.GetClientCertificate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  "ClientCertificate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Config_Chain:
+
+		if v, ok := interface{}(m.GetChain()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  "Chain",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
 	return nil
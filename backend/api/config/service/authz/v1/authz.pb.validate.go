@@ -393,6 +393,98 @@ var _ interface {
 	ErrorName() string
 } = RoleValidationError{}
 
+// Validate checks the field values on Database with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Database) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetDbProvider()) < 1 {
+		return DatabaseValidationError{
+			field:  "DbProvider",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
+
+	if d := m.GetRefreshInterval(); d != nil {
+		dur, err := ptypes.Duration(d)
+		if err != nil {
+			return DatabaseValidationError{
+				field:  "RefreshInterval",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+		}
+
+		gte := time.Duration(1*time.Second + 0*time.Nanosecond)
+
+		if dur < gte {
+			return DatabaseValidationError{
+				field:  "RefreshInterval",
+				reason: "value must be greater than or equal to 1s",
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// DatabaseValidationError is the validation error returned by
+// Database.Validate if the designated constraints aren't met.
+type DatabaseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DatabaseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DatabaseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DatabaseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DatabaseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DatabaseValidationError) ErrorName() string { return "DatabaseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DatabaseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDatabase.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DatabaseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DatabaseValidationError{}
+
 // Validate checks the field values on Config with the rules defined in the
 // proto definition for this message. If any rules are violated, an error is returned.
 func (m *Config) Validate() error {
@@ -430,6 +522,16 @@ func (m *Config) Validate() error {
 
 	}
 
+	if v, ok := interface{}(m.GetDatabase()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Database",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
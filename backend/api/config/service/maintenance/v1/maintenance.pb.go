@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.4
+// source: config/service/maintenance/v1/maintenance.proto
+
+package maintenancev1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the database service that the state is persisted in, so that it is shared by all gateway instances and
+	// kept across restarts. If not specified, the state is kept in memory.
+	DbProvider string `protobuf:"bytes,1,opt,name=db_provider,json=dbProvider,proto3" json:"db_provider,omitempty"`
+	// How often the state is read from the database to pick up changes made by other gateway instances.
+	// If not specified, defaults to 10s.
+	RefreshInterval *duration.Duration `protobuf:"bytes,2,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refresh_interval,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_maintenance_v1_maintenance_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_maintenance_v1_maintenance_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_config_service_maintenance_v1_maintenance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetDbProvider() string {
+	if x != nil {
+		return x.DbProvider
+	}
+	return ""
+}
+
+func (x *Config) GetRefreshInterval() *duration.Duration {
+	if x != nil {
+		return x.RefreshInterval
+	}
+	return nil
+}
+
+var File_config_service_maintenance_v1_maintenance_proto protoreflect.FileDescriptor
+
+var file_config_service_maintenance_v1_maintenance_proto_rawDesc = []byte{
+	0x0a, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f,
+	0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x24, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x88, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x62, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x64, 0x62, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x10,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0xaa, 0x01, 0x04, 0x32, 0x02, 0x08, 0x01, 0x52, 0x0f, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4a, 0x04,
+	0x08, 0x03, 0x10, 0x04, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69, 0x74, 0x42, 0x0f, 0x5a, 0x0d, 0x6d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_config_service_maintenance_v1_maintenance_proto_rawDescOnce sync.Once
+	file_config_service_maintenance_v1_maintenance_proto_rawDescData = file_config_service_maintenance_v1_maintenance_proto_rawDesc
+)
+
+func file_config_service_maintenance_v1_maintenance_proto_rawDescGZIP() []byte {
+	file_config_service_maintenance_v1_maintenance_proto_rawDescOnce.Do(func() {
+		file_config_service_maintenance_v1_maintenance_proto_rawDescData = protoimpl.X.CompressGZIP(file_config_service_maintenance_v1_maintenance_proto_rawDescData)
+	})
+	return file_config_service_maintenance_v1_maintenance_proto_rawDescData
+}
+
+var file_config_service_maintenance_v1_maintenance_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_config_service_maintenance_v1_maintenance_proto_goTypes = []interface{}{
+	(*Config)(nil),            // 0: clutch.config.service.maintenance.v1.Config
+	(*duration.Duration)(nil), // 1: google.protobuf.Duration
+}
+var file_config_service_maintenance_v1_maintenance_proto_depIdxs = []int32{
+	1, // 0: clutch.config.service.maintenance.v1.Config.refresh_interval:type_name -> google.protobuf.Duration
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_config_service_maintenance_v1_maintenance_proto_init() }
+func file_config_service_maintenance_v1_maintenance_proto_init() {
+	if File_config_service_maintenance_v1_maintenance_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_config_service_maintenance_v1_maintenance_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_config_service_maintenance_v1_maintenance_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_service_maintenance_v1_maintenance_proto_goTypes,
+		DependencyIndexes: file_config_service_maintenance_v1_maintenance_proto_depIdxs,
+		MessageInfos:      file_config_service_maintenance_v1_maintenance_proto_msgTypes,
+	}.Build()
+	File_config_service_maintenance_v1_maintenance_proto = out.File
+	file_config_service_maintenance_v1_maintenance_proto_rawDesc = nil
+	file_config_service_maintenance_v1_maintenance_proto_goTypes = nil
+	file_config_service_maintenance_v1_maintenance_proto_depIdxs = nil
+}
@@ -0,0 +1,1284 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: gateway/v1/gateway.proto
+
+package gatewayv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/ptypes"
+
+	v1 "github.com/lyft/clutch/backend/api/api/v1"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = ptypes.DynamicAny{}
+
+	_ = v1.ActionType(0)
+)
+
+// define the regex for a UUID once up-front
+var _gateway_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on Component with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Component) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for TypeUrl
+
+	return nil
+}
+
+// ComponentValidationError is the validation error returned by
+// Component.Validate if the designated constraints aren't met.
+type ComponentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ComponentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ComponentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ComponentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ComponentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ComponentValidationError) ErrorName() string { return "ComponentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ComponentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComponent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ComponentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ComponentValidationError{}
+
+// Validate checks the field values on GetComponentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetComponentsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// GetComponentsRequestValidationError is the validation error returned by
+// GetComponentsRequest.Validate if the designated constraints aren't met.
+type GetComponentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetComponentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetComponentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetComponentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetComponentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetComponentsRequestValidationError) ErrorName() string {
+	return "GetComponentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetComponentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetComponentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetComponentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetComponentsRequestValidationError{}
+
+// Validate checks the field values on GetComponentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetComponentsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetServices() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetComponentsResponseValidationError{
+					field:  fmt.Sprintf("Services[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetResolvers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetComponentsResponseValidationError{
+					field:  fmt.Sprintf("Resolvers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMiddleware() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetComponentsResponseValidationError{
+					field:  fmt.Sprintf("Middleware[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetModules() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetComponentsResponseValidationError{
+					field:  fmt.Sprintf("Modules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetComponentsResponseValidationError is the validation error returned by
+// GetComponentsResponse.Validate if the designated constraints aren't met.
+type GetComponentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetComponentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetComponentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetComponentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetComponentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetComponentsResponseValidationError) ErrorName() string {
+	return "GetComponentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetComponentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetComponentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetComponentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetComponentsResponseValidationError{}
+
+// Validate checks the field values on GetConfigRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GetConfigRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// GetConfigRequestValidationError is the validation error returned by
+// GetConfigRequest.Validate if the designated constraints aren't met.
+type GetConfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConfigRequestValidationError) ErrorName() string { return "GetConfigRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetConfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConfigRequestValidationError{}
+
+// Validate checks the field values on GetConfigResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GetConfigResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetConfigResponseValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetConfigResponseValidationError is the validation error returned by
+// GetConfigResponse.Validate if the designated constraints aren't met.
+type GetConfigResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConfigResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConfigResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConfigResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConfigResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConfigResponseValidationError) ErrorName() string {
+	return "GetConfigResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConfigResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConfigResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConfigResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConfigResponseValidationError{}
+
+// Validate checks the field values on Method with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Method) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for ActionType
+
+	return nil
+}
+
+// MethodValidationError is the validation error returned by Method.Validate if
+// the designated constraints aren't met.
+type MethodValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MethodValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MethodValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MethodValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MethodValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MethodValidationError) ErrorName() string { return "MethodValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MethodValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMethod.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MethodValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MethodValidationError{}
+
+// Validate checks the field values on GetMethodsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GetMethodsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// GetMethodsRequestValidationError is the validation error returned by
+// GetMethodsRequest.Validate if the designated constraints aren't met.
+type GetMethodsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMethodsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMethodsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMethodsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMethodsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMethodsRequestValidationError) ErrorName() string {
+	return "GetMethodsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMethodsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMethodsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMethodsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMethodsRequestValidationError{}
+
+// Validate checks the field values on GetMethodsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetMethodsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetMethods() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMethodsResponseValidationError{
+					field:  fmt.Sprintf("Methods[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetMethodsResponseValidationError is the validation error returned by
+// GetMethodsResponse.Validate if the designated constraints aren't met.
+type GetMethodsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMethodsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMethodsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMethodsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMethodsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMethodsResponseValidationError) ErrorName() string {
+	return "GetMethodsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMethodsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMethodsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMethodsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMethodsResponseValidationError{}
+
+// Validate checks the field values on Version with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Version) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Version
+
+	// no validation rules for ModuleVersion
+
+	// no validation rules for GoVersion
+
+	return nil
+}
+
+// VersionValidationError is the validation error returned by Version.Validate
+// if the designated constraints aren't met.
+type VersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VersionValidationError) ErrorName() string { return "VersionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVersion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VersionValidationError{}
+
+// Validate checks the field values on GetVersionRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GetVersionRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// GetVersionRequestValidationError is the validation error returned by
+// GetVersionRequest.Validate if the designated constraints aren't met.
+type GetVersionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVersionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVersionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVersionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVersionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVersionRequestValidationError) ErrorName() string {
+	return "GetVersionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetVersionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVersionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVersionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVersionRequestValidationError{}
+
+// Validate checks the field values on GetVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetVersionResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetVersionResponseValidationError{
+				field:  "Version",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetVersionResponseValidationError is the validation error returned by
+// GetVersionResponse.Validate if the designated constraints aren't met.
+type GetVersionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVersionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVersionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVersionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVersionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVersionResponseValidationError) ErrorName() string {
+	return "GetVersionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetVersionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVersionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVersionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVersionResponseValidationError{}
+
+// Validate checks the field values on LogLevel with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *LogLevel) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Component
+
+	// no validation rules for Level
+
+	// no validation rules for ConfiguredLevel
+
+	if v, ok := interface{}(m.GetRevertTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LogLevelValidationError{
+				field:  "RevertTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// LogLevelValidationError is the validation error returned by
+// LogLevel.Validate if the designated constraints aren't met.
+type LogLevelValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogLevelValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogLevelValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogLevelValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogLevelValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogLevelValidationError) ErrorName() string { return "LogLevelValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogLevelValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogLevel.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogLevelValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogLevelValidationError{}
+
+// Validate checks the field values on GetLogLevelsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetLogLevelsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// GetLogLevelsRequestValidationError is the validation error returned by
+// GetLogLevelsRequest.Validate if the designated constraints aren't met.
+type GetLogLevelsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLogLevelsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLogLevelsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLogLevelsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLogLevelsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLogLevelsRequestValidationError) ErrorName() string {
+	return "GetLogLevelsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLogLevelsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLogLevelsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLogLevelsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLogLevelsRequestValidationError{}
+
+// Validate checks the field values on GetLogLevelsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetLogLevelsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetLogLevels() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetLogLevelsResponseValidationError{
+					field:  fmt.Sprintf("LogLevels[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetLogLevelsResponseValidationError is the validation error returned by
+// GetLogLevelsResponse.Validate if the designated constraints aren't met.
+type GetLogLevelsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLogLevelsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLogLevelsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLogLevelsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLogLevelsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLogLevelsResponseValidationError) ErrorName() string {
+	return "GetLogLevelsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLogLevelsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLogLevelsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLogLevelsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLogLevelsResponseValidationError{}
+
+// Validate checks the field values on UpdateLogLevelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateLogLevelRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetComponent()) < 1 {
+		return UpdateLogLevelRequestValidationError{
+			field:  "Component",
+			reason: "value length must be at least 1 bytes",
+		}
+	}
+
+	if _, ok := LogLevel_Level_name[int32(m.GetLevel())]; !ok {
+		return UpdateLogLevelRequestValidationError{
+			field:  "Level",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	if d := m.GetTtl(); d != nil {
+		dur, err := ptypes.Duration(d)
+		if err != nil {
+			return UpdateLogLevelRequestValidationError{
+				field:  "Ttl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+		}
+
+		gte := time.Duration(1*time.Second + 0*time.Nanosecond)
+
+		if dur < gte {
+			return UpdateLogLevelRequestValidationError{
+				field:  "Ttl",
+				reason: "value must be greater than or equal to 1s",
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// UpdateLogLevelRequestValidationError is the validation error returned by
+// UpdateLogLevelRequest.Validate if the designated constraints aren't met.
+type UpdateLogLevelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateLogLevelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateLogLevelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateLogLevelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateLogLevelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateLogLevelRequestValidationError) ErrorName() string {
+	return "UpdateLogLevelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateLogLevelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateLogLevelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateLogLevelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateLogLevelRequestValidationError{}
+
+// Validate checks the field values on UpdateLogLevelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateLogLevelResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetLogLevel()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateLogLevelResponseValidationError{
+				field:  "LogLevel",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateLogLevelResponseValidationError is the validation error returned by
+// UpdateLogLevelResponse.Validate if the designated constraints aren't met.
+type UpdateLogLevelResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateLogLevelResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateLogLevelResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateLogLevelResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateLogLevelResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateLogLevelResponseValidationError) ErrorName() string {
+	return "UpdateLogLevelResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateLogLevelResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateLogLevelResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateLogLevelResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateLogLevelResponseValidationError{}
@@ -169,3 +169,234 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = HealthcheckResponseValidationError{}
+
+// Validate checks the field values on Check with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Check) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Status
+
+	// no validation rules for Message
+
+	// no validation rules for Critical
+
+	if v, ok := interface{}(m.GetCheckTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckValidationError{
+				field:  "CheckTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckValidationError is the validation error returned by Check.Validate if
+// the designated constraints aren't met.
+type CheckValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckValidationError) ErrorName() string { return "CheckValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CheckValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheck.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckValidationError{}
+
+// Validate checks the field values on ReadinessRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ReadinessRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// ReadinessRequestValidationError is the validation error returned by
+// ReadinessRequest.Validate if the designated constraints aren't met.
+type ReadinessRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadinessRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadinessRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadinessRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadinessRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadinessRequestValidationError) ErrorName() string { return "ReadinessRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReadinessRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadinessRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadinessRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadinessRequestValidationError{}
+
+// Validate checks the field values on ReadinessResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ReadinessResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Ready
+
+	for idx, item := range m.GetChecks() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadinessResponseValidationError{
+					field:  fmt.Sprintf("Checks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ReadinessResponseValidationError is the validation error returned by
+// ReadinessResponse.Validate if the designated constraints aren't met.
+type ReadinessResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadinessResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadinessResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadinessResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadinessResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadinessResponseValidationError) ErrorName() string {
+	return "ReadinessResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadinessResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadinessResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadinessResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadinessResponseValidationError{}
@@ -0,0 +1,415 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: maintenance/v1/maintenance.proto
+
+package maintenancev1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/ptypes"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = ptypes.DynamicAny{}
+)
+
+// define the regex for a UUID once up-front
+var _maintenance_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on Maintenance with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *Maintenance) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Enabled
+
+	// no validation rules for Message
+
+	// no validation rules for UpdatedBy
+
+	if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MaintenanceValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// MaintenanceValidationError is the validation error returned by
+// Maintenance.Validate if the designated constraints aren't met.
+type MaintenanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MaintenanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MaintenanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MaintenanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MaintenanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MaintenanceValidationError) ErrorName() string { return "MaintenanceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MaintenanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMaintenance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MaintenanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MaintenanceValidationError{}
+
+// Validate checks the field values on GetMaintenanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetMaintenanceRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// GetMaintenanceRequestValidationError is the validation error returned by
+// GetMaintenanceRequest.Validate if the designated constraints aren't met.
+type GetMaintenanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMaintenanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMaintenanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMaintenanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMaintenanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMaintenanceRequestValidationError) ErrorName() string {
+	return "GetMaintenanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMaintenanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMaintenanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMaintenanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMaintenanceRequestValidationError{}
+
+// Validate checks the field values on GetMaintenanceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetMaintenanceResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetMaintenance()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMaintenanceResponseValidationError{
+				field:  "Maintenance",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetMaintenanceResponseValidationError is the validation error returned by
+// GetMaintenanceResponse.Validate if the designated constraints aren't met.
+type GetMaintenanceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMaintenanceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMaintenanceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMaintenanceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMaintenanceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMaintenanceResponseValidationError) ErrorName() string {
+	return "GetMaintenanceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMaintenanceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMaintenanceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMaintenanceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMaintenanceResponseValidationError{}
+
+// Validate checks the field values on UpdateMaintenanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateMaintenanceRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Enabled
+
+	if utf8.RuneCountInString(m.GetMessage()) > 1024 {
+		return UpdateMaintenanceRequestValidationError{
+			field:  "Message",
+			reason: "value length must be at most 1024 runes",
+		}
+	}
+
+	return nil
+}
+
+// UpdateMaintenanceRequestValidationError is the validation error returned by
+// UpdateMaintenanceRequest.Validate if the designated constraints aren't met.
+type UpdateMaintenanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMaintenanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMaintenanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMaintenanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMaintenanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMaintenanceRequestValidationError) ErrorName() string {
+	return "UpdateMaintenanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMaintenanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMaintenanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMaintenanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMaintenanceRequestValidationError{}
+
+// Validate checks the field values on UpdateMaintenanceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateMaintenanceResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetMaintenance()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMaintenanceResponseValidationError{
+				field:  "Maintenance",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateMaintenanceResponseValidationError is the validation error returned by
+// UpdateMaintenanceResponse.Validate if the designated constraints aren't met.
+type UpdateMaintenanceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMaintenanceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMaintenanceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMaintenanceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMaintenanceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMaintenanceResponseValidationError) ErrorName() string {
+	return "UpdateMaintenanceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMaintenanceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMaintenanceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMaintenanceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMaintenanceResponseValidationError{}
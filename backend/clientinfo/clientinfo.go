@@ -0,0 +1,133 @@
+// Package clientinfo describes the client of a request, e.g. its address and certificate, whether it called the gRPC
+// server directly or through the JSON gateway.
+//
+// The JSON gateway reaches the gRPC server over a loopback connection, so the peer of those requests is the gateway
+// itself. The gateway forwards the client's address, certificate, and the headers that services ask for as metadata
+// instead, which is only trusted on requests that arrived over the in-memory loopback. Clients can't connect to it, so
+// the metadata can only have been added by the gateway.
+package clientinfo
+
+import (
+	"context"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// CertificateMetadataKey holds the verified certificate of clients of the JSON gateway, DER encoded.
+const CertificateMetadataKey = "x-clutch-client-certificate-bin"
+
+// The JSON gateway appends the address of the client to this header.
+const forwardedForMetadataKey = "x-forwarded-for"
+
+// Consumer is implemented by services that identify clients with the information the JSON gateway forwards, e.g. authn
+// providers that read the client's certificate or headers set by a trusted proxy. The gateway queries services once
+// they are instantiated, since the information can only be trusted over the in-memory loopback, and only the headers
+// that services ask for are forwarded.
+type Consumer interface {
+	// ClientInfo returns whether the service reads forwarded client information, and the headers it reads.
+	ClientInfo() (headers []string, ok bool)
+}
+
+type loopbackKey struct{}
+
+// LoopbackHandler marks requests served by the handler as coming from the JSON gateway. It must only wrap the handler
+// of the gateway's in-memory loopback server, and never a server listening on a socket, which clients could connect to
+// directly with forged metadata.
+func LoopbackHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), loopbackKey{}, true)))
+	})
+}
+
+// FromGateway returns true if the request came through the JSON gateway.
+func FromGateway(ctx context.Context) bool {
+	v, _ := ctx.Value(loopbackKey{}).(bool)
+	return v
+}
+
+// GatewayMetadata returns the metadata the JSON gateway adds to requests, which is the verified client certificate if
+// there is one.
+func GatewayMetadata(ctx context.Context, r *http.Request) metadata.MD {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return metadata.Pairs(CertificateMetadataKey, string(r.TLS.VerifiedChains[0][0].Raw))
+}
+
+// Address returns the IP address of the client, or nil if it is unknown, e.g. for clients connected over a unix
+// socket.
+func Address(ctx context.Context) net.IP {
+	if FromGateway(ctx) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		// Clients can set the header themselves, so only the address the gateway appended last is used.
+		v := md.Get(forwardedForMetadataKey)
+		if len(v) == 0 {
+			return nil
+		}
+		addrs := strings.Split(v[len(v)-1], ",")
+		return net.ParseIP(strings.TrimSpace(addrs[len(addrs)-1]))
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil
+	}
+	if addr, ok := p.Addr.(*net.TCPAddr); ok {
+		return addr.IP
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return nil
+	}
+	return net.ParseIP(host)
+}
+
+// Certificate returns the client certificate verified by the gateway's TLS config, or nil if the client didn't present
+// one. Certificates forwarded by the JSON gateway were verified when the client connected to it, so they are only
+// parsed.
+func Certificate(ctx context.Context) *x509.Certificate {
+	if FromGateway(ctx) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		v := md.Get(CertificateMetadataKey)
+		if len(v) == 0 {
+			return nil
+		}
+		cert, err := x509.ParseCertificate([]byte(v[len(v)-1]))
+		if err != nil {
+			return nil
+		}
+		return cert
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return info.State.VerifiedChains[0][0]
+}
+
+// Header returns the first value of a header sent by the client, or an empty string if it wasn't sent. Through the JSON
+// gateway, only the headers that services ask for with Consumer and those it forwards by default are available.
+func Header(ctx context.Context, name string) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	key := strings.ToLower(name)
+	// The JSON gateway forwards headers with a prefix, so that clients can't pass them off as metadata of their own.
+	if FromGateway(ctx) {
+		key = runtime.MetadataPrefix + key
+	}
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
@@ -0,0 +1,118 @@
+package clientinfo
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func newCertificate(t *testing.T) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"client.example.com"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	return cert
+}
+
+type stringAddr string
+
+func (a stringAddr) Network() string { return "tcp" }
+func (a stringAddr) String() string  { return string(a) }
+
+// gatewayContext returns the context of a request that came over the loopback with the metadata.
+func gatewayContext(md metadata.MD) context.Context {
+	var ctx context.Context
+	handler := LoopbackHandler(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) { ctx = r.Context() }))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil))
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+func TestFromGateway(t *testing.T) {
+	assert.False(t, FromGateway(context.Background()))
+	assert.True(t, FromGateway(gatewayContext(nil)))
+}
+
+func TestAddress(t *testing.T) {
+	assert.Nil(t, Address(context.Background()))
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234}})
+	assert.Equal(t, "10.0.0.1", Address(ctx).String())
+
+	// Requests served over HTTP have the address as a string.
+	ctx = peer.NewContext(context.Background(), &peer.Peer{Addr: stringAddr("10.0.0.2:1234")})
+	assert.Equal(t, "10.0.0.2", Address(ctx).String())
+
+	// Metadata is ignored unless the request came through the gateway.
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", "192.0.2.1"))
+	assert.Equal(t, "10.0.0.2", Address(ctx).String())
+
+	// The address the gateway appended last is used.
+	ctx = gatewayContext(metadata.Pairs("x-forwarded-for", "192.0.2.1", "x-forwarded-for", "192.0.2.2, 10.0.0.3"))
+	assert.Equal(t, "10.0.0.3", Address(ctx).String())
+
+	assert.Nil(t, Address(gatewayContext(nil)))
+}
+
+func TestCertificate(t *testing.T) {
+	cert := newCertificate(t)
+	assert.Nil(t, Certificate(context.Background()))
+
+	info := credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: info})
+	assert.Equal(t, cert, Certificate(ctx))
+
+	// Certificates that weren't verified are ignored.
+	info = credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}}
+	ctx = peer.NewContext(context.Background(), &peer.Peer{AuthInfo: info})
+	assert.Nil(t, Certificate(ctx))
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(CertificateMetadataKey, string(cert.Raw)))
+	assert.Nil(t, Certificate(ctx))
+
+	ctx = gatewayContext(metadata.Pairs(CertificateMetadataKey, string(cert.Raw)))
+	assert.Equal(t, cert.Raw, Certificate(ctx).Raw)
+
+	assert.Nil(t, Certificate(gatewayContext(metadata.Pairs(CertificateMetadataKey, "junk"))))
+}
+
+func TestGatewayMetadata(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	assert.Nil(t, GatewayMetadata(context.Background(), r))
+
+	cert := newCertificate(t)
+	r.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+	assert.Equal(t, []string{string(cert.Raw)}, GatewayMetadata(context.Background(), r).Get(CertificateMetadataKey))
+}
+
+func TestHeader(t *testing.T) {
+	md := metadata.Pairs("x-forwarded-email", "direct@example.com", "grpcgateway-x-forwarded-email", "gateway@example.com")
+
+	assert.Equal(t, "direct@example.com", Header(metadata.NewIncomingContext(context.Background(), md), "X-Forwarded-Email"))
+	assert.Equal(t, "gateway@example.com", Header(gatewayContext(md), "X-Forwarded-Email"))
+	assert.Equal(t, "", Header(gatewayContext(md), "X-Forwarded-Groups"))
+	assert.Equal(t, "", Header(context.Background(), "X-Forwarded-Email"))
+}
@@ -78,7 +78,7 @@ func Run() {
 		}
 	}
 	if sqlDB == nil {
-		logger.Fatal("no database found in config", zap.String("file", f.BaseFlags.ConfigPath))
+		logger.Fatal("no database found in config", zap.Strings("files", f.BaseFlags.ConfigPaths))
 	}
 
 	// Verify that user wants to continue (unless -f for force is passed as a flag).
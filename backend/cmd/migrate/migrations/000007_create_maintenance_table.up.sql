@@ -0,0 +1,15 @@
+CREATE TABLE maintenance (
+  -- id: The table holds a single row with the state shared by all gateway instances.
+  id integer PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+
+  -- enabled: Whether mutating requests are rejected.
+  enabled boolean NOT NULL DEFAULT FALSE,
+
+  -- message: Shown to users when their requests are rejected.
+  message text NOT NULL DEFAULT '',
+
+  -- updated_by: The user who last changed the state.
+  updated_by text NOT NULL DEFAULT '',
+
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
@@ -0,0 +1,25 @@
+CREATE TABLE authn_tokens (
+  -- id: The ID of the token, which is also the jti claim of the signed token.
+  id uuid PRIMARY KEY,
+
+  -- name: Describes what the token is used for.
+  name text NOT NULL,
+
+  -- subject, groups: The identity the token authenticates as.
+  subject text NOT NULL,
+  groups text[] NOT NULL DEFAULT '{}',
+
+  -- scopes: Patterns of the methods the token can call.
+  scopes text[] NOT NULL DEFAULT '{}',
+
+  -- created_by: The user who created the token.
+  created_by text NOT NULL,
+
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+  -- revoked_at: Set when the token is revoked, after which it is rejected.
+  revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX authn_tokens_subject_idx ON authn_tokens (subject);
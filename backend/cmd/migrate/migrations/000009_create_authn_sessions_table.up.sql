@@ -0,0 +1,15 @@
+CREATE TABLE authn_sessions (
+  -- id: The ID of the session, which is the jti claim of its tokens.
+  id uuid PRIMARY KEY,
+
+  subject text NOT NULL DEFAULT '',
+
+  -- refresh_token: The refresh token from the provider, encrypted with a key derived from the session secret.
+  refresh_token bytea,
+
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  refreshed_at TIMESTAMP WITH TIME ZONE,
+
+  -- revoked_at: Set when the user logs out, after which tokens of the session are rejected.
+  revoked_at TIMESTAMP WITH TIME ZONE
+);
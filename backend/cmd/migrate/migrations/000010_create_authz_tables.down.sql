@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS authz_role_bindings;
+DROP TABLE IF EXISTS authz_policies;
+DROP TABLE IF EXISTS authz_roles;
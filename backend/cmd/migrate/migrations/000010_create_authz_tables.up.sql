@@ -0,0 +1,46 @@
+CREATE TABLE authz_roles (
+  name text PRIMARY KEY,
+
+  -- version: The current version of the role's policies in authz_policies.
+  version bigint NOT NULL,
+
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+  -- updated_by: The user who last changed the role's policies.
+  updated_by text NOT NULL,
+
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE authz_policies (
+  role_name text NOT NULL REFERENCES authz_roles (name) ON DELETE CASCADE,
+  version bigint NOT NULL,
+
+  -- definition: The role with this version of its policies, in the same format as roles in the authz service config.
+  definition jsonb NOT NULL,
+
+  -- created_by: The user who created the version.
+  created_by text NOT NULL,
+
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+  PRIMARY KEY (role_name, version)
+);
+
+CREATE TABLE authz_role_bindings (
+  id uuid PRIMARY KEY,
+
+  -- role_name: Roles can't be deleted while they're bound.
+  role_name text NOT NULL REFERENCES authz_roles (name),
+
+  -- principal_type, principal: The user or group the role is bound to.
+  principal_type text NOT NULL CHECK (principal_type IN ('user', 'group')),
+  principal text NOT NULL,
+
+  -- created_by: The user who created the binding.
+  created_by text NOT NULL,
+
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+  UNIQUE (role_name, principal_type, principal)
+);
@@ -0,0 +1,6 @@
+ALTER TABLE authz_policies
+  DROP CONSTRAINT authz_policies_role_name_fkey,
+  ADD CONSTRAINT authz_policies_role_name_fkey FOREIGN KEY (role_name) REFERENCES authz_roles (name) ON DELETE CASCADE;
+
+DELETE FROM authz_roles WHERE deleted_at IS NOT NULL;
+ALTER TABLE authz_roles DROP COLUMN deleted_at;
@@ -0,0 +1,8 @@
+-- deleted_at: Set when the role is deleted. The role is kept so that the history of its policies is preserved, and a
+-- role created again with the same name continues from its last version.
+ALTER TABLE authz_roles ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+
+-- Policies are no longer removed along with their role.
+ALTER TABLE authz_policies
+  DROP CONSTRAINT authz_policies_role_name_fkey,
+  ADD CONSTRAINT authz_policies_role_name_fkey FOREIGN KEY (role_name) REFERENCES authz_roles (name);
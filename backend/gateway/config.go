@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"text/template"
 	"time"
 
@@ -18,20 +19,46 @@ import (
 	"github.com/golang/protobuf/ptypes/any"
 	durpb "github.com/golang/protobuf/ptypes/duration"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"gopkg.in/yaml.v3"
 
 	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	"github.com/lyft/clutch/backend/gateway/logging"
 )
 
 type Flags struct {
-	ConfigPath string
-	Template   bool
-	Validate   bool
+	// Configuration files are merged in order, so values in later files take precedence.
+	ConfigPaths []string
+	Template    bool
+	Validate    bool
+}
+
+type pathsFlag struct {
+	paths *[]string
+	set   bool
+}
+
+func (p *pathsFlag) String() string {
+	if p.paths == nil {
+		return ""
+	}
+	return strings.Join(*p.paths, ",")
+}
+
+// The first value replaces the default, subsequent values are appended.
+func (p *pathsFlag) Set(value string) error {
+	if !p.set {
+		*p.paths = nil
+		p.set = true
+	}
+	*p.paths = append(*p.paths, value)
+	return nil
 }
 
 // Link register the struct vars globally for parsing by the flag library.
 func (f *Flags) Link() {
-	flag.StringVar(&f.ConfigPath, "c", "clutch-config.yaml", "path to YAML configuration")
+	f.ConfigPaths = []string{"clutch-config.yaml"}
+	flag.Var(&pathsFlag{paths: &f.ConfigPaths}, "c", "path to YAML configuration, repeat to merge multiple files in order")
 	flag.BoolVar(&f.Template, "template", false, "executes go templates on the configuration file")
 	flag.BoolVar(&f.Validate, "validate", false, "validates the configuration file and exits")
 }
@@ -45,15 +72,18 @@ func ParseFlags() *Flags {
 }
 
 func MustReadOrValidateConfig(f *Flags) *gatewayv1.Config {
+	cfg, _ := mustLoadConfig(f)
+	return cfg
+}
+
+// mustLoadConfig is MustReadOrValidateConfig, also returning the files that were read as loadConfig does.
+func mustLoadConfig(f *Flags) (*gatewayv1.Config, []string) {
 	// Use a temporary logger to parse the configuration and output.
-	tmpLogger := newTmpLogger().With(zap.String("file", f.ConfigPath))
+	tmpLogger := newTmpLogger().With(zap.Strings("files", f.ConfigPaths))
 
-	var cfg gatewayv1.Config
-	if err := parseFile(f.ConfigPath, &cfg, f.Template); err != nil {
-		tmpLogger.Fatal("parsing configuration failed", zap.Error(err))
-	}
-	if err := cfg.Validate(); err != nil {
-		tmpLogger.Fatal("validating configuration failed", zap.Error(err))
+	cfg, files, err := loadConfig(f)
+	if err != nil {
+		tmpLogger.Fatal("reading configuration failed", zap.Error(err))
 	}
 
 	if f.Validate {
@@ -61,7 +91,37 @@ func MustReadOrValidateConfig(f *Flags) *gatewayv1.Config {
 		os.Exit(0)
 	}
 
-	return &cfg
+	return cfg, files
+}
+
+// readConfig parses and validates the configuration files.
+func readConfig(f *Flags) (*gatewayv1.Config, error) {
+	cfg, _, err := loadConfig(f)
+	return cfg, err
+}
+
+// loadConfig parses and validates the configuration files. All files that were read are returned as well, including
+// included files and secrets read from files, so that they can be watched for changes.
+func loadConfig(f *Flags) (*gatewayv1.Config, []string, error) {
+	l := &configLoader{template: f.Template}
+	merged := map[string]interface{}{}
+	for _, path := range f.ConfigPaths {
+		contents, err := l.load(path, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing configuration failed: %w", err)
+		}
+		merged = mergeYAML(merged, contents).(map[string]interface{})
+	}
+
+	var cfg gatewayv1.Config
+	secretFiles, err := decodeConfig(merged, &cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing configuration failed: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("validating configuration failed: %w", err)
+	}
+	return &cfg, append(l.files, secretFiles...), nil
 }
 
 func executeTemplate(contents []byte) ([]byte, error) {
@@ -85,40 +145,169 @@ func executeTemplate(contents []byte) ([]byte, error) {
 	return b.Bytes(), nil
 }
 
-func parseFile(path string, pb proto.Message, template bool) error {
+// Interpolate environment variables, leaving secret references to be resolved after the files are merged.
+func expandEnv(contents []byte) []byte {
+	return []byte(os.Expand(string(contents), func(name string) string {
+		if strings.HasPrefix(name, "secret:") {
+			return "${" + name + "}"
+		}
+		return os.Getenv(name)
+	}))
+}
+
+type configLoader struct {
+	template bool
+	// Every file read, in order.
+	files []string
+}
+
+// load reads a configuration file and the files it includes, returning the merged YAML.
+func (l *configLoader) load(path string, parents []string) (map[string]interface{}, error) {
 	// Get absolute path representation for better error message in case file not found.
 	path, err := filepath.Abs(path)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	for _, p := range parents {
+		if p == path {
+			return nil, fmt.Errorf("configuration file '%s' includes itself", path)
+		}
 	}
 
 	// Read file.
 	contents, err := ioutil.ReadFile(path)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	l.files = append(l.files, path)
 
 	// Execute templates if enabled.
-	if template {
+	if l.template {
 		contents, err = executeTemplate(contents)
 		if err != nil {
-			return err
+			return nil, err
+		}
+	}
+
+	// Decode YAML.
+	var rawConfig map[string]interface{}
+	if err := yaml.Unmarshal(expandEnv(contents), &rawConfig); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if rawConfig == nil {
+		rawConfig = map[string]interface{}{}
+	}
+
+	// Included files are merged in order, then this file on top of them.
+	includes, ok := rawConfig["includes"]
+	if !ok {
+		return rawConfig, nil
+	}
+	delete(rawConfig, "includes")
+
+	paths, ok := includes.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: includes must be a list of paths", path)
+	}
+	merged := map[string]interface{}{}
+	for _, include := range paths {
+		includePath, ok := include.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s: includes must be a list of paths", path)
+		}
+		if !filepath.IsAbs(includePath) {
+			includePath = filepath.Join(filepath.Dir(path), includePath)
+		}
+
+		included, err := l.load(includePath, append(parents, path))
+		if err != nil {
+			return nil, err
 		}
+		merged = mergeYAML(merged, included).(map[string]interface{})
 	}
+	return mergeYAML(merged, rawConfig).(map[string]interface{}), nil
+}
 
-	// Interpolate environment variables.
-	contents = []byte(os.ExpandEnv(string(contents)))
+// mergeYAML merges the overlay into the base. Maps are merged recursively. Lists of maps that all have a name (e.g.
+// services and modules) are merged by name, with new names appended. Any other value in the overlay replaces the base.
+func mergeYAML(base, overlay interface{}) interface{} {
+	switch o := overlay.(type) {
+	case map[string]interface{}:
+		b, ok := base.(map[string]interface{})
+		if !ok {
+			return o
+		}
+		for k, v := range o {
+			if existing, ok := b[k]; ok {
+				b[k] = mergeYAML(existing, v)
+			} else {
+				b[k] = v
+			}
+		}
+		return b
+	case []interface{}:
+		b, ok := base.([]interface{})
+		if !ok || !namedList(b) || !namedList(o) {
+			return o
+		}
+		for _, v := range o {
+			name := v.(map[string]interface{})["name"]
+			merged := false
+			for i, existing := range b {
+				if existing.(map[string]interface{})["name"] == name {
+					b[i] = mergeYAML(existing, v)
+					merged = true
+					break
+				}
+			}
+			if !merged {
+				b = append(b, v)
+			}
+		}
+		return b
+	default:
+		return overlay
+	}
+}
 
-	return parseYAML(contents, pb)
+func namedList(l []interface{}) bool {
+	for _, v := range l {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if _, ok := m["name"].(string); !ok {
+			return false
+		}
+	}
+	return true
 }
 
-func parseYAML(contents []byte, pb proto.Message) error {
-	// Decode YAML.
-	var rawConfig map[string]interface{}
-	if err := yaml.Unmarshal(contents, &rawConfig); err != nil {
-		return err
+// decodeConfig resolves secret references in the merged YAML and unmarshals it. The files read by file secret providers
+// are returned.
+func decodeConfig(rawConfig map[string]interface{}, pb *gatewayv1.Config) ([]string, error) {
+	// Providers are decoded first since they're needed to resolve the rest of the configuration.
+	var providers gatewayv1.Config
+	if p, ok := rawConfig["secret_providers"]; ok {
+		if err := decodeYAML(map[string]interface{}{"secret_providers": p}, &providers); err != nil {
+			return nil, err
+		}
+	}
+	resolver, err := newSecretResolver(providers.SecretProviders)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := resolver.resolve(rawConfig); err != nil {
+		return nil, err
+	}
+	if err := decodeYAML(rawConfig, pb); err != nil {
+		return nil, err
 	}
+	return resolver.files, nil
+}
 
+func decodeYAML(rawConfig map[string]interface{}, pb proto.Message) error {
 	// Encode YAML to JSON.
 	jsonBuffer := new(bytes.Buffer)
 	if err := json.NewEncoder(jsonBuffer).Encode(rawConfig); err != nil {
@@ -144,7 +333,9 @@ func duration(p *durpb.Duration) time.Duration {
 	return d
 }
 
-func newLogger(msg *gatewayv1.Logger) (*zap.Logger, error) {
+// newLogger returns a logger that is enabled for all levels, and the levels of the component loggers created from it
+// starting at the configured level.
+func newLogger(msg *gatewayv1.Logger) (*zap.Logger, *logging.Levels, error) {
 	var c zap.Config
 	var opts []zap.Option
 	if msg.GetPretty() {
@@ -154,19 +345,22 @@ func newLogger(msg *gatewayv1.Logger) (*zap.Logger, error) {
 		c = zap.NewProductionConfig()
 	}
 
-	level := zap.NewAtomicLevel()
-
 	levelName := "INFO"
 	if msg.Level != gatewayv1.Logger_UNSPECIFIED {
 		levelName = msg.Level.String()
 	}
 
+	var level zapcore.Level
 	if err := level.UnmarshalText([]byte(levelName)); err != nil {
-		return nil, fmt.Errorf("could not parse log level %s", msg.Level.String())
+		return nil, nil, fmt.Errorf("could not parse log level %s", msg.Level.String())
 	}
-	c.Level = level
+	c.Level = zap.NewAtomicLevelAt(zap.DebugLevel)
 
-	return c.Build(opts...)
+	logger, err := c.Build(opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return logger, logging.NewLevels(level), nil
 }
 
 func newTmpLogger() *zap.Logger {
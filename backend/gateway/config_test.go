@@ -2,12 +2,16 @@ package gateway
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
 
 	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	"github.com/lyft/clutch/backend/gateway/logging"
 )
 
 func TestExecuteTemplate(t *testing.T) {
@@ -59,9 +63,163 @@ func TestNewLogger(t *testing.T) {
 		tc := tc
 		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
 			t.Parallel()
-			l, err := newLogger(tc)
+			l, levels, err := newLogger(tc)
 			assert.NotNil(t, l)
 			assert.NoError(t, err)
+			// The base logger is enabled for all levels and the gateway's logger is filtered by the configured level.
+			assert.True(t, l.Core().Enabled(zap.DebugLevel))
+			logger := levels.Logger(l, logging.GatewayName)
+			assert.Equal(t, tc.Level == gatewayv1.Logger_INFO, logger.Core().Enabled(zap.InfoLevel))
+			assert.True(t, logger.Core().Enabled(zap.WarnLevel))
 		})
 	}
 }
+
+func TestMergeYAML(t *testing.T) {
+	base := map[string]interface{}{
+		"gateway": map[string]interface{}{
+			"listener": map[string]interface{}{"tcp": map[string]interface{}{"address": "0.0.0.0", "port": 8080}},
+		},
+		"services": []interface{}{
+			map[string]interface{}{"name": "a", "typed_config": map[string]interface{}{"foo": "bar", "baz": "qux"}},
+			map[string]interface{}{"name": "b"},
+		},
+		"roles": []interface{}{"one", "two"},
+	}
+	overlay := map[string]interface{}{
+		"gateway": map[string]interface{}{
+			"listener": map[string]interface{}{"tcp": map[string]interface{}{"port": 9090}},
+		},
+		"services": []interface{}{
+			map[string]interface{}{"name": "a", "typed_config": map[string]interface{}{"foo": "override"}},
+			map[string]interface{}{"name": "c"},
+		},
+		"roles": []interface{}{"three"},
+	}
+
+	expected := map[string]interface{}{
+		"gateway": map[string]interface{}{
+			"listener": map[string]interface{}{"tcp": map[string]interface{}{"address": "0.0.0.0", "port": 9090}},
+		},
+		"services": []interface{}{
+			map[string]interface{}{"name": "a", "typed_config": map[string]interface{}{"foo": "override", "baz": "qux"}},
+			map[string]interface{}{"name": "b"},
+			map[string]interface{}{"name": "c"},
+		},
+		"roles": []interface{}{"three"},
+	}
+	assert.Equal(t, expected, mergeYAML(base, overlay))
+}
+
+const layeredBaseConfig = `
+gateway:
+  listener:
+    tcp:
+      address: 0.0.0.0
+      port: 8080
+  logger: {}
+  stats: {}
+services:
+  - name: clutch.service.authz
+    typed_config:
+      "@type": types.google.com/clutch.config.service.authz.v1.Config
+      roles:
+        - role_name: base
+`
+
+func TestLoadConfigLayers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clutch-config")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	write := func(name, contents string) string {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, ioutil.WriteFile(path, []byte(contents), 0600))
+		return path
+	}
+
+	base := write("base.yaml", layeredBaseConfig)
+	overlay := write("overlay.yaml", `
+gateway:
+  listener:
+    tcp:
+      port: 9090
+`)
+	// Includes are relative to the including file and are applied before it.
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "env"), 0700))
+	env := write("env/prod.yaml", `
+includes:
+  - ../base.yaml
+  - ../overlay.yaml
+services:
+  - name: clutch.service.audit
+gateway:
+  listener:
+    tcp:
+      address: 127.0.0.1
+`)
+
+	cfg, files, err := loadConfig(&Flags{ConfigPaths: []string{base, overlay}})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(9090), cfg.Gateway.Listener.GetTcp().Port)
+	assert.Equal(t, "0.0.0.0", cfg.Gateway.Listener.GetTcp().Address)
+	assert.Equal(t, []string{base, overlay}, files)
+
+	cfg, files, err = loadConfig(&Flags{ConfigPaths: []string{env}})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(9090), cfg.Gateway.Listener.GetTcp().Port)
+	assert.Equal(t, "127.0.0.1", cfg.Gateway.Listener.GetTcp().Address)
+	assert.Len(t, cfg.Services, 2)
+	assert.Equal(t, "clutch.service.authz", cfg.Services[0].Name)
+	assert.Equal(t, "clutch.service.audit", cfg.Services[1].Name)
+	assert.Empty(t, cfg.Includes)
+	assert.Equal(t, []string{env, base, overlay}, files)
+
+	// Cycles are an error.
+	cycle := write("cycle.yaml", "includes: [cycle.yaml]")
+	_, _, err = loadConfig(&Flags{ConfigPaths: []string{cycle}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "includes itself")
+
+	// Missing files are an error.
+	_, _, err = loadConfig(&Flags{ConfigPaths: []string{base, filepath.Join(dir, "missing.yaml")}})
+	assert.Error(t, err)
+}
+
+func TestLoadConfigSecrets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clutch-config")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	secretPath := filepath.Join(dir, "role")
+	assert.NoError(t, ioutil.WriteFile(secretPath, []byte("fromfile\n"), 0600))
+
+	os.Setenv("CLUTCH_TEST_SECRET_DIR", dir)
+	config := layeredBaseConfig + `
+        - role_name: ${secret:file:` + secretPath + `}
+        - role_name: prefix-${secret:mounted:role}
+        - role_name: ${secret:cmd:fromexec}
+secret_providers:
+  - name: mounted
+    file:
+      directory: ${CLUTCH_TEST_SECRET_DIR}
+  - name: cmd
+    exec:
+      command: echo
+`
+	path := filepath.Join(dir, "clutch-config.yaml")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(config), 0600))
+
+	cfg, files, err := loadConfig(&Flags{ConfigPaths: []string{path}})
+	assert.NoError(t, err)
+	assert.Contains(t, cfg.Services[0].TypedConfig.String(), "fromfile")
+	assert.Contains(t, cfg.Services[0].TypedConfig.String(), "prefix-fromfile")
+	assert.Contains(t, cfg.Services[0].TypedConfig.String(), "fromexec")
+	assert.Equal(t, []string{path, secretPath}, files)
+
+	// Errors refer to the secret but not its value.
+	assert.NoError(t, ioutil.WriteFile(path, []byte(layeredBaseConfig+"        - role_name: ${secret:vault:foo}\n"), 0600))
+	_, _, err = loadConfig(&Flags{ConfigPaths: []string{path}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "secret provider 'vault' is not configured")
+}
@@ -2,9 +2,13 @@ package gateway
 
 import (
 	"github.com/lyft/clutch/backend/middleware"
+	"github.com/lyft/clutch/backend/middleware/accesslog"
 	"github.com/lyft/clutch/backend/middleware/audit"
 	"github.com/lyft/clutch/backend/middleware/authn"
 	"github.com/lyft/clutch/backend/middleware/authz"
+	"github.com/lyft/clutch/backend/middleware/maintenance"
+	"github.com/lyft/clutch/backend/middleware/ratelimit"
+	"github.com/lyft/clutch/backend/middleware/requestid"
 	"github.com/lyft/clutch/backend/middleware/stats"
 	"github.com/lyft/clutch/backend/middleware/validate"
 	"github.com/lyft/clutch/backend/module"
@@ -16,9 +20,11 @@ import (
 	experimentationapi "github.com/lyft/clutch/backend/module/chaos/experimentation/api"
 	rtdsmod "github.com/lyft/clutch/backend/module/chaos/serverexperimentation/rtds"
 	"github.com/lyft/clutch/backend/module/envoytriage"
+	gatewaymod "github.com/lyft/clutch/backend/module/gateway"
 	"github.com/lyft/clutch/backend/module/healthcheck"
 	k8smod "github.com/lyft/clutch/backend/module/k8s"
 	kinesismod "github.com/lyft/clutch/backend/module/kinesis"
+	maintenancemod "github.com/lyft/clutch/backend/module/maintenance"
 	resolvermod "github.com/lyft/clutch/backend/module/resolver"
 	"github.com/lyft/clutch/backend/module/sourcecontrol"
 	"github.com/lyft/clutch/backend/resolver"
@@ -36,51 +42,59 @@ import (
 	"github.com/lyft/clutch/backend/service/envoyadmin"
 	"github.com/lyft/clutch/backend/service/github"
 	k8sservice "github.com/lyft/clutch/backend/service/k8s"
+	maintenanceservice "github.com/lyft/clutch/backend/service/maintenance"
 	topologyservice "github.com/lyft/clutch/backend/service/topology"
 )
 
 var Middleware = middleware.Factory{
-	audit.Name:    audit.New,
-	authn.Name:    authn.New,
-	authz.Name:    authz.New,
-	stats.Name:    stats.New,
-	validate.Name: validate.New,
+	accesslog.Name:   {New: accesslog.New},
+	audit.Name:       {New: audit.New, Dependencies: audit.Dependencies},
+	authn.Name:       {New: authn.New, Dependencies: authn.Dependencies},
+	authz.Name:       {New: authz.New, Dependencies: authz.Dependencies},
+	maintenance.Name: {New: maintenance.New, Dependencies: maintenance.Dependencies},
+	ratelimit.Name:   {New: ratelimit.New, Dependencies: ratelimit.Dependencies},
+	requestid.Name:   {New: requestid.New},
+	stats.Name:       {New: stats.New},
+	validate.Name:    {New: validate.New},
 }
 
 var Modules = module.Factory{
-	assetsmod.Name:          assetsmod.New,
-	auditmod.Name:           auditmod.New,
-	authnmod.Name:           authnmod.New,
-	authzmod.Name:           authzmod.New,
-	awsmod.Name:             awsmod.New,
-	envoytriage.Name:        envoytriage.New,
-	experimentationapi.Name: experimentationapi.New,
-	k8smod.Name:             k8smod.New,
-	kinesismod.Name:         kinesismod.New,
-	healthcheck.Name:        healthcheck.New,
-	resolvermod.Name:        resolvermod.New,
-	rtdsmod.Name:            rtdsmod.New,
-	sourcecontrol.Name:      sourcecontrol.New,
+	assetsmod.Name:          {New: assetsmod.New},
+	auditmod.Name:           {New: auditmod.New, Dependencies: auditmod.Dependencies},
+	authnmod.Name:           {New: authnmod.New, Dependencies: authnmod.Dependencies},
+	authzmod.Name:           {New: authzmod.New, Dependencies: authzmod.Dependencies},
+	awsmod.Name:             {New: awsmod.New, Dependencies: awsmod.Dependencies},
+	envoytriage.Name:        {New: envoytriage.New, Dependencies: envoytriage.Dependencies},
+	experimentationapi.Name: {New: experimentationapi.New, Dependencies: experimentationapi.Dependencies},
+	gatewaymod.Name:         {New: gatewaymod.New},
+	k8smod.Name:             {New: k8smod.New, Dependencies: k8smod.Dependencies},
+	kinesismod.Name:         {New: kinesismod.New, Dependencies: kinesismod.Dependencies},
+	maintenancemod.Name:     {New: maintenancemod.New, Dependencies: maintenancemod.Dependencies},
+	healthcheck.Name:        {New: healthcheck.New},
+	resolvermod.Name:        {New: resolvermod.New},
+	rtdsmod.Name:            {New: rtdsmod.New, Dependencies: rtdsmod.Dependencies},
+	sourcecontrol.Name:      {New: sourcecontrol.New, Dependencies: sourcecontrol.Dependencies},
 }
 
 var Services = service.Factory{
-	auditservice.Name:    auditservice.New,
-	authnservice.Name:    authnservice.New,
-	authzservice.Name:    authzservice.New,
-	awsservice.Name:      awsservice.New,
-	envoyadmin.Name:      envoyadmin.New,
-	experimentstore.Name: experimentstore.New,
-	github.Name:          github.New,
-	k8sservice.Name:      k8sservice.New,
-	loggingsink.Name:     loggingsink.New,
-	pgservice.Name:       pgservice.New,
-	slack.Name:           slack.New,
-	topologyservice.Name: topologyservice.New,
+	auditservice.Name:       {New: auditservice.New, Dependencies: auditservice.Dependencies},
+	authnservice.Name:       {New: authnservice.New, Dependencies: authnservice.Dependencies},
+	authzservice.Name:       {New: authzservice.New, Dependencies: authzservice.Dependencies},
+	awsservice.Name:         {New: awsservice.New},
+	envoyadmin.Name:         {New: envoyadmin.New},
+	experimentstore.Name:    {New: experimentstore.New, Dependencies: experimentstore.Dependencies},
+	github.Name:             {New: github.New},
+	k8sservice.Name:         {New: k8sservice.New},
+	loggingsink.Name:        {New: loggingsink.New},
+	maintenanceservice.Name: {New: maintenanceservice.New, Dependencies: maintenanceservice.Dependencies},
+	pgservice.Name:          {New: pgservice.New},
+	slack.Name:              {New: slack.New},
+	topologyservice.Name:    {New: topologyservice.New, Dependencies: topologyservice.Dependencies},
 }
 
 var Resolvers = resolver.Factory{
-	awsresolver.Name: awsresolver.New,
-	k8sresolver.Name: k8sresolver.New,
+	awsresolver.Name: {New: awsresolver.New, Dependencies: awsresolver.Dependencies},
+	k8sresolver.Name: {New: k8sresolver.New, Dependencies: k8sresolver.Dependencies},
 }
 
 var CoreComponentFactory = &ComponentFactory{
@@ -0,0 +1,129 @@
+package gateway
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/any"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	"github.com/lyft/clutch/backend/service"
+)
+
+// dependencies returns the services required by a component with the given registration.
+func dependencies(name string, fn service.DependencyFunc, cfg *any.Any) ([]string, error) {
+	if fn == nil {
+		return nil, nil
+	}
+
+	deps, err := fn(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("could not determine dependencies of '%s': %w", name, err)
+	}
+	return deps, nil
+}
+
+// resolveDependencies returns the configured services ordered so that each service comes after the services it depends
+// on, and otherwise in the order they were configured. It returns an error if a component is not registered, if a
+// service is configured more than once, if the services depend on each other in a cycle, or if any component depends on
+// a service that is not registered or not configured.
+func resolveDependencies(cfg *gatewayv1.Config, cf *ComponentFactory) ([]*gatewayv1.Service, error) {
+	configured := make(map[string]*gatewayv1.Service, len(cfg.Services))
+	graph := make(map[string][]string, len(cfg.Services))
+	for _, svc := range cfg.Services {
+		if _, ok := configured[svc.Name]; ok {
+			return nil, fmt.Errorf("service '%s' is configured more than once", svc.Name)
+		}
+		configured[svc.Name] = svc
+	}
+
+	// Check that every component is registered and that its dependencies are registered and configured.
+	check := func(kind, name string, registered bool, fn service.DependencyFunc, typedConfig *any.Any) ([]string, error) {
+		if !registered {
+			return nil, fmt.Errorf("%s '%s' not found in registry", kind, name)
+		}
+		required, err := dependencies(name, fn, typedConfig)
+		if err != nil {
+			return nil, err
+		}
+		for _, dep := range required {
+			if _, ok := cf.Services[dep]; !ok {
+				return nil, fmt.Errorf("%s '%s' depends on service '%s' which is not registered", kind, name, dep)
+			}
+			if _, ok := configured[dep]; !ok {
+				return nil, fmt.Errorf("%s '%s' depends on service '%s' which is not configured", kind, name, dep)
+			}
+		}
+		return required, nil
+	}
+
+	for _, svc := range cfg.Services {
+		r, ok := cf.Services[svc.Name]
+		required, err := check("service", svc.Name, ok, r.Dependencies, svc.TypedConfig)
+		if err != nil {
+			return nil, err
+		}
+		graph[svc.Name] = required
+	}
+	for _, res := range cfg.Resolvers {
+		r, ok := cf.Resolvers[res.Name]
+		if _, err := check("resolver", res.Name, ok, r.Dependencies, res.TypedConfig); err != nil {
+			return nil, err
+		}
+	}
+	for _, mid := range cfg.Gateway.Middleware {
+		r, ok := cf.Middleware[mid.Name]
+		if _, err := check("middleware", mid.Name, ok, r.Dependencies, mid.TypedConfig); err != nil {
+			return nil, err
+		}
+	}
+	for _, mod := range cfg.Modules {
+		r, ok := cf.Modules[mod.Name]
+		if _, err := check("module", mod.Name, ok, r.Dependencies, mod.TypedConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	// Depth-first topological sort, visiting services in configuration order to keep the result stable.
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(cfg.Services))
+	ordered := make([]*gatewayv1.Service, 0, len(cfg.Services))
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			// Only report the services that form the cycle.
+			for i, p := range path {
+				if p == name {
+					path = path[i:]
+					break
+				}
+			}
+			return fmt.Errorf("dependency cycle between services: %s", strings.Join(append(path, name), " -> "))
+		}
+
+		state[name] = visiting
+		for _, dep := range graph[name] {
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		ordered = append(ordered, configured[name])
+		return nil
+	}
+
+	for _, svc := range cfg.Services {
+		if err := visit(svc.Name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
@@ -0,0 +1,155 @@
+package gateway
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/stretchr/testify/assert"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	"github.com/lyft/clutch/backend/middleware"
+	"github.com/lyft/clutch/backend/module"
+	"github.com/lyft/clutch/backend/service"
+)
+
+func servicesConfig(names ...string) *gatewayv1.Config {
+	cfg := &gatewayv1.Config{Gateway: &gatewayv1.GatewayOptions{}}
+	for _, name := range names {
+		cfg.Services = append(cfg.Services, &gatewayv1.Service{Name: name})
+	}
+	return cfg
+}
+
+func serviceNames(services []*gatewayv1.Service) []string {
+	ret := make([]string, len(services))
+	for i, svc := range services {
+		ret[i] = svc.Name
+	}
+	return ret
+}
+
+// servicesFactory registers the services, with the dependencies of each service keyed by name.
+func servicesFactory(deps map[string]service.DependencyFunc, names ...string) *ComponentFactory {
+	cf := &ComponentFactory{Services: service.Factory{}}
+	for _, name := range names {
+		cf.Services[name] = service.Registration{Dependencies: deps[name]}
+	}
+	return cf
+}
+
+func TestResolveDependencies(t *testing.T) {
+	testCases := []struct {
+		id       string
+		cfg      *gatewayv1.Config
+		cf       *ComponentFactory
+		expected []string
+		err      string
+	}{
+		{
+			id:       "no dependencies keeps configuration order",
+			cfg:      servicesConfig("c", "b", "a"),
+			cf:       servicesFactory(nil, "a", "b", "c"),
+			expected: []string{"c", "b", "a"},
+		},
+		{
+			id:  "dependencies are instantiated first",
+			cfg: servicesConfig("audit", "store", "db", "sink"),
+			cf: servicesFactory(map[string]service.DependencyFunc{
+				"audit": service.Requires("db", "sink"),
+				"store": service.Requires("db"),
+			}, "audit", "store", "db", "sink"),
+			expected: []string{"db", "sink", "audit", "store"},
+		},
+		{
+			id:  "duplicate service",
+			cfg: servicesConfig("a", "b", "a"),
+			cf:  servicesFactory(nil, "a", "b"),
+			err: "service 'a' is configured more than once",
+		},
+		{
+			id:  "unregistered service",
+			cfg: servicesConfig("a", "b"),
+			cf:  servicesFactory(nil, "a"),
+			err: "service 'b' not found in registry",
+		},
+		{
+			id:  "missing dependency",
+			cfg: servicesConfig("store"),
+			cf:  servicesFactory(map[string]service.DependencyFunc{"store": service.Requires("db")}, "store", "db"),
+			err: "service 'store' depends on service 'db' which is not configured",
+		},
+		{
+			id:  "unregistered dependency",
+			cfg: servicesConfig("store"),
+			cf:  servicesFactory(map[string]service.DependencyFunc{"store": service.Requires("bd")}, "store", "db"),
+			err: "service 'store' depends on service 'bd' which is not registered",
+		},
+		{
+			id:  "cycle",
+			cfg: servicesConfig("a", "b", "c"),
+			cf: servicesFactory(map[string]service.DependencyFunc{
+				"a": service.Requires("b"),
+				"b": service.Requires("c"),
+				"c": service.Requires("b"),
+			}, "a", "b", "c"),
+			err: "dependency cycle between services: b -> c -> b",
+		},
+		{
+			id:  "dependency function error",
+			cfg: servicesConfig("a"),
+			cf: servicesFactory(map[string]service.DependencyFunc{
+				"a": func(*any.Any) ([]string, error) { return nil, errors.New("bad config") },
+			}, "a"),
+			err: "could not determine dependencies of 'a': bad config",
+		},
+		{
+			id: "missing module dependency",
+			cfg: &gatewayv1.Config{
+				Gateway:  &gatewayv1.GatewayOptions{},
+				Services: []*gatewayv1.Service{{Name: "db"}},
+				Modules:  []*gatewayv1.Module{{Name: "mod"}},
+			},
+			cf: &ComponentFactory{
+				Services: service.Factory{"db": {}, "store": {}},
+				Modules:  module.Factory{"mod": {Dependencies: service.Requires("db", "store")}},
+			},
+			err: "module 'mod' depends on service 'store' which is not configured",
+		},
+		{
+			id: "missing middleware dependency",
+			cfg: &gatewayv1.Config{
+				Gateway: &gatewayv1.GatewayOptions{Middleware: []*gatewayv1.Middleware{{Name: "mid"}}},
+			},
+			cf: &ComponentFactory{
+				Services:   service.Factory{"authn": {}},
+				Middleware: middleware.Factory{"mid": {Dependencies: service.Requires("authn")}},
+			},
+			err: "middleware 'mid' depends on service 'authn' which is not configured",
+		},
+		{
+			id: "unregistered resolver",
+			cfg: &gatewayv1.Config{
+				Gateway:   &gatewayv1.GatewayOptions{},
+				Resolvers: []*gatewayv1.Resolver{{Name: "res"}},
+			},
+			cf:  &ComponentFactory{},
+			err: "resolver 'res' not found in registry",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.id, func(t *testing.T) {
+			t.Parallel()
+
+			services, err := resolveDependencies(tt.cfg, tt.cf)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, serviceNames(services))
+		})
+	}
+}
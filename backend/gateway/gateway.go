@@ -4,18 +4,25 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"time"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/uber-go/tally"
+	tallyprom "github.com/uber-go/tally/prometheus"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
 	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	"github.com/lyft/clutch/backend/gateway/logging"
 	"github.com/lyft/clutch/backend/gateway/meta"
 	"github.com/lyft/clutch/backend/gateway/mux"
+	"github.com/lyft/clutch/backend/gateway/openapi"
 	"github.com/lyft/clutch/backend/gateway/stats"
 	"github.com/lyft/clutch/backend/middleware"
+	"github.com/lyft/clutch/backend/middleware/recovery"
 	"github.com/lyft/clutch/backend/middleware/timeouts"
+	"github.com/lyft/clutch/backend/middleware/tracing"
 	"github.com/lyft/clutch/backend/module"
 	"github.com/lyft/clutch/backend/resolver"
 	"github.com/lyft/clutch/backend/service"
@@ -31,26 +38,39 @@ type ComponentFactory struct {
 }
 
 func Run(f *Flags, cf *ComponentFactory, assets http.FileSystem) {
-	cfg := MustReadOrValidateConfig(f)
-	RunWithConfig(f, cfg, cf, assets)
+	cfg, files := mustLoadConfig(f)
+	runWithConfig(f, cfg, files, cf, assets)
 }
 
+// RunWithConfig runs the gateway with a configuration that was already read. If the configuration is watched for
+// changes, files included by the configuration files and secrets read from files are only watched after the first
+// reload, since they aren't known until then.
 func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets http.FileSystem) {
-	// Init the server's logger.
-	logger, err := newLogger(cfg.Gateway.Logger)
+	runWithConfig(f, cfg, f.ConfigPaths, cf, assets)
+}
+
+// runWithConfig runs the gateway with the configuration read from the given files, which are watched for changes.
+func runWithConfig(f *Flags, cfg *gatewayv1.Config, files []string, cf *ComponentFactory, assets http.FileSystem) {
+	// Init the server's logger. Each component gets its own logger from the base logger so that its level can be changed
+	// separately.
+	baseLogger, levels, err := newLogger(cfg.Gateway.Logger)
 	if err != nil {
 		newTmpLogger().Fatal("could not instantiate logger", zap.Error(err))
 	}
 	defer func() {
-		if err := logger.Sync(); err != nil {
-			panic(err)
-		}
+		// Syncing can fail if the logger writes to a console (e.g. "sync /dev/stderr: invalid argument"), so errors are
+		// ignored now that the gateway exits normally on shutdown.
+		_ = baseLogger.Sync()
 	}()
+	logger := levels.Logger(baseLogger, logging.GatewayName)
 
-	logger.Info("using configuration", zap.String("file", f.ConfigPath))
+	logger.Info("using configuration", zap.Strings("files", f.ConfigPaths))
 
 	// Init stats.
 	var reporter tally.StatsReporter
+	var cachedReporter tally.CachedStatsReporter
+	var metricsHandler http.Handler
+	scopeOptions := tally.ScopeOptions{Prefix: "clutch"}
 	switch t := cfg.Gateway.Stats.Reporter.(type) {
 	case nil:
 		reporter = tally.NullStatsReporter
@@ -61,17 +81,22 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 		if err != nil {
 			logger.Fatal("error creating statsd reporter", zap.Error(err))
 		}
+	case *gatewayv1.Stats_PrometheusReporter_:
+		promReporter, err := stats.NewPrometheusReporter(cfg.Gateway.Stats.GetPrometheusReporter(), logger)
+		if err != nil {
+			logger.Fatal("error creating prometheus reporter", zap.Error(err))
+		}
+		cachedReporter = promReporter
+		metricsHandler = promReporter.HTTPHandler()
+		scopeOptions.Separator = tallyprom.DefaultSeparator
+		scopeOptions.SanitizeOptions = &tallyprom.DefaultSanitizerOpts
 	default:
 		logger.Fatal("unsupported logger", zap.Reflect("type", t))
 	}
 
-	scope, scopeCloser := tally.NewRootScope(
-		tally.ScopeOptions{
-			Reporter: reporter,
-			Prefix:   "clutch",
-		},
-		duration(cfg.Gateway.Stats.FlushInterval),
-	)
+	scopeOptions.Reporter = reporter
+	scopeOptions.CachedReporter = cachedReporter
+	scope, scopeCloser := tally.NewRootScope(scopeOptions, duration(cfg.Gateway.Stats.FlushInterval))
 	defer func() {
 		if err := scopeCloser.Close(); err != nil {
 			panic(err)
@@ -81,14 +106,41 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 	initScope := scope.SubScope("gateway")
 	initScope.Counter("start").Inc(1)
 
-	// Instantiate and register services.
-	for _, svcConfig := range cfg.Services {
+	// Track components in order of instantiation for starting and stopping.
+	lc := &lifecycle{}
+	// Track components in order of registration for the gateway API.
+	info := &meta.GatewayInfo{}
+
+	// Init tracing before anything else so that it is stopped last, allowing spans from other components to be exported.
+	var tracingMiddleware middleware.Middleware
+	if cfg.Gateway.Tracing != nil {
+		tracingMiddleware, err = tracing.New(cfg.Gateway.Tracing, logger, scope.SubScope("tracing"))
+		if err != nil {
+			logger.Fatal("could not create tracer", zap.Error(err))
+		}
+		lc.add("tracing", tracingMiddleware)
+	}
+
+	// Order services so that dependencies are instantiated first, and check that every component's dependencies are
+	// configured before instantiating anything.
+	services, err := resolveDependencies(cfg, cf)
+	if err != nil {
+		logger.Fatal("could not resolve component dependencies", zap.Error(err))
+	}
+	listenerConfigs, err := listeners(cfg)
+	if err != nil {
+		logger.Fatal("invalid listener configuration", zap.Error(err))
+	}
+
+	// Instantiate and register services, and collect the request headers that the JSON gateway forwards for them.
+	var forwardedHeaders []string
+	for _, svcConfig := range services {
 		factory, ok := cf.Services[svcConfig.Name]
-		logger := logger.With(zap.String("serviceName", svcConfig.Name))
+		logger := levels.Logger(baseLogger, svcConfig.Name).With(zap.String("serviceName", svcConfig.Name))
 		if !ok {
 			logger.Fatal("service not found in registry")
 		}
-		if factory == nil {
+		if factory.New == nil {
 			logger.Fatal("service has nil factory")
 		}
 
@@ -97,20 +149,29 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 		}
 
 		logger.Info("registering service")
-		svc, err := factory(svcConfig.TypedConfig, logger, scope.SubScope("service"))
+		svc, err := factory.New(svcConfig.TypedConfig, logger, scope.SubScope("service"))
 		if err != nil {
 			logger.Fatal("service instantiation failed", zap.Error(err))
 		}
-		service.Registry[svcConfig.Name] = svc
+		if err := service.Registry.Register(svcConfig.Name, svc); err != nil {
+			logger.Fatal("service registration failed", zap.Error(err))
+		}
+		headers, err := clientInfoHeaders(cfg.Gateway, svc)
+		if err != nil {
+			logger.Fatal("invalid listener configuration", zap.Error(err))
+		}
+		forwardedHeaders = append(forwardedHeaders, headers...)
+		lc.add(svcConfig.Name, svc)
+		info.Services = append(info.Services, component(svcConfig))
 	}
 
 	for _, resolverCfg := range cfg.Resolvers {
 		factory, ok := cf.Resolvers[resolverCfg.Name]
-		logger := logger.With(zap.String("resolverName", resolverCfg.Name))
+		logger := levels.Logger(baseLogger, resolverCfg.Name).With(zap.String("resolverName", resolverCfg.Name))
 		if !ok {
 			logger.Fatal("resolver not found in registry")
 		}
-		if factory == nil {
+		if factory.New == nil {
 			logger.Fatal("resolver has nil factory")
 		}
 
@@ -119,26 +180,47 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 		}
 
 		logger.Info("registering resolver")
-		res, err := factory(resolverCfg.TypedConfig, logger, scope.SubScope("resolver"))
+		res, err := factory.New(resolverCfg.TypedConfig, logger, scope.SubScope("resolver"))
 		if err != nil {
 			logger.Fatal("resolver instantiation failed", zap.Error(err))
 		}
-		resolver.Registry[resolverCfg.Name] = res
+		if err := resolver.Registry.Register(resolverCfg.Name, res); err != nil {
+			logger.Fatal("resolver registration failed", zap.Error(err))
+		}
+		lc.add(resolverCfg.Name, res)
+		info.Resolvers = append(info.Resolvers, component(resolverCfg))
 	}
 
 	timeoutInterceptor, err := timeouts.New(cfg.Gateway.Timeouts, logger, scope)
 	if err != nil {
 		logger.Fatal("could not create timeout interceptor", zap.Error(err))
 	}
-	interceptors := []grpc.UnaryServerInterceptor{timeoutInterceptor.UnaryInterceptor()}
+	// Built-in middleware is applied on every listener. Middleware is listed in chain order for the gateway API.
+	var builtinMiddleware []middleware.Middleware
+	// The span for the request covers all other middleware.
+	if tracingMiddleware != nil {
+		builtinMiddleware = append(builtinMiddleware, tracingMiddleware)
+		info.Middleware = append(info.Middleware, meta.Component{Name: "clutch.middleware.tracing"})
+	}
+	// Panics in any later middleware or handler are converted to errors rather than crashing the gateway.
+	recoveryMiddleware, err := recovery.New(logger, scope)
+	if err != nil {
+		logger.Fatal("could not create recovery middleware", zap.Error(err))
+	}
+	builtinMiddleware = append(builtinMiddleware, recoveryMiddleware)
+	info.Middleware = append(info.Middleware, meta.Component{Name: "clutch.middleware.recovery"})
+	builtinMiddleware = append(builtinMiddleware, timeoutInterceptor)
+	info.Middleware = append(info.Middleware, meta.Component{Name: "clutch.middleware.timeouts"})
+
+	configuredMiddleware := make(map[string]middleware.Middleware, len(cfg.Gateway.Middleware))
 	for _, mCfg := range cfg.Gateway.Middleware {
-		logger := logger.With(zap.String("moduleName", mCfg.Name))
+		logger := levels.Logger(baseLogger, mCfg.Name).With(zap.String("moduleName", mCfg.Name))
 
 		factory, ok := cf.Middleware[mCfg.Name]
 		if !ok {
 			logger.Fatal("middleware not found in registry")
 		}
-		if factory == nil {
+		if factory.New == nil {
 			logger.Fatal("middleware has nil factory")
 		}
 
@@ -147,48 +229,87 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 		}
 
 		logger.Info("registering middleware")
-		m, err := factory(mCfg.TypedConfig, logger, scope)
+		m, err := factory.New(mCfg.TypedConfig, logger, scope)
 		if err != nil {
 			logger.Fatal("middleware instatiation failed", zap.Error(err))
 		}
 
-		interceptors = append(interceptors, m.UnaryInterceptor())
+		configuredMiddleware[mCfg.Name] = m
+		lc.add(mCfg.Name, m)
+		info.Middleware = append(info.Middleware, component(mCfg))
 	}
 
-	// Instantiate and register modules listed in the configuration.
-	rpcMux := mux.New(interceptors, assets)
-	ctx := context.TODO()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Each listener has its own gRPC server and JSON gateway with the middleware it applies. The JSON gateway's handlers
+	// connect to the gRPC server over a private loopback rather than the listener's address.
+	rpcMuxes := make([]*mux.Mux, len(listenerConfigs))
+	registrars := make([]module.Registrar, len(listenerConfigs))
+	loopbacks := make([]*server, len(listenerConfigs))
+	for i, lisCfg := range listenerConfigs {
+		logger := logger.With(zap.String("listenerName", lisCfg.Name))
+
+		var interceptors []grpc.UnaryServerInterceptor
+		var streamInterceptors []grpc.StreamServerInterceptor
+		for _, m := range builtinMiddleware {
+			interceptors = append(interceptors, m.UnaryInterceptor())
+			streamInterceptors = append(streamInterceptors, m.StreamInterceptor())
+		}
+		for _, mCfg := range cfg.Gateway.Middleware {
+			if serves(lisCfg.Middleware, mCfg.Name) {
+				interceptors = append(interceptors, configuredMiddleware[mCfg.Name].UnaryInterceptor())
+				streamInterceptors = append(streamInterceptors, configuredMiddleware[mCfg.Name].StreamInterceptor())
+			}
+		}
+
+		rpcMux := mux.New(interceptors, streamInterceptors, assets, forwardedHeaders)
+		if cfg.Gateway.Cors != nil {
+			if err := rpcMux.EnableCORS(cfg.Gateway.Cors); err != nil {
+				logger.Fatal("invalid cors configuration", zap.Error(err))
+			}
+		}
+		var loopbackCfg *gatewayv1.Listener
+		if i == 0 {
+			if metricsHandler != nil {
+				path := stats.PrometheusPath(cfg.Gateway.Stats.GetPrometheusReporter())
+				logger.Info("serving prometheus metrics", zap.String("path", path))
+				rpcMux.Handle(path, metricsHandler)
+			}
+			loopbackCfg = cfg.Gateway.JsonGrpcLoopbackListener
+		}
 
-	// Create a client connection for the registrar to make grpc-gateway's handlers available.
-	// TODO: stand up a private loopback listener for the grpcServer and connect to that instead.
-	conn, err := grpc.Dial(fmt.Sprintf("%s:%d", cfg.Gateway.Listener.GetTcp().Address, cfg.Gateway.Listener.GetTcp().Port), grpc.WithInsecure())
-	if err != nil {
-		logger.Fatal("failed to bring up gRPC transport for grpc-gateway handlers", zap.Error(err))
-	}
-	defer func() {
+		// Create a client connection for the registrar to make grpc-gateway's handlers available.
+		loopback, dialOptions, err := newLoopback(lisCfg.Name+"-loopback", loopbackCfg, rpcMux.GRPCServer, logger)
 		if err != nil {
-			if cerr := conn.Close(); cerr != nil {
-				logger.Warn("failed to close gRPC transport connection after err", zap.Error(err))
-			}
-			return
+			logger.Fatal("could not create loopback listener for grpc-gateway handlers", zap.Error(err))
+		}
+		conn, err := grpc.DialContext(ctx, loopback.addr, dialOptions...)
+		if err != nil {
+			logger.Fatal("failed to bring up gRPC transport for grpc-gateway handlers", zap.Error(err))
 		}
 		go func() {
 			<-ctx.Done()
-			if cerr := conn.Close(); cerr != nil {
+			if err := conn.Close(); err != nil {
 				logger.Warn("failed to close gRPC transport connection when done", zap.Error(err))
 			}
 		}()
-	}()
 
-	reg := newRegistrar(ctx, rpcMux.JSONGateway, rpcMux.GRPCServer, conn)
+		rpcMuxes[i] = rpcMux
+		registrars[i] = newRegistrar(ctx, rpcMux.JSONGateway, rpcMux.GRPCServer, conn)
+		loopbacks[i] = loopback
+	}
+
+	// Instantiate modules listed in the configuration and register them with the listeners that serve them.
+	extenders := make([][]openapi.Extender, len(listenerConfigs))
 	for _, modCfg := range cfg.Modules {
-		logger := logger.With(zap.String("moduleName", modCfg.Name))
+		logger := levels.Logger(baseLogger, modCfg.Name).With(zap.String("moduleName", modCfg.Name))
 
 		factory, ok := cf.Modules[modCfg.Name]
 		if !ok {
 			logger.Fatal("module not found in registry")
 		}
-		if factory == nil {
+		if factory.New == nil {
 			logger.Fatal("module has nil factory")
 		}
 
@@ -197,43 +318,148 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 		}
 
 		logger.Info("registering module")
-		mod, err := factory(modCfg.TypedConfig, logger, scope.SubScope("module"))
+		mod, err := factory.New(modCfg.TypedConfig, logger, scope.SubScope("module"))
 		if err != nil {
 			logger.Fatal("module instantiation failed", zap.Error(err))
 		}
 
-		if err := mod.Register(reg); err != nil {
-			logger.Fatal("registration to gateway failed", zap.Error(err))
+		for i, lisCfg := range listenerConfigs {
+			if !serves(lisCfg.Modules, modCfg.Name) {
+				continue
+			}
+			if err := mod.Register(registrars[i]); err != nil {
+				logger.Fatal("registration to gateway failed", zap.String("listenerName", lisCfg.Name), zap.Error(err))
+			}
+			if e, ok := mod.(openapi.Extender); ok {
+				extenders[i] = append(extenders[i], e)
+			}
 		}
+		lc.add(modCfg.Name, mod)
+		info.Modules = append(info.Modules, component(modCfg))
 	}
 
+	// No components are instantiated after this point, so the registries can be made read-only.
+	service.Registry.Lock()
+	resolver.Registry.Lock()
+
 	// Now that everything is registered, enable gRPC reflection.
-	rpcMux.EnableGRPCReflection()
+	grpcServers := make([]*grpc.Server, len(rpcMuxes))
+	for i, rpcMux := range rpcMuxes {
+		rpcMux.EnableGRPCReflection()
+		grpcServers[i] = rpcMux.GRPCServer
+	}
 
 	// Save metadata on what RPCs being served for fast-lookup by internal services.
-	if err := meta.GenerateGRPCMetadata(rpcMux.GRPCServer); err != nil {
+	if err := meta.GenerateGRPCMetadata(grpcServers...); err != nil {
 		logger.Fatal("reflection on grpc server failed", zap.Error(err))
 	}
 
-	// Instantiate server and listen.
-	switch t := cfg.Gateway.Listener.Socket.(type) {
-	case *gatewayv1.Listener_Tcp:
-		// OK
-	default:
-		logger.Fatal("socket not supported", zap.Any("type", t))
+	if cfg.Gateway.Openapi != nil {
+		path := openAPIPath(cfg.Gateway.Openapi)
+		for i, lisCfg := range listenerConfigs {
+			handler, err := newOpenAPIHandler(rpcMuxes[i].GRPCServer, extenders[i])
+			if err != nil {
+				logger.Fatal("could not generate openapi document", zap.String("listenerName", lisCfg.Name), zap.Error(err))
+			}
+			rpcMuxes[i].Handle(path, handler)
+		}
+		logger.Info("serving openapi document", zap.String("path", path))
+	}
+
+	// Save the components and configuration for the gateway API.
+	if info.Config, err = redactConfig(cfg); err != nil {
+		logger.Warn("could not convert configuration for gateway api", zap.Error(err))
+	}
+	meta.SetGatewayInfo(info)
+	logging.SetLevels(levels)
+
+	// Instantiate servers and listen. This happens before components are started so that a bad certificate or an
+	// address in use fails startup without leaving started components behind.
+	servers := make([]*server, len(listenerConfigs))
+	for i, lisCfg := range listenerConfigs {
+		logger := logger.With(zap.String("listenerName", lisCfg.Name))
+
+		// Load TLS certificates if the listener is secure.
+		certs, err := listenerCertificates(lisCfg, logger)
+		if err != nil {
+			logger.Fatal("could not load TLS certificates for listener", zap.Error(err))
+		}
+		lis, err := listen(lisCfg)
+		if err != nil {
+			logger.Fatal("error bringing up listener", zap.Error(err))
+		}
+		servers[i] = newServer(lisCfg.Name, listenerAddr(lisCfg), lis, certs, rpcMuxes[i], rpcMuxes[i].GRPCServer)
+	}
+
+	// Start components now that everything is registered.
+	if err := lc.start(ctx, logger); err != nil {
+		lc.stop(context.Background(), logger)
+		logger.Fatal("could not start components", zap.Error(err))
+	}
+
+	// Reload configuration on SIGHUP, and when the file changes if enabled.
+	rl := newReloader(f, cfg, files, lc, logger.With(zap.Strings("files", f.ConfigPaths)), initScope)
+	if cfg.Gateway.ConfigWatchInterval != nil {
+		go rl.watch(ctx, duration(cfg.Gateway.ConfigWatchInterval))
+	}
+
+	serveErr := make(chan error, len(servers)+len(loopbacks))
+	for _, s := range append(append([]*server{}, loopbacks...), servers...) {
+		logger.Info("listening", zap.String("listenerName", s.name), zap.Namespace(s.lis.Addr().Network()),
+			zap.String("addr", s.addr), zap.Bool("secure", s.certs != nil))
+		if s.certs != nil {
+			go s.certs.poll(ctx)
+		}
+		go func(s *server) {
+			if err := s.serve(); err != nil {
+				serveErr <- fmt.Errorf("listener '%s': %w", s.name, err)
+			}
+		}(s)
 	}
 
-	if cfg.Gateway.Listener.GetTcp().Secure {
-		logger.Fatal("'secure' set to true but listener security is not currently supported")
+	// Serve until a listener fails or a shutdown signal is received. A failed listener shuts down the gateway the same
+	// way, but exits with an error once components are stopped.
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	var listenerErr error
+serve:
+	for {
+		select {
+		case listenerErr = <-serveErr:
+			logger.Error("listener failed, shutting down", zap.Error(listenerErr))
+			break serve
+		case sig := <-signals:
+			if sig == syscall.SIGHUP {
+				logger.Info("received signal, reloading configuration", zap.String("signal", sig.String()))
+				if err := rl.reload(); err != nil {
+					logger.Error("configuration reload rejected", zap.Error(err))
+				}
+				continue
+			}
+			logger.Info("received signal, shutting down", zap.String("signal", sig.String()))
+			break serve
+		}
 	}
 
-	addr := fmt.Sprintf("%s:%d", cfg.Gateway.Listener.GetTcp().Address, cfg.Gateway.Listener.GetTcp().Port)
-	logger.Info("listening", zap.Namespace("tcp"), zap.String("addr", addr))
-	srv := &http.Server{
-		Handler:      mux.InsecureHandler(rpcMux),
-		Addr:         addr,
-		WriteTimeout: 15 * time.Second,
-		ReadTimeout:  15 * time.Second,
+	shutdownTimeout := defaultShutdownTimeout
+	if cfg.Gateway.ShutdownTimeout != nil {
+		shutdownTimeout = duration(cfg.Gateway.ShutdownTimeout)
+	}
+
+	// Requests through the JSON gateway use the loopbacks, so they are drained after the listeners.
+	drainCtx, drainCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer drainCancel()
+	drainServers(drainCtx, servers, logger)
+	drainServers(drainCtx, loopbacks, logger)
+
+	// Cancel the context given to components and background loops, then stop components in reverse order.
+	cancel()
+	stopCtx, stopCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer stopCancel()
+	lc.stop(stopCtx, logger)
+
+	if listenerErr != nil {
+		logger.Fatal("error bringing up listener", zap.Error(listenerErr))
 	}
-	logger.Fatal("error bringing up listener", zap.Error(srv.ListenAndServe()))
+	logger.Info("shutdown complete")
 }
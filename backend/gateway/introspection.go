@@ -0,0 +1,78 @@
+package gateway
+
+import (
+	"encoding/json"
+	"regexp"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes/any"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	"github.com/lyft/clutch/backend/gateway/meta"
+)
+
+const redacted = "[REDACTED]"
+
+// Fields whose names suggest they hold a secret are redacted even if the value did not come from a secret reference,
+// e.g. if it was interpolated from an environment variable rather than read with the env secret provider.
+var sensitiveFieldPattern = regexp.MustCompile(`(?i)(secret|password|token|credential|authorization|api_?key)`)
+
+// componentConfig is implemented by the configuration of services, resolvers, middleware, and modules.
+type componentConfig interface {
+	GetName() string
+	GetTypedConfig() *any.Any
+}
+
+func component(c componentConfig) meta.Component {
+	return meta.Component{Name: c.GetName(), TypeURL: c.GetTypedConfig().GetTypeUrl()}
+}
+
+// redactConfig converts the configuration to a struct, replacing resolved secrets and sensitive fields.
+func redactConfig(cfg *gatewayv1.Config) (*structpb.Struct, error) {
+	m := &jsonpb.Marshaler{OrigName: true}
+	s, err := m.MarshalToString(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, err
+	}
+	redactValue(raw)
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	ret := &structpb.Struct{}
+	if err := jsonpb.UnmarshalString(string(b), ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func redactValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			if _, ok := child.(string); ok && sensitiveFieldPattern.MatchString(k) {
+				t[k] = redacted
+				continue
+			}
+			t[k] = redactValue(child)
+		}
+	case []interface{}:
+		for i, child := range t {
+			t[i] = redactValue(child)
+		}
+	case string:
+		// Only values that had a secret reference are redacted, rather than every value containing a secret, which
+		// would redact unrelated values that happen to contain a short secret.
+		if _, ok := resolvedSecrets.Load(t); ok {
+			return redacted
+		}
+	}
+	return v
+}
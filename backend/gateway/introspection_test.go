@@ -0,0 +1,109 @@
+package gateway
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	githubv1 "github.com/lyft/clutch/backend/api/config/service/github/v1"
+	"github.com/lyft/clutch/backend/gateway/meta"
+)
+
+func TestRedactConfig(t *testing.T) {
+	resolvedSecrets.Store("/etc/clutch/hunter3.yaml", struct{}{})
+	defer resolvedSecrets.Delete("/etc/clutch/hunter3.yaml")
+
+	github, err := ptypes.MarshalAny(&githubv1.Config{
+		Auth: &githubv1.Config_AccessToken{AccessToken: "plaintext"},
+	})
+	assert.NoError(t, err)
+
+	cfg := &gatewayv1.Config{
+		Gateway: &gatewayv1.GatewayOptions{
+			Listener: &gatewayv1.Listener{Socket: &gatewayv1.Listener_Tcp{Tcp: &gatewayv1.TCPSocket{Address: "localhost", Port: 8080}}},
+		},
+		Services: []*gatewayv1.Service{{Name: "clutch.service.github", TypedConfig: github}},
+		Includes: []string{"/etc/clutch/hunter3.yaml", "/etc/clutch/hunter3"},
+	}
+
+	s, err := redactConfig(cfg)
+	assert.NoError(t, err)
+	fields := s.Fields
+
+	tcp := fields["gateway"].GetStructValue().Fields["listener"].GetStructValue().Fields["tcp"].GetStructValue()
+	assert.Equal(t, "localhost", tcp.Fields["address"].GetStringValue())
+
+	// Sensitive fields are redacted even if the value isn't a resolved secret.
+	svc := fields["services"].GetListValue().Values[0].GetStructValue()
+	assert.Equal(t, "clutch.service.github", svc.Fields["name"].GetStringValue())
+	typedConfig := svc.Fields["typed_config"].GetStructValue()
+	assert.Equal(t, "type.googleapis.com/clutch.config.service.github.v1.Config", typedConfig.Fields["@type"].GetStringValue())
+	assert.Equal(t, redacted, typedConfig.Fields["access_token"].GetStringValue())
+
+	// Values that had a secret reference are redacted wherever they appear, but other values are not.
+	assert.Equal(t, redacted, fields["includes"].GetListValue().Values[0].GetStringValue())
+	assert.Equal(t, "/etc/clutch/hunter3", fields["includes"].GetListValue().Values[1].GetStringValue())
+}
+
+func TestRedactConfigSecretReferences(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clutch-introspection")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	os.Setenv("CLUTCH_TEST_HONEYCOMB_KEY", "3a1f")
+	defer os.Unsetenv("CLUTCH_TEST_HONEYCOMB_KEY")
+
+	// The header's name doesn't suggest it is sensitive.
+	config := `
+gateway:
+  listener:
+    tcp:
+      address: 0.0.0.0
+      port: 8080
+  logger: {}
+  stats: {}
+  tracing:
+    otlp_exporter:
+      endpoint: https://api.honeycomb.io
+      headers:
+        x-honeycomb-team: ${secret:env:CLUTCH_TEST_HONEYCOMB_KEY}
+services:
+  - name: clutch.service.authz
+    typed_config:
+      "@type": types.google.com/clutch.config.service.authz.v1.Config
+      roles:
+        - role_name: 3a1f-viewers
+`
+	path := filepath.Join(dir, "clutch-config.yaml")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(config), 0600))
+
+	cfg, _, err := loadConfig(&Flags{ConfigPaths: []string{path}})
+	assert.NoError(t, err)
+	s, err := redactConfig(cfg)
+	assert.NoError(t, err)
+
+	gateway := s.Fields["gateway"].GetStructValue()
+	otlp := gateway.Fields["tracing"].GetStructValue().Fields["otlp_exporter"].GetStructValue()
+	assert.Equal(t, redacted, otlp.Fields["headers"].GetStructValue().Fields["x-honeycomb-team"].GetStringValue())
+
+	// Other values are not redacted because they contain the secret's value.
+	svc := s.Fields["services"].GetListValue().Values[0].GetStructValue()
+	roles := svc.Fields["typed_config"].GetStructValue().Fields["roles"].GetListValue()
+	assert.Equal(t, "3a1f-viewers", roles.Values[0].GetStructValue().Fields["role_name"].GetStringValue())
+}
+
+func TestComponent(t *testing.T) {
+	github, err := ptypes.MarshalAny(&githubv1.Config{})
+	assert.NoError(t, err)
+
+	assert.Equal(t,
+		meta.Component{Name: "clutch.service.github", TypeURL: "type.googleapis.com/clutch.config.service.github.v1.Config"},
+		component(&gatewayv1.Service{Name: "clutch.service.github", TypedConfig: github}),
+	)
+	assert.Equal(t, meta.Component{Name: "clutch.module.healthcheck"}, component(&gatewayv1.Module{Name: "clutch.module.healthcheck"}))
+}
@@ -0,0 +1,106 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const defaultShutdownTimeout = time.Second * 15
+
+// Starter is an optional interface for services, resolvers, middleware, and modules that run background work such as
+// pollers. Start is called once all components are registered and before the gateway begins serving, in the order the
+// components were instantiated. The context passed to Start is cancelled when the gateway shuts down.
+type Starter interface {
+	Start(ctx context.Context) error
+}
+
+// Stopper is an optional interface for components that hold resources that should be released on shutdown, e.g.
+// database pools. Stop is called after in-flight requests have drained, in the reverse order of instantiation, so that a
+// component is always stopped before the components it depends on.
+type Stopper interface {
+	Stop(ctx context.Context) error
+}
+
+type lifecycleComponent struct {
+	name      string
+	component interface{}
+}
+
+// lifecycle tracks instantiated components in order so that they can be started and stopped.
+type lifecycle struct {
+	components []lifecycleComponent
+	started    int
+}
+
+func (l *lifecycle) add(name string, component interface{}) {
+	l.components = append(l.components, lifecycleComponent{name: name, component: component})
+}
+
+func (l *lifecycle) start(ctx context.Context, logger *zap.Logger) error {
+	for _, c := range l.components {
+		if s, ok := c.component.(Starter); ok {
+			logger.Info("starting component", zap.String("componentName", c.name))
+			if err := s.Start(ctx); err != nil {
+				return fmt.Errorf("component '%s' failed to start: %w", c.name, err)
+			}
+		}
+		l.started++
+	}
+	return nil
+}
+
+// Only components that started successfully (or had nothing to start) are stopped.
+func (l *lifecycle) stop(ctx context.Context, logger *zap.Logger) {
+	for i := l.started - 1; i >= 0; i-- {
+		c := l.components[i]
+		if s, ok := c.component.(Stopper); ok {
+			logger.Info("stopping component", zap.String("componentName", c.name))
+			if err := s.Stop(ctx); err != nil {
+				logger.Error("component failed to stop", zap.String("componentName", c.name), zap.Error(err))
+			}
+		}
+	}
+	l.started = 0
+}
+
+// inflightHandler counts the requests currently being served. http.Server.Shutdown does not wait on hijacked
+// connections, which includes all h2c (i.e. plaintext gRPC) traffic, so requests are tracked here in order to drain them.
+type inflightHandler struct {
+	next  http.Handler
+	count int64
+}
+
+func (h *inflightHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	atomic.AddInt64(&h.count, 1)
+	defer atomic.AddInt64(&h.count, -1)
+	h.next.ServeHTTP(w, r)
+}
+
+// wait blocks until there are no requests in flight or the context is done.
+func (h *inflightHandler) wait(ctx context.Context) error {
+	ticker := time.NewTicker(time.Millisecond * 50)
+	defer ticker.Stop()
+	for {
+		if atomic.LoadInt64(&h.count) == 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// drain stops the server from accepting new connections and waits for in-flight requests to complete.
+func drain(ctx context.Context, srv *http.Server, inflight *inflightHandler) error {
+	if err := srv.Shutdown(ctx); err != nil {
+		return err
+	}
+	return inflight.wait(ctx)
+}
@@ -0,0 +1,107 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+type testComponent struct {
+	name     string
+	startErr error
+	events   *[]string
+}
+
+func (c *testComponent) Start(context.Context) error {
+	*c.events = append(*c.events, "start "+c.name)
+	return c.startErr
+}
+
+func (c *testComponent) Stop(context.Context) error {
+	*c.events = append(*c.events, "stop "+c.name)
+	return nil
+}
+
+type stopOnlyComponent struct {
+	name   string
+	events *[]string
+}
+
+func (c *stopOnlyComponent) Stop(context.Context) error {
+	*c.events = append(*c.events, "stop "+c.name)
+	return nil
+}
+
+func TestLifecycleOrder(t *testing.T) {
+	var events []string
+	lc := &lifecycle{}
+	lc.add("db", &stopOnlyComponent{name: "db", events: &events})
+	lc.add("none", struct{}{})
+	lc.add("poller", &testComponent{name: "poller", events: &events})
+	lc.add("module", &testComponent{name: "module", events: &events})
+
+	assert.NoError(t, lc.start(context.Background(), zaptest.NewLogger(t)))
+	lc.stop(context.Background(), zaptest.NewLogger(t))
+
+	assert.Equal(t, []string{
+		"start poller",
+		"start module",
+		"stop module",
+		"stop poller",
+		"stop db",
+	}, events)
+
+	// Stopping again is a no-op.
+	lc.stop(context.Background(), zaptest.NewLogger(t))
+	assert.Len(t, events, 5)
+}
+
+func TestLifecycleStartFailure(t *testing.T) {
+	var events []string
+	lc := &lifecycle{}
+	lc.add("db", &stopOnlyComponent{name: "db", events: &events})
+	lc.add("poller", &testComponent{name: "poller", events: &events})
+	lc.add("broken", &testComponent{name: "broken", events: &events, startErr: errors.New("boom")})
+	lc.add("module", &testComponent{name: "module", events: &events})
+
+	err := lc.start(context.Background(), zaptest.NewLogger(t))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "broken")
+
+	// Only components that started are stopped.
+	lc.stop(context.Background(), zaptest.NewLogger(t))
+	assert.Equal(t, []string{
+		"start poller",
+		"start broken",
+		"stop poller",
+		"stop db",
+	}, events)
+}
+
+func TestInflightHandler(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	h := &inflightHandler{next: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	})}
+
+	// Nothing in flight.
+	assert.NoError(t, h.wait(context.Background()))
+
+	go h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, h.wait(ctx))
+
+	close(release)
+	assert.NoError(t, h.wait(context.Background()))
+}
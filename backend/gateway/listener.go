@@ -0,0 +1,277 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/test/bufconn"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	"github.com/lyft/clutch/backend/clientinfo"
+	"github.com/lyft/clutch/backend/gateway/mux"
+	"github.com/lyft/clutch/backend/service"
+)
+
+const (
+	defaultListenerName   = "default"
+	defaultUnixSocketMode = 0660
+
+	loopbackBufferSize = 1024 * 1024
+)
+
+// listeners returns the primary listener followed by the additional listeners, checking that their names are unique,
+// that the modules and middleware they refer to are configured, and that every module is served by a listener.
+func listeners(cfg *gatewayv1.Config) ([]*gatewayv1.Listener, error) {
+	if l := cfg.Gateway.JsonGrpcLoopbackListener; l != nil {
+		if l.Name != "" || len(l.Modules) > 0 || len(l.Middleware) > 0 {
+			return nil, errors.New("the json grpc loopback listener can't set a name, modules, or middleware")
+		}
+	}
+
+	primary := cfg.Gateway.Listener
+	if primary.Name == "" {
+		primary = &gatewayv1.Listener{
+			Socket:     primary.Socket,
+			Name:       defaultListenerName,
+			Modules:    primary.Modules,
+			Middleware: primary.Middleware,
+		}
+	}
+	ret := append([]*gatewayv1.Listener{primary}, cfg.Gateway.AdditionalListeners...)
+
+	modules := make(map[string]bool, len(cfg.Modules))
+	for _, m := range cfg.Modules {
+		modules[m.Name] = false
+	}
+	middleware := make(map[string]bool, len(cfg.Gateway.Middleware))
+	for _, m := range cfg.Gateway.Middleware {
+		middleware[m.Name] = true
+	}
+
+	names := make(map[string]bool, len(ret))
+	for _, l := range ret {
+		if l.Name == "" {
+			return nil, errors.New("additional listeners must have a name")
+		}
+		if names[l.Name] {
+			return nil, fmt.Errorf("listener name '%s' is not unique", l.Name)
+		}
+		names[l.Name] = true
+
+		for _, name := range l.Modules {
+			if _, ok := modules[name]; !ok {
+				return nil, fmt.Errorf("listener '%s' serves module '%s' which is not configured", l.Name, name)
+			}
+		}
+		for _, name := range l.Middleware {
+			if !middleware[name] {
+				return nil, fmt.Errorf("listener '%s' applies middleware '%s' which is not configured", l.Name, name)
+			}
+		}
+		for name := range modules {
+			if serves(l.Modules, name) {
+				modules[name] = true
+			}
+		}
+	}
+
+	for _, m := range cfg.Modules {
+		if !modules[m.Name] {
+			return nil, fmt.Errorf("module '%s' is not served by any listener", m.Name)
+		}
+	}
+	return ret, nil
+}
+
+// serves returns whether the named component is in the listener's list of components, where an empty list includes
+// every component.
+func serves(names []string, name string) bool {
+	return len(names) == 0 || containsString(names, name)
+}
+
+func listenerAddr(cfg *gatewayv1.Listener) string {
+	switch t := cfg.Socket.(type) {
+	case *gatewayv1.Listener_Tcp:
+		return fmt.Sprintf("%s:%d", t.Tcp.Address, t.Tcp.Port)
+	case *gatewayv1.Listener_Unix:
+		return t.Unix.Path
+	default:
+		return ""
+	}
+}
+
+// listen opens the listener's socket.
+func listen(cfg *gatewayv1.Listener) (net.Listener, error) {
+	switch t := cfg.Socket.(type) {
+	case *gatewayv1.Listener_Tcp:
+		return net.Listen("tcp", listenerAddr(cfg))
+	case *gatewayv1.Listener_Unix:
+		return listenUnix(t.Unix)
+	default:
+		return nil, fmt.Errorf("socket not supported: %T", t)
+	}
+}
+
+func listenUnix(cfg *gatewayv1.UnixSocket) (net.Listener, error) {
+	// Only remove sockets, in case the path was misconfigured to point at a regular file.
+	if fi, err := os.Lstat(cfg.Path); err == nil {
+		if fi.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("'%s' exists and is not a socket", cfg.Path)
+		}
+		if err := os.Remove(cfg.Path); err != nil {
+			return nil, err
+		}
+	}
+
+	lis, err := net.Listen("unix", cfg.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	mode := os.FileMode(defaultUnixSocketMode)
+	if cfg.Mode != 0 {
+		mode = os.FileMode(cfg.Mode)
+	}
+	if err := os.Chmod(cfg.Path, mode); err != nil {
+		_ = lis.Close()
+		return nil, err
+	}
+	return lis, nil
+}
+
+// listenerCertificates loads the TLS certificates for the listener if it is secure.
+func listenerCertificates(cfg *gatewayv1.Listener, logger *zap.Logger) (*certificateReloader, error) {
+	if !cfg.GetTcp().GetSecure() {
+		return nil, nil
+	}
+	return newCertificateReloader(cfg.GetTcp().Tls, logger)
+}
+
+// server serves HTTP and gRPC traffic on a listener.
+type server struct {
+	name     string
+	addr     string
+	lis      net.Listener
+	certs    *certificateReloader
+	inflight *inflightHandler
+	srv      *http.Server
+	grpc     *grpc.Server
+}
+
+func newServer(name, addr string, lis net.Listener, certs *certificateReloader, handler http.Handler, grpcServer *grpc.Server) *server {
+	inflight := &inflightHandler{next: handler}
+	srv := &http.Server{
+		WriteTimeout: 15 * time.Second,
+		ReadTimeout:  15 * time.Second,
+	}
+	if certs != nil {
+		// HTTP/2 is negotiated via ALPN when serving TLS, so h2c is not needed.
+		srv.Handler = inflight
+		srv.TLSConfig = certs.serverConfig()
+	} else {
+		srv.Handler = mux.InsecureServerHandler(srv, inflight)
+	}
+	return &server{name: name, addr: addr, lis: lis, certs: certs, inflight: inflight, srv: srv, grpc: grpcServer}
+}
+
+func (s *server) serve() error {
+	if s.certs != nil {
+		return s.srv.ServeTLS(s.lis, "", "")
+	}
+	return s.srv.Serve(s.lis)
+}
+
+// drain stops the server from accepting new connections and waits for in-flight requests to complete.
+func (s *server) drain(ctx context.Context) error {
+	return drain(ctx, s.srv, s.inflight)
+}
+
+// stop stops the gRPC server, which cancels the RPCs still in flight, e.g. long-lived streams, and closes the server.
+func (s *server) stop() {
+	s.grpc.Stop()
+	_ = s.srv.Close()
+}
+
+// drainServers stops the servers gracefully and concurrently, stopping any that don't finish before the context is done.
+//
+// grpc.Server.GracefulStop can't be used since the gRPC server is served by the HTTP server, and draining its HTTP
+// transport panics. Shutting down the HTTP server does the same instead: clients are sent GOAWAY so that they don't
+// start new RPCs, and in-flight RPCs are waited on until the timeout, after which the gRPC server is stopped.
+func drainServers(ctx context.Context, servers []*server, logger *zap.Logger) {
+	var wg sync.WaitGroup
+	for _, s := range servers {
+		wg.Add(1)
+		go func(s *server) {
+			defer wg.Done()
+			if err := s.drain(ctx); err != nil {
+				logger.Warn("in-flight requests did not complete before shutdown timeout",
+					zap.String("listenerName", s.name), zap.Error(err))
+				s.stop()
+			}
+		}(s)
+	}
+	wg.Wait()
+}
+
+// newLoopback creates the server that the JSON gateway's handlers use to reach the gRPC server, and returns it along
+// with the options for dialing it. If no listener is configured, an in-memory connection is used. Only requests over
+// the in-memory connection are marked so that the metadata the gateway adds to them, e.g. the client's address, can be
+// trusted, since clients can connect to a socket themselves and add the same metadata.
+func newLoopback(name string, cfg *gatewayv1.Listener, grpcServer *grpc.Server, logger *zap.Logger) (*server, []grpc.DialOption, error) {
+	if cfg == nil {
+		lis := bufconn.Listen(loopbackBufferSize)
+		opts := []grpc.DialOption{
+			grpc.WithInsecure(),
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		}
+		return newServer(name, "memory", lis, nil, clientinfo.LoopbackHandler(grpcServer), grpcServer), opts, nil
+	}
+
+	certs, err := listenerCertificates(cfg, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	lis, err := listen(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	network, addr := lis.Addr().Network(), listenerAddr(cfg)
+	opts := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, addr)
+		}),
+	}
+	if certs != nil {
+		opts[0] = grpc.WithTransportCredentials(credentials.NewTLS(certs.clientConfig()))
+	}
+	return newServer(name, addr, lis, certs, grpcServer, grpcServer), opts, nil
+}
+
+// clientInfoHeaders returns the headers that the JSON gateway forwards for a service that identifies clients with the
+// information the gateway forwards. The information is only trusted over the in-memory loopback, so these services
+// can't be used with a socket loopback.
+func clientInfoHeaders(cfg *gatewayv1.GatewayOptions, svc service.Service) ([]string, error) {
+	consumer, ok := svc.(clientinfo.Consumer)
+	if !ok {
+		return nil, nil
+	}
+	headers, ok := consumer.ClientInfo()
+	if !ok {
+		return nil, nil
+	}
+	if cfg.JsonGrpcLoopbackListener != nil {
+		return nil, errors.New("the json grpc loopback listener can't be used with a service that identifies clients by their address, certificate, or headers, since they are only forwarded over the in-memory loopback")
+	}
+	return headers, nil
+}
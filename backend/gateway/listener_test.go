@@ -0,0 +1,249 @@
+package gateway
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
+)
+
+func tcpListener(name string, port uint32) *gatewayv1.Listener {
+	return &gatewayv1.Listener{
+		Name:   name,
+		Socket: &gatewayv1.Listener_Tcp{Tcp: &gatewayv1.TCPSocket{Address: "127.0.0.1", Port: port}},
+	}
+}
+
+func listenersConfig() *gatewayv1.Config {
+	internal := tcpListener("internal", 9000)
+	internal.Modules = []string{"clutch.module.rtds"}
+	internal.Middleware = []string{"clutch.middleware.stats"}
+
+	return &gatewayv1.Config{
+		Gateway: &gatewayv1.GatewayOptions{
+			Listener:            tcpListener("", 8080),
+			AdditionalListeners: []*gatewayv1.Listener{internal},
+			Middleware:          []*gatewayv1.Middleware{{Name: "clutch.middleware.stats"}},
+		},
+		Modules: []*gatewayv1.Module{{Name: "clutch.module.healthcheck"}, {Name: "clutch.module.rtds"}},
+	}
+}
+
+func TestListeners(t *testing.T) {
+	cfg := listenersConfig()
+	ret, err := listeners(cfg)
+	assert.NoError(t, err)
+	assert.Len(t, ret, 2)
+	assert.Equal(t, defaultListenerName, ret[0].Name)
+	assert.Equal(t, "internal", ret[1].Name)
+	// The configuration is not modified.
+	assert.Empty(t, cfg.Gateway.Listener.Name)
+
+	testCases := []struct {
+		name   string
+		modify func(cfg *gatewayv1.Config)
+		err    string
+	}{
+		{
+			name:   "missing name",
+			modify: func(cfg *gatewayv1.Config) { cfg.Gateway.AdditionalListeners[0].Name = "" },
+			err:    "must have a name",
+		},
+		{
+			name:   "duplicate name",
+			modify: func(cfg *gatewayv1.Config) { cfg.Gateway.AdditionalListeners[0].Name = defaultListenerName },
+			err:    "not unique",
+		},
+		{
+			name: "unknown module",
+			modify: func(cfg *gatewayv1.Config) {
+				cfg.Gateway.AdditionalListeners[0].Modules = []string{"clutch.module.k8s"}
+			},
+			err: "module 'clutch.module.k8s' which is not configured",
+		},
+		{
+			name: "unknown middleware",
+			modify: func(cfg *gatewayv1.Config) {
+				cfg.Gateway.AdditionalListeners[0].Middleware = []string{"clutch.middleware.audit"}
+			},
+			err: "middleware 'clutch.middleware.audit' which is not configured",
+		},
+		{
+			name: "unserved module",
+			modify: func(cfg *gatewayv1.Config) {
+				cfg.Gateway.Listener.Modules = []string{"clutch.module.healthcheck"}
+				cfg.Gateway.AdditionalListeners[0].Modules = []string{"clutch.module.healthcheck"}
+			},
+			err: "'clutch.module.rtds' is not served by any listener",
+		},
+		{
+			name:   "named loopback",
+			modify: func(cfg *gatewayv1.Config) { cfg.Gateway.JsonGrpcLoopbackListener = tcpListener("loopback", 8081) },
+			err:    "loopback listener",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := listenersConfig()
+			tt.modify(cfg)
+
+			_, err := listeners(cfg)
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
+
+type clientInfoService struct {
+	headers []string
+	ok      bool
+}
+
+func (s clientInfoService) ClientInfo() ([]string, bool) { return s.headers, s.ok }
+
+func TestClientInfoHeaders(t *testing.T) {
+	cfg := &gatewayv1.GatewayOptions{}
+	headers, err := clientInfoHeaders(cfg, struct{}{})
+	assert.NoError(t, err)
+	assert.Empty(t, headers)
+
+	headers, err = clientInfoHeaders(cfg, clientInfoService{headers: []string{"X-Forwarded-Email"}, ok: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"X-Forwarded-Email"}, headers)
+
+	// Socket loopbacks can only be used with services that don't need the client's information.
+	cfg.JsonGrpcLoopbackListener = tcpListener("", 8081)
+	_, err = clientInfoHeaders(cfg, clientInfoService{})
+	assert.NoError(t, err)
+	_, err = clientInfoHeaders(cfg, clientInfoService{ok: true})
+	assert.Error(t, err)
+}
+
+func TestServes(t *testing.T) {
+	assert.True(t, serves(nil, "clutch.module.k8s"))
+	assert.True(t, serves([]string{"clutch.module.k8s"}, "clutch.module.k8s"))
+	assert.False(t, serves([]string{"clutch.module.k8s"}, "clutch.module.aws"))
+}
+
+func TestListenUnix(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clutch-listener")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "clutch.sock")
+
+	// A socket left behind by a previous process is replaced.
+	stale, err := net.Listen("unix", path)
+	assert.NoError(t, err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	assert.NoError(t, stale.Close())
+
+	lis, err := listen(&gatewayv1.Listener{Socket: &gatewayv1.Listener_Unix{Unix: &gatewayv1.UnixSocket{Path: path}}})
+	assert.NoError(t, err)
+	fi, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(defaultUnixSocketMode), fi.Mode().Perm())
+	assert.NoError(t, lis.Close())
+
+	lis, err = listen(&gatewayv1.Listener{Socket: &gatewayv1.Listener_Unix{Unix: &gatewayv1.UnixSocket{Path: path, Mode: 0600}}})
+	assert.NoError(t, err)
+	fi, err = os.Stat(path)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+	assert.NoError(t, lis.Close())
+
+	// Other files are not removed.
+	file := filepath.Join(dir, "config.yaml")
+	assert.NoError(t, ioutil.WriteFile(file, nil, 0600))
+	_, err = listen(&gatewayv1.Listener{Socket: &gatewayv1.Listener_Unix{Unix: &gatewayv1.UnixSocket{Path: file}}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not a socket")
+}
+
+type healthcheckServer struct {
+	healthcheckv1.UnimplementedHealthcheckAPIServer
+}
+
+func (healthcheckServer) Healthcheck(context.Context, *healthcheckv1.HealthcheckRequest) (*healthcheckv1.HealthcheckResponse, error) {
+	return &healthcheckv1.HealthcheckResponse{}, nil
+}
+
+func TestLoopback(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clutch-listener")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	testCases := []struct {
+		name string
+		cfg  *gatewayv1.Listener
+	}{
+		{name: "memory"},
+		{name: "unix", cfg: &gatewayv1.Listener{Socket: &gatewayv1.Listener_Unix{Unix: &gatewayv1.UnixSocket{Path: filepath.Join(dir, "loopback.sock")}}}},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			grpcServer := grpc.NewServer()
+			healthcheckv1.RegisterHealthcheckAPIServer(grpcServer, &healthcheckServer{})
+
+			s, opts, err := newLoopback("loopback", tt.cfg, grpcServer, zaptest.NewLogger(t))
+			assert.NoError(t, err)
+			go func() { _ = s.serve() }()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			conn, err := grpc.DialContext(ctx, s.addr, opts...)
+			assert.NoError(t, err)
+			defer conn.Close()
+
+			_, err = healthcheckv1.NewHealthcheckAPIClient(conn).Healthcheck(ctx, &healthcheckv1.HealthcheckRequest{})
+			assert.NoError(t, err)
+
+			drainServers(ctx, []*server{s}, zaptest.NewLogger(t))
+		})
+	}
+}
+
+func TestDrainServersStopsStreams(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, health.NewServer())
+
+	s, opts, err := newLoopback("loopback", nil, grpcServer, zaptest.NewLogger(t))
+	assert.NoError(t, err)
+	go func() { _ = s.serve() }()
+
+	conn, err := grpc.DialContext(context.Background(), s.addr, opts...)
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	// Watch streams until the client cancels it.
+	stream, err := healthpb.NewHealthClient(conn).Watch(context.Background(), &healthpb.HealthCheckRequest{})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	drainServers(ctx, []*server{s}, zaptest.NewLogger(t))
+
+	// The stream is ended by stopping the gRPC server once the timeout passes.
+	_, err = stream.Recv()
+	assert.Error(t, err)
+	assert.Equal(t, int64(0), atomic.LoadInt64(&s.inflight.count))
+}
@@ -0,0 +1,170 @@
+// Package logging gives each component of the gateway a named logger whose level can be changed while the gateway is
+// running, e.g. to debug a single service without restarting or enabling debug logs for everything.
+package logging
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// The name of the gateway's own logger, which is used by the gateway and its built-in middleware.
+const GatewayName = "gateway"
+
+// ErrUnknownComponent is returned when changing the level of a component that doesn't have a logger.
+var ErrUnknownComponent = errors.New("no logger for component")
+
+// Level describes the current level of a component's logger.
+type Level struct {
+	Component string
+	Level     zapcore.Level
+	// The level from the gateway's configuration, which the logger returns to when it is reset.
+	ConfiguredLevel zapcore.Level
+	// If the level was changed with a TTL, when it will be reset. Otherwise zero.
+	RevertTime time.Time
+}
+
+// Levels holds the levels of the component loggers.
+type Levels struct {
+	configured zapcore.Level
+
+	mu         sync.Mutex
+	components map[string]*componentLevel
+}
+
+type componentLevel struct {
+	level      zap.AtomicLevel
+	revertTime time.Time
+	revert     *time.Timer
+}
+
+func NewLevels(configured zapcore.Level) *Levels {
+	return &Levels{
+		configured: configured,
+		components: make(map[string]*componentLevel),
+	}
+}
+
+// Logger returns a logger named after the component, which logs at the component's level. The base logger must be
+// enabled for all levels, i.e. only the component's level filters entries.
+func (l *Levels) Logger(base *zap.Logger, component string) *zap.Logger {
+	l.mu.Lock()
+	c, ok := l.components[component]
+	if !ok {
+		c = &componentLevel{level: zap.NewAtomicLevelAt(l.configured)}
+		l.components[component] = c
+	}
+	l.mu.Unlock()
+
+	logger := base.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return &levelCore{Core: core, level: c.level}
+	}))
+	if component == GatewayName {
+		return logger
+	}
+	return logger.Named(component)
+}
+
+// Set changes the level of the component's logger. If the TTL is positive, the level is reset to the configured level
+// once it passes.
+func (l *Levels) Set(component string, level zapcore.Level, ttl time.Duration) (Level, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	c, ok := l.components[component]
+	if !ok {
+		return Level{}, fmt.Errorf("%w '%s'", ErrUnknownComponent, component)
+	}
+
+	if c.revert != nil {
+		c.revert.Stop()
+		c.revert = nil
+	}
+	c.revertTime = time.Time{}
+	c.level.SetLevel(level)
+
+	if ttl > 0 {
+		c.revertTime = time.Now().Add(ttl)
+		var revert *time.Timer
+		revert = time.AfterFunc(ttl, func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			// The level may have been changed again since the timer fired.
+			if c.revert != revert {
+				return
+			}
+			c.revert = nil
+			c.revertTime = time.Time{}
+			c.level.SetLevel(l.configured)
+		})
+		c.revert = revert
+	}
+	return l.level(component, c), nil
+}
+
+// Reset returns the component's logger to the configured level.
+func (l *Levels) Reset(component string) (Level, error) {
+	return l.Set(component, l.configured, 0)
+}
+
+// List returns the levels of all component loggers, sorted by component name.
+func (l *Levels) List() []Level {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	ret := make([]Level, 0, len(l.components))
+	for name, c := range l.components {
+		ret = append(ret, l.level(name, c))
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Component < ret[j].Component })
+	return ret
+}
+
+func (l *Levels) level(name string, c *componentLevel) Level {
+	return Level{
+		Component:       name,
+		Level:           c.level.Level(),
+		ConfiguredLevel: l.configured,
+		RevertTime:      c.revertTime,
+	}
+}
+
+// levelCore filters entries from the wrapped core by the component's level.
+type levelCore struct {
+	zapcore.Core
+	level zap.AtomicLevel
+}
+
+func (c *levelCore) Enabled(level zapcore.Level) bool {
+	return c.level.Enabled(level)
+}
+
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelCore{Core: c.Core.With(fields), level: c.level}
+}
+
+func (c *levelCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.Enabled(entry.Level) {
+		return ce
+	}
+	return c.Core.Check(entry, ce)
+}
+
+var levels atomic.Value
+
+// SetLevels is called by the gateway once the component loggers are created.
+func SetLevels(l *Levels) {
+	levels.Store(l)
+}
+
+// GetLevels returns the levels set by the gateway, or nil if they haven't been set yet.
+func GetLevels() *Levels {
+	l, _ := levels.Load().(*Levels)
+	return l
+}
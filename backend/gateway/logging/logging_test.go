@@ -0,0 +1,94 @@
+package logging
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestLogger(t *testing.T) {
+	core, logs := observer.New(zap.DebugLevel)
+	base := zap.New(core)
+	levels := NewLevels(zap.InfoLevel)
+
+	gateway := levels.Logger(base, GatewayName)
+	svc := levels.Logger(base, "clutch.service.k8s").With(zap.String("serviceName", "clutch.service.k8s"))
+
+	gateway.Debug("gateway debug")
+	svc.Debug("service debug")
+	svc.Info("service info")
+	assert.Equal(t, 1, logs.Len())
+
+	_, err := levels.Set("clutch.service.k8s", zap.DebugLevel, 0)
+	assert.NoError(t, err)
+	gateway.Debug("gateway debug")
+	svc.Debug("service debug")
+
+	entries := logs.TakeAll()
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "service info", entries[0].Message)
+	assert.Equal(t, "clutch.service.k8s", entries[0].LoggerName)
+	assert.Equal(t, "service debug", entries[1].Message)
+	assert.Equal(t, "clutch.service.k8s", entries[1].ContextMap()["serviceName"])
+
+	// The gateway's logger isn't named.
+	gateway.Info("gateway info")
+	assert.Equal(t, "", logs.TakeAll()[0].LoggerName)
+
+	// Loggers for the same component share the level.
+	levels.Logger(base, "clutch.service.k8s").Debug("service debug")
+	assert.Equal(t, 1, logs.Len())
+}
+
+func TestSet(t *testing.T) {
+	levels := NewLevels(zap.InfoLevel)
+	_ = levels.Logger(zap.NewNop(), GatewayName)
+	_ = levels.Logger(zap.NewNop(), "clutch.module.k8s")
+
+	_, err := levels.Set("clutch.module.foo", zap.DebugLevel, 0)
+	assert.True(t, errors.Is(err, ErrUnknownComponent))
+
+	level, err := levels.Set("clutch.module.k8s", zap.ErrorLevel, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, "clutch.module.k8s", level.Component)
+	assert.Equal(t, zapcore.ErrorLevel, level.Level)
+	assert.Equal(t, zapcore.InfoLevel, level.ConfiguredLevel)
+	assert.False(t, level.RevertTime.IsZero())
+
+	assert.Equal(t, []Level{
+		{Component: "clutch.module.k8s", Level: zap.ErrorLevel, ConfiguredLevel: zap.InfoLevel, RevertTime: level.RevertTime},
+		{Component: GatewayName, Level: zap.InfoLevel, ConfiguredLevel: zap.InfoLevel},
+	}, levels.List())
+
+	level, err = levels.Reset("clutch.module.k8s")
+	assert.NoError(t, err)
+	assert.Equal(t, zapcore.InfoLevel, level.Level)
+	assert.True(t, level.RevertTime.IsZero())
+}
+
+func TestSetTTL(t *testing.T) {
+	levels := NewLevels(zap.InfoLevel)
+	_ = levels.Logger(zap.NewNop(), GatewayName)
+
+	_, err := levels.Set(GatewayName, zap.DebugLevel, 10*time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, zapcore.DebugLevel, levels.List()[0].Level)
+
+	assert.Eventually(t, func() bool {
+		l := levels.List()[0]
+		return l.Level == zap.InfoLevel && l.RevertTime.IsZero()
+	}, time.Second, time.Millisecond)
+
+	// Changing the level again cancels the pending revert.
+	_, err = levels.Set(GatewayName, zap.DebugLevel, 10*time.Millisecond)
+	assert.NoError(t, err)
+	_, err = levels.Set(GatewayName, zap.WarnLevel, 0)
+	assert.NoError(t, err)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, zapcore.WarnLevel, levels.List()[0].Level)
+}
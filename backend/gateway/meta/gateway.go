@@ -0,0 +1,40 @@
+package meta
+
+import (
+	"sync/atomic"
+
+	structpb "github.com/golang/protobuf/ptypes/struct"
+)
+
+// Component is a service, resolver, middleware, or module instantiated by the gateway.
+type Component struct {
+	Name string
+	// The type URL of the component's configuration, if it has one.
+	TypeURL string
+}
+
+// GatewayInfo describes what the running gateway loaded.
+type GatewayInfo struct {
+	// Services are in the order they were instantiated, i.e. dependencies first.
+	Services  []Component
+	Resolvers []Component
+	// Middleware are in the order that requests pass through them.
+	Middleware []Component
+	Modules    []Component
+
+	// The configuration currently in effect with secrets redacted.
+	Config *structpb.Struct
+}
+
+var gatewayInfo atomic.Value
+
+// SetGatewayInfo is called by the gateway once all components are registered and whenever the configuration is reloaded.
+func SetGatewayInfo(info *GatewayInfo) {
+	gatewayInfo.Store(info)
+}
+
+// GetGatewayInfo returns the information set by the gateway, or nil if it hasn't been set yet.
+func GetGatewayInfo() *GatewayInfo {
+	info, _ := gatewayInfo.Load().(*GatewayInfo)
+	return info
+}
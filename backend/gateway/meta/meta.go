@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/golang/protobuf/descriptor"
@@ -24,17 +25,20 @@ var (
 	fieldNameRegexp = regexp.MustCompile(`{(\w+)}`)
 )
 
-func GenerateGRPCMetadata(server *grpc.Server) error {
-	serviceDescriptors, err := grpcreflect.LoadServiceDescriptors(server)
-	if err != nil {
-		return err
-	}
-
+// GenerateGRPCMetadata saves the methods of all services registered on the servers, e.g. one for each listener.
+func GenerateGRPCMetadata(servers ...*grpc.Server) error {
 	mds := make(map[string]*desc.MethodDescriptor)
-	for _, sd := range serviceDescriptors {
-		for _, md := range sd.GetMethods() {
-			methodName := fmt.Sprintf("/%s/%s", sd.GetFullyQualifiedName(), md.GetName())
-			mds[methodName] = md
+	for _, server := range servers {
+		serviceDescriptors, err := grpcreflect.LoadServiceDescriptors(server)
+		if err != nil {
+			return err
+		}
+
+		for _, sd := range serviceDescriptors {
+			for _, md := range sd.GetMethods() {
+				methodName := fmt.Sprintf("/%s/%s", sd.GetFullyQualifiedName(), md.GetName())
+				mds[methodName] = md
+			}
 		}
 	}
 
@@ -42,6 +46,16 @@ func GenerateGRPCMetadata(server *grpc.Server) error {
 	return nil
 }
 
+// Methods returns the full names of all methods served by the gateway in sorted order.
+func Methods() []string {
+	ret := make([]string, 0, len(methodDescriptors))
+	for name := range methodDescriptors {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
 func GetAction(method string) apiv1.ActionType {
 	md, ok := methodDescriptors[method]
 	if !ok {
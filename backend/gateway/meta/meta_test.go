@@ -5,6 +5,8 @@ import (
 
 	"github.com/golang/protobuf/descriptor"
 	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
 	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
@@ -26,7 +28,7 @@ func (m *mockRegistrar) RegisterJSONGateway(handlerFunc module.GatewayRegisterAP
 }
 
 func TestGetAction(t *testing.T) {
-	hc, err := healthcheck.New(nil, nil, nil)
+	hc, err := healthcheck.New(nil, zap.NewNop(), tally.NoopScope)
 	assert.NoError(t, err)
 
 	r := &mockRegistrar{s: grpc.NewServer()}
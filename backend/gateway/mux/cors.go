@@ -0,0 +1,137 @@
+package mux
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gobwas/glob"
+	"github.com/golang/protobuf/ptypes"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	"github.com/lyft/clutch/backend/requestid"
+)
+
+var (
+	corsAllowedMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+	// Headers sent by the JSON gateway's clients and by gRPC-Web clients.
+	corsAllowedHeaders = []string{
+		"Accept",
+		"Authorization",
+		"Content-Type",
+		"Grpc-Timeout",
+		"Traceparent",
+		"Tracestate",
+		"X-Grpc-Web",
+		"X-User-Agent",
+		requestid.Header,
+	}
+
+	corsExposedHeaders = []string{
+		"Grpc-Status",
+		"Grpc-Message",
+		"Grpc-Status-Details-Bin",
+		"Retry-After",
+		requestid.Header,
+	}
+)
+
+type corsPolicy struct {
+	anyOrigin      bool
+	origins        []glob.Glob
+	allowedHeaders string
+	exposedHeaders string
+	credentials    bool
+	maxAge         string
+}
+
+func newCORSPolicy(cfg *gatewayv1.CORS) (*corsPolicy, error) {
+	p := &corsPolicy{
+		allowedHeaders: strings.Join(append(append([]string{}, corsAllowedHeaders...), cfg.AllowedHeaders...), ", "),
+		exposedHeaders: strings.Join(append(append([]string{}, corsExposedHeaders...), cfg.ExposedHeaders...), ", "),
+		credentials:    cfg.AllowCredentials,
+	}
+
+	for _, origin := range cfg.AllowedOrigins {
+		if origin == "*" {
+			p.anyOrigin = true
+			continue
+		}
+		g, err := glob.Compile(strings.ToLower(origin), '.')
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed origin '%s': %w", origin, err)
+		}
+		p.origins = append(p.origins, g)
+	}
+	if p.anyOrigin && p.credentials {
+		return nil, errors.New("credentials can't be allowed from any origin")
+	}
+
+	if cfg.MaxAge != nil {
+		maxAge, err := ptypes.Duration(cfg.MaxAge)
+		if err != nil {
+			return nil, err
+		}
+		p.maxAge = strconv.Itoa(int(maxAge.Seconds()))
+	}
+	return p, nil
+}
+
+func (p *corsPolicy) allowed(origin string) bool {
+	if p.anyOrigin {
+		return true
+	}
+	origin = strings.ToLower(origin)
+	for _, g := range p.origins {
+		if g.Match(origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// handle adds the CORS headers to the response if the request is from an allowed origin, and returns true if it
+// responded to a preflight request.
+func (p *corsPolicy) handle(w http.ResponseWriter, r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return false
+	}
+
+	h := w.Header()
+	h.Add("Vary", "Origin")
+	preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+	if !p.allowed(origin) {
+		if preflight {
+			w.WriteHeader(http.StatusForbidden)
+		}
+		return preflight
+	}
+
+	if p.anyOrigin {
+		h.Set("Access-Control-Allow-Origin", "*")
+	} else {
+		h.Set("Access-Control-Allow-Origin", origin)
+	}
+	if p.credentials {
+		h.Set("Access-Control-Allow-Credentials", "true")
+	}
+
+	if !preflight {
+		h.Set("Access-Control-Expose-Headers", p.exposedHeaders)
+		return false
+	}
+
+	h.Add("Vary", "Access-Control-Request-Method")
+	h.Add("Vary", "Access-Control-Request-Headers")
+	h.Set("Access-Control-Allow-Methods", strings.Join(corsAllowedMethods, ", "))
+	h.Set("Access-Control-Allow-Headers", p.allowedHeaders)
+	if p.maxAge != "" {
+		h.Set("Access-Control-Max-Age", p.maxAge)
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return true
+}
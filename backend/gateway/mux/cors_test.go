@@ -0,0 +1,76 @@
+package mux
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/stretchr/testify/assert"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+)
+
+func TestNewCORSPolicy(t *testing.T) {
+	_, err := newCORSPolicy(&gatewayv1.CORS{AllowedOrigins: []string{"*"}, AllowCredentials: true})
+	assert.Error(t, err)
+
+	_, err = newCORSPolicy(&gatewayv1.CORS{AllowedOrigins: []string{"https://[.example.com"}})
+	assert.Error(t, err)
+}
+
+func TestCORS(t *testing.T) {
+	m := New(nil, nil, http.Dir("."), nil)
+	m.Handle("/metrics", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	assert.NoError(t, m.EnableCORS(&gatewayv1.CORS{
+		AllowedOrigins:   []string{"https://*.example.com"},
+		AllowedHeaders:   []string{"X-Foo"},
+		AllowCredentials: true,
+		MaxAge:           &duration.Duration{Seconds: 600},
+	}))
+
+	testCases := []struct {
+		name    string
+		method  string
+		origin  string
+		code    int
+		allowed bool
+	}{
+		{name: "same origin", method: http.MethodGet, code: http.StatusOK},
+		{name: "allowed", method: http.MethodGet, origin: "https://app.example.com", code: http.StatusOK, allowed: true},
+		{name: "allowed preflight", method: http.MethodOptions, origin: "https://App.example.com", code: http.StatusNoContent, allowed: true},
+		{name: "disallowed", method: http.MethodGet, origin: "https://app.example.org", code: http.StatusOK},
+		{name: "disallowed preflight", method: http.MethodOptions, origin: "https://a.b.example.com", code: http.StatusForbidden},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/metrics", nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			if tt.method == http.MethodOptions {
+				req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+			}
+			rec := httptest.NewRecorder()
+			m.ServeHTTP(rec, req)
+			assert.Equal(t, tt.code, rec.Code)
+
+			h := rec.Header()
+			if !tt.allowed {
+				assert.Empty(t, h.Get("Access-Control-Allow-Origin"))
+				return
+			}
+			assert.Equal(t, tt.origin, h.Get("Access-Control-Allow-Origin"))
+			assert.Equal(t, "true", h.Get("Access-Control-Allow-Credentials"))
+			if tt.method == http.MethodOptions {
+				assert.Contains(t, h.Get("Access-Control-Allow-Headers"), "X-Grpc-Web")
+				assert.Contains(t, h.Get("Access-Control-Allow-Headers"), "X-Foo")
+				assert.Equal(t, "600", h.Get("Access-Control-Max-Age"))
+			} else {
+				assert.Contains(t, h.Get("Access-Control-Expose-Headers"), "Grpc-Status")
+			}
+		})
+	}
+}
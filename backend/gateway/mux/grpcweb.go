@@ -0,0 +1,167 @@
+package mux
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/http2"
+)
+
+// See https://github.com/grpc/grpc/blob/master/doc/PROTOCOL-WEB.md.
+const (
+	grpcWebContentType     = "application/grpc-web"
+	grpcWebTextContentType = "application/grpc-web-text"
+
+	// The flag of the frame that carries the trailers at the end of the response body.
+	grpcWebTrailerFlag = 0x80
+)
+
+func isGRPCWebRequest(r *http.Request) bool {
+	return r.Method == http.MethodPost && strings.HasPrefix(r.Header.Get("Content-Type"), grpcWebContentType)
+}
+
+// serveGRPCWeb translates a gRPC-Web request into a gRPC request for the server, and the response back to gRPC-Web.
+// Browsers can't read HTTP trailers, so the status is sent at the end of the body instead.
+func serveGRPCWeb(grpcServer http.Handler, w http.ResponseWriter, r *http.Request) {
+	contentType := r.Header.Get("Content-Type")
+	text := strings.HasPrefix(contentType, grpcWebTextContentType)
+
+	req := r.Clone(r.Context())
+	req.ProtoMajor, req.ProtoMinor, req.Proto = 2, 0, "HTTP/2.0"
+	req.Header.Set("Content-Type", "application/grpc"+strings.TrimPrefix(strings.TrimPrefix(contentType, grpcWebTextContentType), grpcWebContentType))
+	req.Header.Del("Content-Length")
+	req.ContentLength = -1
+	if text {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		decoded, err := decodeBase64Chunks(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(decoded))
+	}
+
+	ww := &grpcWebResponseWriter{w: w, header: make(http.Header), contentType: contentType, text: text}
+	grpcServer.ServeHTTP(ww, req)
+	ww.writeTrailers()
+}
+
+// Messages in gRPC-Web text requests are encoded separately, so the body may contain padding between messages. Each
+// four byte quantum is decoded on its own to handle this.
+func decodeBase64Chunks(b []byte) ([]byte, error) {
+	b = bytes.TrimSpace(b)
+	if len(b)%4 != 0 {
+		return nil, fmt.Errorf("invalid base64 length %d", len(b))
+	}
+
+	out := make([]byte, 0, base64.StdEncoding.DecodedLen(len(b)))
+	buf := make([]byte, 3)
+	for i := 0; i < len(b); i += 4 {
+		n, err := base64.StdEncoding.Decode(buf, b[i:i+4])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, buf[:n]...)
+	}
+	return out, nil
+}
+
+type grpcWebResponseWriter struct {
+	w http.ResponseWriter
+	// The headers set by the gRPC server. Those set after the response headers are written are the trailers.
+	header        http.Header
+	contentType   string
+	text          bool
+	headerWritten bool
+}
+
+func (w *grpcWebResponseWriter) Header() http.Header {
+	return w.header
+}
+
+// trailerKeys returns the keys of the headers that are trailers, i.e. those declared in the Trailer header and those
+// with the prefix for undeclared trailers.
+func (w *grpcWebResponseWriter) trailerKeys() map[string]bool {
+	keys := make(map[string]bool)
+	for _, v := range w.header["Trailer"] {
+		for _, k := range strings.Split(v, ",") {
+			keys[http.CanonicalHeaderKey(strings.TrimSpace(k))] = true
+		}
+	}
+	for k := range w.header {
+		if strings.HasPrefix(k, http2.TrailerPrefix) {
+			keys[k] = true
+		}
+	}
+	return keys
+}
+
+func (w *grpcWebResponseWriter) WriteHeader(code int) {
+	if w.headerWritten {
+		return
+	}
+	w.headerWritten = true
+
+	trailers := w.trailerKeys()
+	h := w.w.Header()
+	for k, v := range w.header {
+		if k == "Trailer" || trailers[k] {
+			continue
+		}
+		h[k] = v
+	}
+	h.Set("Content-Type", w.contentType)
+	h.Del("Content-Length")
+	w.w.WriteHeader(code)
+}
+
+func (w *grpcWebResponseWriter) Write(b []byte) (int, error) {
+	if !w.headerWritten {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.text {
+		if _, err := w.w.Write([]byte(base64.StdEncoding.EncodeToString(b))); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+	return w.w.Write(b)
+}
+
+func (w *grpcWebResponseWriter) Flush() {
+	if !w.headerWritten {
+		w.WriteHeader(http.StatusOK)
+	}
+	if f, ok := w.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// writeTrailers writes the trailers as the final frame of the body, with lowercase keys as in HTTP/2.
+func (w *grpcWebResponseWriter) writeTrailers() {
+	var lines []string
+	for k := range w.trailerKeys() {
+		for _, v := range w.header[k] {
+			lines = append(lines, fmt.Sprintf("%s: %s\r\n", strings.ToLower(strings.TrimPrefix(k, http2.TrailerPrefix)), v))
+		}
+	}
+	sort.Strings(lines)
+	payload := strings.Join(lines, "")
+
+	frame := make([]byte, 5, 5+len(payload))
+	frame[0] = grpcWebTrailerFlag
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(payload)))
+	frame = append(frame, payload...)
+	_, _ = w.Write(frame)
+	w.Flush()
+}
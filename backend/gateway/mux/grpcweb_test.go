@@ -0,0 +1,121 @@
+package mux
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
+)
+
+type healthcheckServer struct {
+	healthcheckv1.UnimplementedHealthcheckAPIServer
+}
+
+func (healthcheckServer) Healthcheck(context.Context, *healthcheckv1.HealthcheckRequest) (*healthcheckv1.HealthcheckResponse, error) {
+	return &healthcheckv1.HealthcheckResponse{}, nil
+}
+
+func frame(flag byte, payload []byte) []byte {
+	b := make([]byte, 5, 5+len(payload))
+	b[0] = flag
+	binary.BigEndian.PutUint32(b[1:], uint32(len(payload)))
+	return append(b, payload...)
+}
+
+// readFrames splits the body into the payloads of the data frames and the trailer frame.
+func readFrames(t *testing.T, body []byte) ([][]byte, string) {
+	var data [][]byte
+	for len(body) >= 5 {
+		n := binary.BigEndian.Uint32(body[1:5])
+		payload := body[5 : 5+n]
+		if body[0] == grpcWebTrailerFlag {
+			return data, string(payload)
+		}
+		data = append(data, payload)
+		body = body[5+n:]
+	}
+	t.Fatal("response did not end with a trailer frame")
+	return nil, ""
+}
+
+func TestGRPCWeb(t *testing.T) {
+	m := New(nil, nil, http.Dir("."), nil)
+	healthcheckv1.RegisterHealthcheckAPIServer(m.GRPCServer, &healthcheckServer{})
+
+	msg, err := proto.Marshal(&healthcheckv1.HealthcheckRequest{})
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		path        string
+		contentType string
+		frames      int
+		trailers    string
+	}{
+		{
+			name:        "binary",
+			path:        "/clutch.healthcheck.v1.HealthcheckAPI/Healthcheck",
+			contentType: "application/grpc-web+proto",
+			frames:      1,
+			trailers:    "grpc-status: 0\r\n",
+		},
+		{
+			name:        "text",
+			path:        "/clutch.healthcheck.v1.HealthcheckAPI/Healthcheck",
+			contentType: "application/grpc-web-text",
+			frames:      1,
+			trailers:    "grpc-status: 0\r\n",
+		},
+		{
+			name:        "error",
+			path:        "/clutch.healthcheck.v1.HealthcheckAPI/Unknown",
+			contentType: "application/grpc-web+proto",
+			trailers:    "grpc-message: unknown method Unknown for service clutch.healthcheck.v1.HealthcheckAPI\r\ngrpc-status: 12\r\n",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			body := frame(0, msg)
+			if tt.name == "text" {
+				body = []byte(base64.StdEncoding.EncodeToString(body))
+			}
+			req := httptest.NewRequest(http.MethodPost, tt.path, bytes.NewReader(body))
+			req.Header.Set("Content-Type", tt.contentType)
+			rec := httptest.NewRecorder()
+			m.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tt.contentType, rec.Header().Get("Content-Type"))
+			assert.Empty(t, rec.Header().Get("Grpc-Status"))
+
+			resp := rec.Body.Bytes()
+			if tt.name == "text" {
+				resp, err = decodeBase64Chunks(resp)
+				assert.NoError(t, err)
+			}
+			data, trailers := readFrames(t, resp)
+			assert.Len(t, data, tt.frames)
+			assert.Equal(t, tt.trailers, trailers)
+		})
+	}
+}
+
+func TestDecodeBase64Chunks(t *testing.T) {
+	// Separately encoded chunks are padded.
+	b, err := decodeBase64Chunks([]byte(base64.StdEncoding.EncodeToString([]byte("a")) + base64.StdEncoding.EncodeToString([]byte("bcde"))))
+	assert.NoError(t, err)
+	assert.Equal(t, "abcde", string(b))
+
+	_, err = decodeBase64Chunks([]byte("abc"))
+	assert.Error(t, err)
+}
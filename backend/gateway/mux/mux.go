@@ -12,6 +12,8 @@ import (
 	"strings"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
@@ -19,10 +21,18 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	"github.com/lyft/clutch/backend/clientinfo"
+	"github.com/lyft/clutch/backend/requestid"
 )
 
 var apiPattern = regexp.MustCompile(`^/v\d+/`)
 
+// RetryAfterMetadataKey is the response metadata key for the number of seconds to wait before retrying a request, e.g.
+// one that was throttled. The JSON gateway returns it in the Retry-After header.
+const RetryAfterMetadataKey = "retry-after"
+
 type assetHandler struct {
 	next       http.Handler
 	fileSystem http.FileSystem
@@ -40,7 +50,7 @@ func copyHTTPResponse(resp *http.Response, w http.ResponseWriter) {
 }
 
 func (a *assetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if apiPattern.MatchString(r.URL.Path) || r.URL.Path == "/healthcheck" {
+	if apiPattern.MatchString(r.URL.Path) || r.URL.Path == "/healthcheck" || r.URL.Path == "/readiness" {
 		// Serve from the embedded API handler.
 		a.next.ServeHTTP(w, r)
 		return
@@ -83,6 +93,10 @@ func customResponseForwarder(ctx context.Context, w http.ResponseWriter, resp pr
 			Path:     "/",
 			HttpOnly: false,
 		}
+		// An empty token deletes the cookie, e.g. when logging out.
+		if cookie.Value == "" {
+			cookie.MaxAge = -1
+		}
 		http.SetCookie(w, cookie)
 	}
 
@@ -100,9 +114,18 @@ func customResponseForwarder(ctx context.Context, w http.ResponseWriter, resp pr
 	return nil
 }
 
+// Browsers navigating to a page are redirected to log in when they aren't authenticated. Other clients, i.e. requests
+// that accept JSON or authenticate with a token, receive the error like any other.
+func redirectToLogin(req *http.Request) bool {
+	if req.Header.Get("Authorization") != "" {
+		return false
+	}
+	accept := strings.ToLower(req.Header.Get("Accept"))
+	return strings.Contains(accept, "text/html") && !strings.Contains(accept, "application/json")
+}
+
 func customErrorHandler(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, req *http.Request, err error) {
-	//  TODO(maybe): once we have non-browser clients we probably want to avoid the redirect and directly return the error.
-	if s, ok := status.FromError(err); ok && s.Code() == codes.Unauthenticated {
+	if s, ok := status.FromError(err); ok && s.Code() == codes.Unauthenticated && redirectToLogin(req) {
 		referer := req.Referer()
 		redirectPath := "/v1/authn/login"
 		if len(referer) != 0 {
@@ -119,14 +142,100 @@ func customErrorHandler(ctx context.Context, mux *runtime.ServeMux, m runtime.Ma
 		http.Redirect(w, req, redirectPath, http.StatusFound)
 		return
 	}
-	runtime.DefaultHTTPProtoErrorHandler(ctx, mux, m, w, req, err)
+	// The status is returned as JSON with its code, message, and details, e.g. partial failures or field violations.
+	runtime.DefaultHTTPProtoErrorHandler(ctx, mux, m, w, req, decodableStatus(err))
+}
+
+// decodableStatus removes details of unknown types from the error's status, since the marshaler would otherwise fail
+// and return a generic error in place of the status.
+func decodableStatus(err error) error {
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	pb := s.Proto()
+	var details []*any.Any
+	for _, d := range pb.Details {
+		if _, err := ptypes.Empty(d); err == nil {
+			details = append(details, d)
+		}
+	}
+	if len(details) == len(pb.Details) {
+		return err
+	}
+	pb.Details = details
+	return status.ErrorProto(pb)
+}
+
+// Forward W3C trace context headers so that requests through the JSON gateway continue the caller's trace, and the
+// request ID so that it can be correlated with the caller's logs. The forwarded headers, e.g. those read by authn
+// providers from a proxy, are passed with a prefix so that they can't be confused with metadata set by the client or
+// the gateway. Other headers are matched as usual.
+func newHeaderMatcher(forwardedHeaders []string) runtime.HeaderMatcherFunc {
+	forwarded := make(map[string]bool, len(forwardedHeaders))
+	for _, h := range forwardedHeaders {
+		if k := strings.ToLower(h); isValidMetadataKey(k) {
+			forwarded[k] = true
+		}
+	}
+
+	return func(key string) (string, bool) {
+		k := strings.ToLower(key)
+		switch k {
+		case "traceparent", "tracestate", requestid.MetadataKey:
+			return k, true
+		}
+
+		if md := strings.TrimPrefix(k, strings.ToLower(runtime.MetadataHeaderPrefix)); md != k {
+			// Clients can't set the metadata that the gateway sets itself.
+			if md == clientinfo.CertificateMetadataKey || md == "x-forwarded-for" || strings.HasPrefix(md, runtime.MetadataPrefix) {
+				return "", false
+			}
+			return runtime.DefaultHeaderMatcher(key)
+		}
+		if forwarded[k] {
+			return runtime.MetadataPrefix + k, true
+		}
+		return runtime.DefaultHeaderMatcher(key)
+	}
+}
+
+// Header names can contain characters that aren't allowed in metadata keys.
+func isValidMetadataKey(k string) bool {
+	for _, c := range k {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '_' || c == '.') {
+			return false
+		}
+	}
+	return k != ""
 }
 
-func New(unaryInterceptors []grpc.UnaryServerInterceptor, assets http.FileSystem) *Mux {
-	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(unaryInterceptors...))
+// Return the request ID and the time to wait before retrying throttled requests in their conventional headers rather
+// than with the prefix used for other response metadata.
+func customOutgoingHeaderMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case requestid.MetadataKey:
+		return requestid.Header, true
+	case RetryAfterMetadataKey:
+		return "Retry-After", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// New creates the gRPC server and JSON gateway. The JSON gateway forwards the given request headers to the gRPC server
+// in addition to those it forwards by default.
+func New(unaryInterceptors []grpc.UnaryServerInterceptor, streamInterceptors []grpc.StreamServerInterceptor, assets http.FileSystem, forwardedHeaders []string) *Mux {
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
 	jsonGateway := runtime.NewServeMux(
 		runtime.WithForwardResponseOption(customResponseForwarder),
 		runtime.WithProtoErrorHandler(customErrorHandler),
+		runtime.WithIncomingHeaderMatcher(newHeaderMatcher(forwardedHeaders)),
+		runtime.WithOutgoingHeaderMatcher(customOutgoingHeaderMatcher),
+		runtime.WithMetadata(clientinfo.GatewayMetadata),
 		runtime.WithMarshalerOption(
 			runtime.MIMEWildcard,
 			&runtime.JSONPb{
@@ -149,6 +258,7 @@ func New(unaryInterceptors []grpc.UnaryServerInterceptor, assets http.FileSystem
 		GRPCServer:  grpcServer,
 		JSONGateway: jsonGateway,
 		HTTPMux:     httpMux,
+		httpMux:     httpMux,
 	}
 	return mux
 }
@@ -159,13 +269,39 @@ type Mux struct {
 	JSONGateway *runtime.ServeMux
 	HTTPMux     http.Handler
 	GRPCServer  *grpc.Server
+
+	httpMux *http.ServeMux
+	cors    *corsPolicy
+}
+
+// Handle registers a plain HTTP handler for the given pattern. Requests to it are not routed through the assets handler
+// or the JSON gateway, and are therefore not subject to middleware.
+func (m *Mux) Handle(pattern string, handler http.Handler) {
+	m.httpMux.Handle(pattern, handler)
+}
+
+// EnableCORS allows browser apps on other origins to make requests according to the policy.
+func (m *Mux) EnableCORS(cfg *gatewayv1.CORS) error {
+	policy, err := newCORSPolicy(cfg)
+	if err != nil {
+		return err
+	}
+	m.cors = policy
+	return nil
 }
 
 // Adapted from https://github.com/grpc/grpc-go/blob/197c621/server.go#L760-L778.
 func (m *Mux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+	if m.cors != nil && m.cors.handle(w, r) {
+		return
+	}
+
+	switch {
+	case isGRPCWebRequest(r):
+		serveGRPCWeb(m.GRPCServer, w, r)
+	case r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc"):
 		m.GRPCServer.ServeHTTP(w, r)
-	} else {
+	default:
 		m.HTTPMux.ServeHTTP(w, r)
 	}
 }
@@ -178,3 +314,12 @@ func (m *Mux) EnableGRPCReflection() {
 func InsecureHandler(handler http.Handler) http.Handler {
 	return h2c.NewHandler(handler, &http2.Server{})
 }
+
+// InsecureServerHandler is an InsecureHandler for the given server that also shuts down the HTTP/2 connections it
+// serves gracefully, i.e. sends clients GOAWAY, when the server is shut down.
+func InsecureServerHandler(srv *http.Server, handler http.Handler) http.Handler {
+	h2s := &http2.Server{}
+	// Configuring the server only fails for TLS settings that aren't allowed with HTTP/2, which don't apply to h2c.
+	_ = http2.ConfigureServer(srv, h2s)
+	return h2c.NewHandler(handler, h2s)
+}
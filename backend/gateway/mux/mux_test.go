@@ -1,13 +1,21 @@
 package mux
 
 import (
+	"context"
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func TestCopyHTTPResponse(t *testing.T) {
@@ -29,3 +37,162 @@ func TestCopyHTTPResponse(t *testing.T) {
 	assert.Equal(t, headers, rec.Header())
 	assert.Equal(t, body, rec.Body.String())
 }
+
+func TestHandle(t *testing.T) {
+	m := New(nil, nil, http.Dir("."), nil)
+	m.Handle("/metrics", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("metrics"))
+	}))
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "metrics", rec.Body.String())
+}
+
+func TestHeaderMatcher(t *testing.T) {
+	customHeaderMatcher := newHeaderMatcher([]string{"X-Forwarded-Email", "X-Foo{}"})
+
+	key, ok := customHeaderMatcher("Traceparent")
+	assert.True(t, ok)
+	assert.Equal(t, "traceparent", key)
+
+	key, ok = customHeaderMatcher("X-Request-Id")
+	assert.True(t, ok)
+	assert.Equal(t, "x-request-id", key)
+
+	// Headers asked for by services are forwarded with a prefix.
+	key, ok = customHeaderMatcher("X-Forwarded-Email")
+	assert.True(t, ok)
+	assert.Equal(t, "grpcgateway-x-forwarded-email", key)
+
+	_, ok = customHeaderMatcher("X-Foo{}")
+	assert.False(t, ok)
+
+	// Other headers aren't forwarded.
+	_, ok = customHeaderMatcher("X-Forwarded-Groups")
+	assert.False(t, ok)
+	_, ok = newHeaderMatcher(nil)("X-Forwarded-Email")
+	assert.False(t, ok)
+
+	// Default behavior is preserved.
+	key, ok = customHeaderMatcher("Grpc-Metadata-Foo")
+	assert.True(t, ok)
+	assert.Equal(t, "Foo", key)
+
+	key, ok = customHeaderMatcher("Cookie")
+	assert.True(t, ok)
+	assert.Equal(t, "grpcgateway-Cookie", key)
+
+	// Metadata set by the gateway can't be set by clients.
+	for _, h := range []string{"Grpc-Metadata-X-Clutch-Client-Certificate-Bin", "Grpc-Metadata-X-Forwarded-For", "Grpc-Metadata-Grpcgateway-X-Forwarded-Email"} {
+		_, ok = customHeaderMatcher(h)
+		assert.False(t, ok, h)
+	}
+}
+
+func TestCustomOutgoingHeaderMatcher(t *testing.T) {
+	key, ok := customOutgoingHeaderMatcher("x-request-id")
+	assert.True(t, ok)
+	assert.Equal(t, "X-Request-Id", key)
+
+	key, ok = customOutgoingHeaderMatcher("retry-after")
+	assert.True(t, ok)
+	assert.Equal(t, "Retry-After", key)
+
+	key, ok = customOutgoingHeaderMatcher("foo")
+	assert.True(t, ok)
+	assert.Equal(t, "Grpc-Metadata-foo", key)
+}
+
+func TestCustomErrorHandlerRedirect(t *testing.T) {
+	testCases := []struct {
+		name     string
+		header   http.Header
+		redirect bool
+	}{
+		{
+			name:     "browser navigation",
+			header:   http.Header{"Accept": []string{"text/html,application/xhtml+xml,*/*;q=0.8"}},
+			redirect: true,
+		},
+		{name: "no accept header"},
+		{name: "json", header: http.Header{"Accept": []string{"application/json, text/plain, */*"}}},
+		{
+			name: "token",
+			header: http.Header{
+				"Accept":        []string{"text/html"},
+				"Authorization": []string{"Bearer foo"},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/v1/k8s/describePod", nil)
+			req.Header = tt.header
+			rec := httptest.NewRecorder()
+			customErrorHandler(context.Background(), runtime.NewServeMux(), &runtime.JSONPb{}, rec, req, status.Error(codes.Unauthenticated, "token expired"))
+
+			if tt.redirect {
+				assert.Equal(t, http.StatusFound, rec.Code)
+				assert.Equal(t, "/v1/authn/login", rec.Header().Get("Location"))
+			} else {
+				assert.Equal(t, http.StatusUnauthorized, rec.Code)
+				assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+				assert.JSONEq(t, `{"code":16,"message":"token expired"}`, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestCustomErrorHandlerDetails(t *testing.T) {
+	s, err := status.New(codes.InvalidArgument, "invalid request").WithDetails(
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: "name", Description: "must not be empty"}}},
+		status.New(codes.NotFound, "cluster 'foo' not found").Proto(),
+	)
+	assert.NoError(t, err)
+	pb := s.Proto()
+	// Details of unknown types are dropped rather than failing the response.
+	pb.Details = append(pb.Details, &any.Any{TypeUrl: "type.googleapis.com/foo.v1.Unknown"})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/resolver/search", nil)
+	req.Header.Set("Accept", "application/json")
+	rec := httptest.NewRecorder()
+	customErrorHandler(context.Background(), runtime.NewServeMux(), &runtime.JSONPb{}, rec, req, status.ErrorProto(pb))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var body struct {
+		Code    int
+		Message string
+		Details []map[string]interface{}
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, int(codes.InvalidArgument), body.Code)
+	assert.Equal(t, "invalid request", body.Message)
+	if assert.Len(t, body.Details, 2) {
+		assert.Equal(t, "type.googleapis.com/google.rpc.BadRequest", body.Details[0]["@type"])
+		assert.Equal(t, "type.googleapis.com/google.rpc.Status", body.Details[1]["@type"])
+		assert.Equal(t, "cluster 'foo' not found", body.Details[1]["message"])
+	}
+}
+
+func TestCustomResponseForwarderCookie(t *testing.T) {
+	tests := []struct {
+		token  string
+		cookie string
+	}{
+		{token: "abc", cookie: "token=abc; Path=/"},
+		{token: "", cookie: "token=; Path=/; Max-Age=0"},
+	}
+
+	for _, tt := range tests {
+		md := runtime.ServerMetadata{HeaderMD: metadata.Pairs("Set-Cookie-Token", tt.token)}
+		ctx := runtime.NewServerMetadataContext(context.Background(), md)
+
+		rec := httptest.NewRecorder()
+		assert.NoError(t, customResponseForwarder(ctx, rec, nil))
+		assert.Equal(t, tt.cookie, rec.Header().Get("Set-Cookie"))
+	}
+}
@@ -0,0 +1,53 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/grpcreflect"
+	"google.golang.org/grpc"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	"github.com/lyft/clutch/backend/gateway/openapi"
+	"github.com/lyft/clutch/backend/version"
+)
+
+const defaultOpenAPIPath = "/openapi.json"
+
+func openAPIPath(cfg *gatewayv1.OpenAPI) string {
+	if cfg.Path == "" {
+		return defaultOpenAPIPath
+	}
+	return cfg.Path
+}
+
+// newOpenAPIHandler generates the document for the services registered on the server, merged with the additions from
+// the listener's modules, and returns a handler that serves it.
+func newOpenAPIHandler(server *grpc.Server, extenders []openapi.Extender) (http.Handler, error) {
+	sds, err := grpcreflect.LoadServiceDescriptors(server)
+	if err != nil {
+		return nil, err
+	}
+	services := make([]*desc.ServiceDescriptor, 0, len(sds))
+	for _, sd := range sds {
+		services = append(services, sd)
+	}
+
+	doc, err := openapi.Generate(&openapi.Info{Title: "Clutch", Version: version.Version}, services)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range extenders {
+		doc.Merge(e.OpenAPI())
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(b)
+	}), nil
+}
@@ -0,0 +1,320 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/envoyproxy/protoc-gen-validate/validate"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	dpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	statuspb "google.golang.org/genproto/googleapis/rpc/status"
+
+	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
+)
+
+const (
+	jsonContentType = "application/json"
+	statusSchema    = "google.rpc.Status"
+)
+
+// Path templates such as `/v1/{name=projects/*}` are written as `/v1/{name}`.
+var pathParamPattern = regexp.MustCompile(`{([^}=]+)(=[^}]*)?}`)
+
+// Well-known types have a special JSON mapping rather than being objects with their fields.
+var wellKnownSchemas = map[string]func() *Schema{
+	"google.protobuf.Any": func() *Schema {
+		return &Schema{
+			Type:                 "object",
+			Properties:           map[string]*Schema{"@type": {Type: "string"}},
+			AdditionalProperties: true,
+		}
+	},
+	"google.protobuf.Duration":    func() *Schema { return &Schema{Type: "string"} },
+	"google.protobuf.Empty":       func() *Schema { return &Schema{Type: "object"} },
+	"google.protobuf.FieldMask":   func() *Schema { return &Schema{Type: "string"} },
+	"google.protobuf.ListValue":   func() *Schema { return &Schema{Type: "array", Items: &Schema{}} },
+	"google.protobuf.Struct":      func() *Schema { return &Schema{Type: "object", AdditionalProperties: true} },
+	"google.protobuf.Timestamp":   func() *Schema { return &Schema{Type: "string", Format: "date-time"} },
+	"google.protobuf.Value":       func() *Schema { return &Schema{} },
+	"google.protobuf.BoolValue":   func() *Schema { return &Schema{Type: "boolean"} },
+	"google.protobuf.BytesValue":  func() *Schema { return &Schema{Type: "string", Format: "byte"} },
+	"google.protobuf.DoubleValue": func() *Schema { return &Schema{Type: "number", Format: "double"} },
+	"google.protobuf.FloatValue":  func() *Schema { return &Schema{Type: "number", Format: "float"} },
+	"google.protobuf.Int32Value":  func() *Schema { return &Schema{Type: "integer", Format: "int32"} },
+	"google.protobuf.Int64Value":  func() *Schema { return &Schema{Type: "string", Format: "int64"} },
+	"google.protobuf.StringValue": func() *Schema { return &Schema{Type: "string"} },
+	"google.protobuf.UInt32Value": func() *Schema { return &Schema{Type: "integer", Format: "int64"} },
+	"google.protobuf.UInt64Value": func() *Schema { return &Schema{Type: "string", Format: "uint64"} },
+}
+
+// Generate creates a document describing the methods of the services that have `google.api.http` annotations. Only
+// unary methods are included.
+func Generate(info *Info, services []*desc.ServiceDescriptor) (*Document, error) {
+	g := &generator{schemas: make(map[string]*Schema)}
+	d := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   make(map[string]*PathItem),
+	}
+
+	statusDesc, err := desc.LoadMessageDescriptorForMessage(&statuspb.Status{})
+	if err != nil {
+		return nil, err
+	}
+	g.addMessage(statusDesc)
+
+	sorted := append([]*desc.ServiceDescriptor{}, services...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].GetFullyQualifiedName() < sorted[j].GetFullyQualifiedName()
+	})
+
+	for _, sd := range sorted {
+		tagged := false
+		for _, md := range sd.GetMethods() {
+			if md.IsClientStreaming() || md.IsServerStreaming() {
+				continue
+			}
+			ext, err := proto.GetExtension(md.GetMethodOptions(), annotations.E_Http)
+			if err != nil {
+				continue
+			}
+			rule := ext.(*annotations.HttpRule)
+
+			for _, r := range append([]*annotations.HttpRule{rule}, rule.AdditionalBindings...) {
+				if err := g.addOperation(d, md, r); err != nil {
+					return nil, err
+				}
+			}
+			tagged = true
+		}
+		if tagged {
+			d.Tags = append(d.Tags, &Tag{Name: sd.GetFullyQualifiedName()})
+		}
+	}
+
+	d.Components = &Components{Schemas: g.schemas}
+	return d, nil
+}
+
+type generator struct {
+	schemas map[string]*Schema
+}
+
+func (g *generator) addOperation(d *Document, md *desc.MethodDescriptor, rule *annotations.HttpRule) error {
+	var method, path string
+	switch p := rule.Pattern.(type) {
+	case *annotations.HttpRule_Get:
+		method, path = "get", p.Get
+	case *annotations.HttpRule_Put:
+		method, path = "put", p.Put
+	case *annotations.HttpRule_Post:
+		method, path = "post", p.Post
+	case *annotations.HttpRule_Delete:
+		method, path = "delete", p.Delete
+	case *annotations.HttpRule_Patch:
+		method, path = "patch", p.Patch
+	default:
+		// Custom methods can't be described.
+		return nil
+	}
+
+	input, output := md.GetInputType(), md.GetOutputType()
+	op := &Operation{
+		OperationID: md.GetFullyQualifiedName(),
+		Summary:     md.GetName(),
+		Tags:        []string{md.GetService().GetFullyQualifiedName()},
+		Responses: map[string]*Response{
+			"200": {
+				Description: "A successful response.",
+				Content:     map[string]*MediaType{jsonContentType: {Schema: g.messageRef(output)}},
+			},
+			"default": {
+				Description: "An error response.",
+				Content:     map[string]*MediaType{jsonContentType: {Schema: &Schema{Ref: schemaRef(statusSchema)}}},
+			},
+		},
+	}
+	if action := actionType(md); action != apiv1.ActionType_UNSPECIFIED {
+		op.Extensions = map[string]interface{}{ActionTypeExtension: action.String()}
+	}
+
+	pathFields := make(map[string]bool)
+	for _, m := range pathParamPattern.FindAllStringSubmatch(path, -1) {
+		pathFields[m[1]] = true
+		schema := &Schema{Type: "string"}
+		if fd := fieldByPath(input, m[1]); fd != nil {
+			schema = g.fieldSchema(fd)
+		}
+		op.Parameters = append(op.Parameters, &Parameter{Name: m[1], In: "path", Required: true, Schema: schema})
+	}
+	path = pathParamPattern.ReplaceAllString(path, "{$1}")
+
+	switch rule.Body {
+	case "":
+		// Other scalar fields of the request can be set with query parameters.
+		for _, fd := range input.GetFields() {
+			if pathFields[fd.GetName()] || fd.GetMessageType() != nil {
+				continue
+			}
+			op.Parameters = append(op.Parameters, &Parameter{Name: fd.GetJSONName(), In: "query", Schema: g.fieldSchema(fd)})
+		}
+	case "*":
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]*MediaType{jsonContentType: {Schema: g.messageRef(input)}},
+		}
+	default:
+		fd := input.FindFieldByName(rule.Body)
+		if fd == nil {
+			return fmt.Errorf("body field '%s' of method '%s' does not exist", rule.Body, md.GetFullyQualifiedName())
+		}
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]*MediaType{jsonContentType: {Schema: g.fieldSchema(fd)}},
+		}
+	}
+
+	if rule.ResponseBody != "" {
+		if fd := output.FindFieldByName(rule.ResponseBody); fd != nil {
+			op.Responses["200"].Content[jsonContentType].Schema = g.fieldSchema(fd)
+		}
+	}
+
+	item, ok := d.Paths[path]
+	if !ok {
+		item = &PathItem{}
+		d.Paths[path] = item
+	}
+	switch method {
+	case "get":
+		item.Get = op
+	case "put":
+		item.Put = op
+	case "post":
+		item.Post = op
+	case "delete":
+		item.Delete = op
+	case "patch":
+		item.Patch = op
+	}
+	return nil
+}
+
+func actionType(md *desc.MethodDescriptor) apiv1.ActionType {
+	ext, err := proto.GetExtension(md.GetMethodOptions(), apiv1.E_Action)
+	if err != nil {
+		return apiv1.ActionType_UNSPECIFIED
+	}
+	return ext.(*apiv1.Action).Type
+}
+
+// fieldByPath returns the field for a path parameter, which can refer to a nested field, e.g. `pod.name`.
+func fieldByPath(md *desc.MessageDescriptor, path string) *desc.FieldDescriptor {
+	var fd *desc.FieldDescriptor
+	for _, name := range strings.Split(path, ".") {
+		if md == nil {
+			return nil
+		}
+		fd = md.FindFieldByName(name)
+		if fd == nil {
+			return nil
+		}
+		md = fd.GetMessageType()
+	}
+	return fd
+}
+
+func schemaRef(name string) string {
+	return "#/components/schemas/" + name
+}
+
+// messageRef returns the schema for the message, adding it to the components if it's not a well-known type.
+func (g *generator) messageRef(md *desc.MessageDescriptor) *Schema {
+	name := md.GetFullyQualifiedName()
+	if wk, ok := wellKnownSchemas[name]; ok {
+		return wk()
+	}
+	g.addMessage(md)
+	return &Schema{Ref: schemaRef(name)}
+}
+
+func (g *generator) addMessage(md *desc.MessageDescriptor) {
+	name := md.GetFullyQualifiedName()
+	if _, ok := g.schemas[name]; ok {
+		return
+	}
+
+	// The schema is added before its fields so that recursive messages refer to it rather than being added again.
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	g.schemas[name] = s
+	for _, fd := range md.GetFields() {
+		s.Properties[fd.GetJSONName()] = g.fieldSchema(fd)
+	}
+}
+
+func (g *generator) fieldSchema(fd *desc.FieldDescriptor) *Schema {
+	var s *Schema
+	switch {
+	case fd.IsMap():
+		s = &Schema{Type: "object", AdditionalProperties: g.valueSchema(fd.GetMapValueType())}
+	case fd.IsRepeated():
+		s = &Schema{Type: "array", Items: g.valueSchema(fd)}
+	default:
+		s = g.valueSchema(fd)
+	}
+
+	rules, err := proto.GetExtension(fd.GetFieldOptions(), validate.E_Rules)
+	if err != nil {
+		return s
+	}
+	b, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(rules.(*validate.FieldRules))
+	if err != nil {
+		return s
+	}
+	// Keywords next to a reference are ignored, so the reference is wrapped.
+	if s.Ref != "" {
+		s = &Schema{AllOf: []*Schema{s}}
+	}
+	s.Extensions = map[string]interface{}{ValidateExtension: json.RawMessage(b)}
+	return s
+}
+
+// valueSchema returns the schema of a single value of the field.
+func (g *generator) valueSchema(fd *desc.FieldDescriptor) *Schema {
+	switch fd.GetType() {
+	case dpb.FieldDescriptorProto_TYPE_MESSAGE, dpb.FieldDescriptorProto_TYPE_GROUP:
+		return g.messageRef(fd.GetMessageType())
+	case dpb.FieldDescriptorProto_TYPE_ENUM:
+		values := fd.GetEnumType().GetValues()
+		s := &Schema{Type: "string", Enum: make([]string, len(values))}
+		for i, v := range values {
+			s.Enum[i] = v.GetName()
+		}
+		return s
+	case dpb.FieldDescriptorProto_TYPE_BOOL:
+		return &Schema{Type: "boolean"}
+	case dpb.FieldDescriptorProto_TYPE_STRING:
+		return &Schema{Type: "string"}
+	case dpb.FieldDescriptorProto_TYPE_BYTES:
+		return &Schema{Type: "string", Format: "byte"}
+	case dpb.FieldDescriptorProto_TYPE_DOUBLE:
+		return &Schema{Type: "number", Format: "double"}
+	case dpb.FieldDescriptorProto_TYPE_FLOAT:
+		return &Schema{Type: "number", Format: "float"}
+	case dpb.FieldDescriptorProto_TYPE_INT32, dpb.FieldDescriptorProto_TYPE_SINT32, dpb.FieldDescriptorProto_TYPE_SFIXED32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case dpb.FieldDescriptorProto_TYPE_UINT32, dpb.FieldDescriptorProto_TYPE_FIXED32:
+		return &Schema{Type: "integer", Format: "int64"}
+	case dpb.FieldDescriptorProto_TYPE_UINT64, dpb.FieldDescriptorProto_TYPE_FIXED64:
+		// 64-bit integers are strings in JSON.
+		return &Schema{Type: "string", Format: "uint64"}
+	default:
+		return &Schema{Type: "string", Format: "int64"}
+	}
+}
@@ -0,0 +1,182 @@
+// Package openapi describes the JSON endpoints of the gateway with an OpenAPI v3 document.
+package openapi
+
+import (
+	"encoding/json"
+)
+
+const (
+	Version = "3.0.3"
+
+	// Extension on operations with the action type of the method, e.g. "READ".
+	ActionTypeExtension = "x-clutch-action-type"
+	// Extension on schemas with the protoc-gen-validate rules of the field in their JSON form.
+	ValidateExtension = "x-clutch-validate"
+)
+
+// Extender is implemented by modules to add to the document, e.g. to describe plain HTTP handlers that don't have proto
+// definitions. The returned document is merged into the document for each listener the module is served on.
+type Extender interface {
+	OpenAPI() *Document
+}
+
+// Only the parts of the specification used by the gateway are modeled.
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       *Info                `json:"info"`
+	Tags       []*Tag               `json:"tags,omitempty"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components *Components          `json:"components,omitempty"`
+}
+
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type Tag struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type PathItem struct {
+	Get    *Operation `json:"get,omitempty"`
+	Put    *Operation `json:"put,omitempty"`
+	Post   *Operation `json:"post,omitempty"`
+	Delete *Operation `json:"delete,omitempty"`
+	Patch  *Operation `json:"patch,omitempty"`
+}
+
+type Operation struct {
+	OperationID string               `json:"operationId,omitempty"`
+	Summary     string               `json:"summary,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	Parameters  []*Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+
+	Extensions map[string]interface{} `json:"-"`
+}
+
+func (o *Operation) MarshalJSON() ([]byte, error) {
+	type operation Operation
+	return marshalWithExtensions((*operation)(o), o.Extensions)
+}
+
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required,omitempty"`
+	Schema   *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required,omitempty"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas,omitempty"`
+}
+
+type Schema struct {
+	Ref        string             `json:"$ref,omitempty"`
+	AllOf      []*Schema          `json:"allOf,omitempty"`
+	Type       string             `json:"type,omitempty"`
+	Format     string             `json:"format,omitempty"`
+	Enum       []string           `json:"enum,omitempty"`
+	Items      *Schema            `json:"items,omitempty"`
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	// Either a schema for the values of a map, or true if any properties are allowed.
+	AdditionalProperties interface{} `json:"additionalProperties,omitempty"`
+
+	Extensions map[string]interface{} `json:"-"`
+}
+
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	type schema Schema
+	return marshalWithExtensions((*schema)(s), s.Extensions)
+}
+
+func marshalWithExtensions(v interface{}, extensions map[string]interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil || len(extensions) == 0 {
+		return b, err
+	}
+
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	for k, ext := range extensions {
+		fields[k] = ext
+	}
+	return json.Marshal(fields)
+}
+
+// Merge adds the tags, operations, and schemas of the other document. Operations and schemas in the other document
+// replace those with the same path and method or name.
+func (d *Document) Merge(other *Document) {
+	if other == nil {
+		return
+	}
+
+	for _, t := range other.Tags {
+		if !d.hasTag(t.Name) {
+			d.Tags = append(d.Tags, t)
+		}
+	}
+
+	if d.Paths == nil {
+		d.Paths = make(map[string]*PathItem)
+	}
+	for path, item := range other.Paths {
+		existing, ok := d.Paths[path]
+		if !ok {
+			d.Paths[path] = item
+			continue
+		}
+		mergeOperation(&existing.Get, item.Get)
+		mergeOperation(&existing.Put, item.Put)
+		mergeOperation(&existing.Post, item.Post)
+		mergeOperation(&existing.Delete, item.Delete)
+		mergeOperation(&existing.Patch, item.Patch)
+	}
+
+	if other.Components == nil {
+		return
+	}
+	if d.Components == nil {
+		d.Components = &Components{}
+	}
+	if d.Components.Schemas == nil {
+		d.Components.Schemas = make(map[string]*Schema)
+	}
+	for name, s := range other.Components.Schemas {
+		d.Components.Schemas[name] = s
+	}
+}
+
+func (d *Document) hasTag(name string) bool {
+	for _, t := range d.Tags {
+		if t.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func mergeOperation(dst **Operation, src *Operation) {
+	if src != nil {
+		*dst = src
+	}
+}
@@ -0,0 +1,98 @@
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/lyft/clutch/backend/api/authn/v1"
+	_ "github.com/lyft/clutch/backend/api/healthcheck/v1"
+	_ "github.com/lyft/clutch/backend/api/k8s/v1"
+)
+
+func loadServices(t *testing.T, files map[string]string) []*desc.ServiceDescriptor {
+	var ret []*desc.ServiceDescriptor
+	for file, name := range files {
+		fd, err := desc.LoadFileDescriptor(file)
+		if assert.NoError(t, err) {
+			ret = append(ret, fd.FindService(name))
+		}
+	}
+	return ret
+}
+
+func TestGenerate(t *testing.T) {
+	services := loadServices(t, map[string]string{
+		"authn/v1/authn.proto":             "clutch.authn.v1.AuthnAPI",
+		"healthcheck/v1/healthcheck.proto": "clutch.healthcheck.v1.HealthcheckAPI",
+		"k8s/v1/k8s.proto":                 "clutch.k8s.v1.K8sAPI",
+	})
+
+	d, err := Generate(&Info{Title: "Clutch", Version: "dev"}, services)
+	assert.NoError(t, err)
+	assert.Equal(t, Version, d.OpenAPI)
+	assert.Len(t, d.Tags, 3)
+
+	// Additional bindings are included.
+	assert.NotNil(t, d.Paths["/v1/healthcheck"].Get)
+	assert.NotNil(t, d.Paths["/healthcheck"].Get)
+
+	login := d.Paths["/v1/authn/login"].Get
+	if assert.NotNil(t, login) && assert.Len(t, login.Parameters, 1) {
+		assert.Equal(t, "redirectUrl", login.Parameters[0].Name)
+		assert.Equal(t, "query", login.Parameters[0].In)
+	}
+
+	describe := d.Paths["/v1/k8s/describePod"].Post
+	if assert.NotNil(t, describe) {
+		assert.Equal(t, "clutch.k8s.v1.K8sAPI.DescribePod", describe.OperationID)
+		assert.Equal(t, "READ", describe.Extensions[ActionTypeExtension])
+		assert.Equal(t, "#/components/schemas/clutch.k8s.v1.DescribePodRequest", describe.RequestBody.Content[jsonContentType].Schema.Ref)
+		assert.Equal(t, "#/components/schemas/clutch.k8s.v1.DescribePodResponse", describe.Responses["200"].Content[jsonContentType].Schema.Ref)
+		assert.Equal(t, "#/components/schemas/google.rpc.Status", describe.Responses["default"].Content[jsonContentType].Schema.Ref)
+	}
+
+	req := d.Components.Schemas["clutch.k8s.v1.DescribePodRequest"]
+	if assert.NotNil(t, req) {
+		b, err := json.Marshal(req.Properties["clientset"])
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"type":"string","x-clutch-validate":{"string":{"min_bytes":"1"}}}`, string(b))
+	}
+
+	pod := d.Components.Schemas["clutch.k8s.v1.Pod"]
+	if assert.NotNil(t, pod) {
+		assert.Equal(t, "object", pod.Properties["labels"].Type)
+		assert.Equal(t, "array", pod.Properties["containers"].Type)
+		assert.Equal(t, "#/components/schemas/clutch.k8s.v1.Container", pod.Properties["containers"].Items.Ref)
+		assert.Equal(t, "date-time", pod.Properties["startTime"].Format)
+		assert.NotEmpty(t, pod.Properties["state"].Enum)
+	}
+
+	_, err = json.Marshal(d)
+	assert.NoError(t, err)
+}
+
+func TestMerge(t *testing.T) {
+	d := &Document{
+		Tags: []*Tag{{Name: "foo"}},
+		Paths: map[string]*PathItem{
+			"/v1/foo": {Get: &Operation{OperationID: "get"}, Post: &Operation{OperationID: "post"}},
+		},
+	}
+	d.Merge(&Document{
+		Tags: []*Tag{{Name: "foo"}, {Name: "bar"}},
+		Paths: map[string]*PathItem{
+			"/v1/foo": {Post: &Operation{OperationID: "replaced"}},
+			"/v1/bar": {Get: &Operation{OperationID: "bar"}},
+		},
+		Components: &Components{Schemas: map[string]*Schema{"bar": {Type: "object"}}},
+	})
+
+	assert.Len(t, d.Tags, 2)
+	assert.Equal(t, "get", d.Paths["/v1/foo"].Get.OperationID)
+	assert.Equal(t, "replaced", d.Paths["/v1/foo"].Post.OperationID)
+	assert.Equal(t, "bar", d.Paths["/v1/bar"].Get.OperationID)
+	assert.Equal(t, "object", d.Components.Schemas["bar"].Type)
+}
@@ -0,0 +1,47 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
+	"github.com/lyft/clutch/backend/gateway/openapi"
+)
+
+type extender struct{}
+
+func (extender) OpenAPI() *openapi.Document {
+	return &openapi.Document{Paths: map[string]*openapi.PathItem{"/v1/extra": {Get: &openapi.Operation{OperationID: "extra"}}}}
+}
+
+func TestOpenAPIPath(t *testing.T) {
+	assert.Equal(t, defaultOpenAPIPath, openAPIPath(&gatewayv1.OpenAPI{}))
+	assert.Equal(t, "/api.json", openAPIPath(&gatewayv1.OpenAPI{Path: "/api.json"}))
+}
+
+func TestOpenAPIHandler(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	healthcheckv1.RegisterHealthcheckAPIServer(grpcServer, &healthcheckServer{})
+
+	handler, err := newOpenAPIHandler(grpcServer, []openapi.Extender{extender{}})
+	assert.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, defaultOpenAPIPath, nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var doc struct {
+		Paths map[string]interface{}
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+	assert.Contains(t, doc.Paths, "/v1/healthcheck")
+	assert.Contains(t, doc.Paths, "/v1/extra")
+	assert.NotContains(t, doc.Paths, "/v1/k8s/describePod")
+}
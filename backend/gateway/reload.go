@@ -0,0 +1,247 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	"github.com/lyft/clutch/backend/gateway/meta"
+)
+
+// Reconfigurer is an optional interface for components that can apply a new configuration without restarting the
+// gateway. Reconfigure is called with the component's new typed config when it changes on reload. Implementations
+// should validate the configuration fully and return a function that swaps it in, which can't fail. The function is
+// only called once every changed component has accepted its configuration, so nothing may change before then.
+type Reconfigurer interface {
+	Reconfigure(cfg *any.Any) (apply func(), err error)
+}
+
+// reloader re-reads the configuration and applies changes to components that support reconfiguration.
+type reloader struct {
+	flags  *Flags
+	logger *zap.Logger
+	scope  tally.Scope
+
+	mu sync.Mutex
+	// The configuration the gateway was started with, and the configuration of the gateway options and of each
+	// component that is currently applied.
+	config     *gatewayv1.Config
+	gateway    *gatewayv1.GatewayOptions
+	applied    map[string]*any.Any
+	components map[string]interface{}
+	// The files the configuration was read from, for detecting changes when watching.
+	files []string
+}
+
+// newReloader creates a reloader for the configuration that was read from the given files. The files are watched as they
+// were read at startup rather than reading the configuration again, which would run exec secret providers twice.
+func newReloader(f *Flags, cfg *gatewayv1.Config, files []string, lc *lifecycle, logger *zap.Logger, scope tally.Scope) *reloader {
+	r := &reloader{
+		flags:      f,
+		logger:     logger,
+		scope:      scope,
+		config:     cfg,
+		gateway:    cfg.Gateway,
+		applied:    componentConfigs(cfg),
+		components: make(map[string]interface{}, len(lc.components)),
+		files:      files,
+	}
+	for _, c := range lc.components {
+		r.components[c.name] = c.component
+	}
+	return r
+}
+
+// componentConfigs returns the typed config of every component keyed by component name.
+func componentConfigs(cfg *gatewayv1.Config) map[string]*any.Any {
+	ret := make(map[string]*any.Any)
+	for _, c := range cfg.Services {
+		ret[c.Name] = c.TypedConfig
+	}
+	for _, c := range cfg.Resolvers {
+		ret[c.Name] = c.TypedConfig
+	}
+	for _, c := range cfg.Gateway.Middleware {
+		ret[c.Name] = c.TypedConfig
+	}
+	for _, c := range cfg.Modules {
+		ret[c.Name] = c.TypedConfig
+	}
+	return ret
+}
+
+// reload reads and validates the configuration and reconfigures the components whose configuration changed. If the
+// configuration is invalid or any component rejects its configuration, nothing is applied and an error is returned.
+func (r *reloader) reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cfg, files, err := loadConfig(r.flags)
+	if err != nil {
+		r.scope.Counter("config_reload_failure").Inc(1)
+		return err
+	}
+
+	configs := componentConfigs(cfg)
+	for name, typedConfig := range configs {
+		if err := validateAny(typedConfig); err != nil {
+			r.scope.Counter("config_reload_failure").Inc(1)
+			return fmt.Errorf("config validation for component '%s' failed: %w", name, err)
+		}
+	}
+
+	if !proto.Equal(r.gateway, cfg.Gateway) {
+		r.logger.Warn("changes to gateway options require a restart to take effect")
+	}
+	for name := range configs {
+		if _, ok := r.applied[name]; !ok {
+			r.logger.Warn("adding a component requires a restart to take effect", zap.String("componentName", name))
+		}
+	}
+	for name := range r.applied {
+		if _, ok := configs[name]; !ok {
+			r.logger.Warn("removing a component requires a restart to take effect", zap.String("componentName", name))
+		}
+	}
+
+	// Every changed component validates its configuration before any is applied, so that the gateway doesn't run with a
+	// mix of the previous and the new configuration.
+	type change struct {
+		name        string
+		typedConfig *any.Any
+		apply       func()
+	}
+	var changes []change
+	for name, typedConfig := range configs {
+		current, ok := r.applied[name]
+		if !ok || proto.Equal(current, typedConfig) {
+			continue
+		}
+
+		c, ok := r.components[name].(Reconfigurer)
+		if !ok {
+			r.logger.Warn("component does not support reconfiguration, changes require a restart to take effect",
+				zap.String("componentName", name))
+			continue
+		}
+
+		apply, err := c.Reconfigure(typedConfig)
+		if err != nil {
+			r.scope.Counter("config_reload_failure").Inc(1)
+			return fmt.Errorf("component '%s' rejected its configuration: %w", name, err)
+		}
+		changes = append(changes, change{name: name, typedConfig: typedConfig, apply: apply})
+	}
+
+	for _, c := range changes {
+		c.apply()
+		r.applied[c.name] = c.typedConfig
+		r.logger.Info("reconfigured component", zap.String("componentName", c.name))
+	}
+
+	r.files = files
+	r.publish()
+	r.scope.Counter("config_reload_success").Inc(1)
+	return nil
+}
+
+// effectiveConfig returns the startup configuration with the component configuration that is currently applied.
+func (r *reloader) effectiveConfig() *gatewayv1.Config {
+	cfg := proto.Clone(r.config).(*gatewayv1.Config)
+	for _, c := range cfg.Services {
+		c.TypedConfig = r.applied[c.Name]
+	}
+	for _, c := range cfg.Resolvers {
+		c.TypedConfig = r.applied[c.Name]
+	}
+	for _, c := range cfg.Gateway.Middleware {
+		c.TypedConfig = r.applied[c.Name]
+	}
+	for _, c := range cfg.Modules {
+		c.TypedConfig = r.applied[c.Name]
+	}
+	return cfg
+}
+
+// publish updates the configuration returned by the gateway API after a reload.
+func (r *reloader) publish() {
+	info := meta.GetGatewayInfo()
+	if info == nil {
+		return
+	}
+
+	updated := *info
+	config, err := redactConfig(r.effectiveConfig())
+	if err != nil {
+		r.logger.Warn("could not convert configuration for gateway api", zap.Error(err))
+	}
+	updated.Config = config
+	meta.SetGatewayInfo(&updated)
+}
+
+// fingerprint hashes the contents of the files the configuration was read from.
+func (r *reloader) fingerprint() ([]byte, error) {
+	r.mu.Lock()
+	files := r.files
+	r.mu.Unlock()
+
+	h := sha256.New()
+	for _, file := range files {
+		contents, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		_, _ = fmt.Fprintf(h, "%s:%d:", file, len(contents))
+		_, _ = h.Write(contents)
+	}
+	return h.Sum(nil), nil
+}
+
+// watch reloads the configuration whenever the contents of any of its files change, including included files and
+// secrets read from files, until the context is done.
+func (r *reloader) watch(ctx context.Context, interval time.Duration) {
+	last, err := r.fingerprint()
+	if err != nil {
+		r.logger.Error("could not read configuration file", zap.Error(err))
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		current, err := r.fingerprint()
+		if err != nil {
+			r.logger.Error("could not read configuration file", zap.Error(err))
+			continue
+		}
+		if bytes.Equal(current, last) {
+			continue
+		}
+		last = current
+
+		r.logger.Info("configuration file changed, reloading")
+		if err := r.reload(); err != nil {
+			r.logger.Error("configuration reload rejected", zap.Error(err))
+			continue
+		}
+		// The set of files may have changed, e.g. if an include was added.
+		if last, err = r.fingerprint(); err != nil {
+			r.logger.Error("could not read configuration file", zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap/zaptest"
+)
+
+// reconfigurable records the configurations applied to it, or rejects them with err.
+type reconfigurable struct {
+	configs []*any.Any
+	err     error
+}
+
+func (r *reconfigurable) Reconfigure(cfg *any.Any) (func(), error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return func() { r.configs = append(r.configs, cfg) }, nil
+}
+
+const reloadTestConfig = `
+gateway:
+  listener:
+    tcp:
+      address: 0.0.0.0
+      port: 8080
+  logger: {}
+  stats: {}
+services:
+  - name: clutch.service.authz
+    typed_config:
+      "@type": types.google.com/clutch.config.service.authz.v1.Config
+      roles:
+        - role_name: %[1]s
+  - name: clutch.service.static
+    typed_config:
+      "@type": types.google.com/clutch.config.service.authz.v1.Config
+  - name: clutch.service.other
+    typed_config:
+      "@type": types.google.com/clutch.config.service.authz.v1.Config
+      roles:
+        - role_name: %[1]s
+`
+
+func TestReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clutch-reload")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "clutch-config.yaml")
+	write := func(roleName string) {
+		contents := fmt.Sprintf(reloadTestConfig, roleName)
+		assert.NoError(t, ioutil.WriteFile(path, []byte(contents), 0600))
+	}
+
+	write("first")
+	f := &Flags{ConfigPaths: []string{path}}
+	cfg, files, err := loadConfig(f)
+	assert.NoError(t, err)
+
+	authz := &reconfigurable{}
+	other := &reconfigurable{}
+	lc := &lifecycle{}
+	lc.add("clutch.service.authz", authz)
+	lc.add("clutch.service.static", struct{}{})
+	lc.add("clutch.service.other", other)
+
+	scope := tally.NewTestScope("", nil)
+	r := newReloader(f, cfg, files, lc, zaptest.NewLogger(t), scope)
+
+	// Unchanged configuration does not reconfigure anything.
+	assert.NoError(t, r.reload())
+	assert.Len(t, authz.configs, 0)
+
+	// Changed configuration is applied.
+	write("second")
+	assert.NoError(t, r.reload())
+	assert.Len(t, authz.configs, 1)
+	assert.Len(t, other.configs, 1)
+
+	// Invalid configuration is rejected.
+	assert.NoError(t, ioutil.WriteFile(path, []byte("gateway: {}"), 0600))
+	assert.Error(t, r.reload())
+	assert.Len(t, authz.configs, 1)
+
+	// If any component rejects its configuration, none of the changes are applied.
+	write("third")
+	other.err = errors.New("invalid")
+	assert.EqualError(t, r.reload(), "component 'clutch.service.other' rejected its configuration: invalid")
+	assert.Len(t, authz.configs, 1)
+	assert.Len(t, other.configs, 1)
+
+	// The changes are applied once every component accepts them.
+	other.err = nil
+	assert.NoError(t, r.reload())
+	assert.Len(t, authz.configs, 2)
+	assert.Len(t, other.configs, 2)
+
+	assert.EqualValues(t, 3, scope.Snapshot().Counters()["config_reload_success+"].Value())
+	assert.EqualValues(t, 2, scope.Snapshot().Counters()["config_reload_failure+"].Value())
+}
+
+func TestWatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clutch-reload")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// The exec secret provider records each time it runs.
+	calls := filepath.Join(dir, "calls")
+	path := filepath.Join(dir, "clutch-config.yaml")
+	write := func(roleName string) {
+		contents := fmt.Sprintf(reloadTestConfig, "${secret:cmd:"+roleName+"}") + `
+secret_providers:
+  - name: cmd
+    exec:
+      command: sh
+      args: ["-c", "echo >> ` + calls + `; printf %s \"$1\"", "sh"]
+`
+		assert.NoError(t, ioutil.WriteFile(path, []byte(contents), 0600))
+	}
+	countCalls := func() int {
+		contents, _ := ioutil.ReadFile(calls)
+		return strings.Count(string(contents), "\n")
+	}
+
+	write("first")
+	f := &Flags{ConfigPaths: []string{path}}
+	cfg, files, err := loadConfig(f)
+	assert.NoError(t, err)
+	startupCalls := countCalls()
+	assert.NotZero(t, startupCalls)
+
+	authz := &reconfigurable{}
+	lc := &lifecycle{}
+	lc.add("clutch.service.authz", authz)
+	r := newReloader(f, cfg, files, lc, zaptest.NewLogger(t), tally.NoopScope)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		r.watch(ctx, time.Millisecond*10)
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	// Secrets are not resolved again until the configuration changes.
+	time.Sleep(time.Millisecond * 50)
+	assert.Equal(t, startupCalls, countCalls())
+
+	write("second")
+	assert.Eventually(t, func() bool { return countCalls() == 2*startupCalls }, time.Second*5, time.Millisecond*10)
+}
@@ -0,0 +1,241 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+)
+
+const defaultSecretExecTimeout = time.Second * 10
+
+// References are resolved within string values, e.g. "password: ${secret:vault:clutch/postgres}".
+var secretPattern = regexp.MustCompile(`\$\{secret:([^:}]+):([^}]+)\}`)
+
+// SecretProvider resolves the value of secret references in the configuration. Values must never be logged or included
+// in errors.
+type SecretProvider interface {
+	Secret(key string) (string, error)
+}
+
+// Every string value in the configuration that had a secret reference, after the references were resolved, so that the
+// values can be redacted from the configuration returned by the gateway API.
+var resolvedSecrets sync.Map
+
+var (
+	secretProvidersMu sync.Mutex
+	secretProviders   = map[string]SecretProvider{
+		"env":  envSecretProvider{},
+		"file": &fileSecretProvider{},
+	}
+)
+
+// RegisterSecretProvider makes a custom provider available to secret references under the given name. It must be called
+// before the configuration is read. Providers in the configuration take precedence over registered providers.
+func RegisterSecretProvider(name string, provider SecretProvider) {
+	secretProvidersMu.Lock()
+	defer secretProvidersMu.Unlock()
+	secretProviders[name] = provider
+}
+
+func newSecretProvider(cfg *gatewayv1.SecretProvider) (SecretProvider, error) {
+	switch t := cfg.Type.(type) {
+	case *gatewayv1.SecretProvider_File_:
+		return &fileSecretProvider{directory: t.File.Directory}, nil
+	case *gatewayv1.SecretProvider_Exec_:
+		timeout := defaultSecretExecTimeout
+		if t.Exec.Timeout != nil {
+			timeout = duration(t.Exec.Timeout)
+		}
+		return &execSecretProvider{command: t.Exec.Command, args: t.Exec.Args, timeout: timeout}, nil
+	default:
+		return nil, fmt.Errorf("secret provider '%s' has unsupported type %T", cfg.Name, t)
+	}
+}
+
+type fileSecretProvider struct {
+	directory string
+}
+
+func (p *fileSecretProvider) path(key string) (string, error) {
+	if p.directory == "" {
+		if !filepath.IsAbs(key) {
+			return "", fmt.Errorf("secret file path '%s' is not absolute", key)
+		}
+		return filepath.Clean(key), nil
+	}
+
+	path := filepath.Join(p.directory, key)
+	// Compare whole path elements so that names starting with two dots, e.g. '..token', are allowed.
+	if rel, err := filepath.Rel(p.directory, path); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("secret file '%s' is outside of directory '%s'", key, p.directory)
+	}
+	return path, nil
+}
+
+func (p *fileSecretProvider) Secret(key string) (string, error) {
+	path, err := p.path(key)
+	if err != nil {
+		return "", err
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(contents), "\r\n"), nil
+}
+
+// envSecretProvider reads the secret from an environment variable. Unlike interpolating the variable directly, the value
+// is redacted from the configuration returned by the gateway API.
+type envSecretProvider struct{}
+
+func (envSecretProvider) Secret(key string) (string, error) {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return "", fmt.Errorf("environment variable '%s' is not set", key)
+	}
+	return v, nil
+}
+
+type execSecretProvider struct {
+	command string
+	args    []string
+	timeout time.Duration
+}
+
+func (p *execSecretProvider) Secret(key string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	args := append(append([]string{}, p.args...), key)
+	cmd := exec.CommandContext(ctx, p.command, args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	// Only stderr is included in the error since stdout may contain the secret.
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%w: %s", err, msg)
+		}
+		return "", err
+	}
+	return strings.TrimRight(stdout.String(), "\r\n"), nil
+}
+
+// secretResolver resolves references using the providers in the configuration and registered providers. Values are
+// cached so that each secret is only fetched once per read of the configuration.
+type secretResolver struct {
+	providers map[string]SecretProvider
+	cache     map[string]string
+	// Files read by file providers, so that rotated secrets can trigger a reload.
+	files []string
+}
+
+func newSecretResolver(cfgs []*gatewayv1.SecretProvider) (*secretResolver, error) {
+	r := &secretResolver{
+		providers: make(map[string]SecretProvider),
+		cache:     make(map[string]string),
+	}
+
+	secretProvidersMu.Lock()
+	for name, p := range secretProviders {
+		r.providers[name] = p
+	}
+	secretProvidersMu.Unlock()
+
+	for _, cfg := range cfgs {
+		if err := cfg.Validate(); err != nil {
+			return nil, fmt.Errorf("secret provider '%s' is invalid: %w", cfg.Name, err)
+		}
+		p, err := newSecretProvider(cfg)
+		if err != nil {
+			return nil, err
+		}
+		r.providers[cfg.Name] = p
+	}
+	return r, nil
+}
+
+func (r *secretResolver) secret(provider, key string) (string, error) {
+	ref := provider + ":" + key
+	if v, ok := r.cache[ref]; ok {
+		return v, nil
+	}
+
+	p, ok := r.providers[provider]
+	if !ok {
+		return "", fmt.Errorf("secret provider '%s' is not configured", provider)
+	}
+	v, err := p.Secret(key)
+	if err != nil {
+		return "", fmt.Errorf("could not resolve secret '%s' from provider '%s': %w", key, provider, err)
+	}
+
+	if fp, ok := p.(*fileSecretProvider); ok {
+		if path, err := fp.path(key); err == nil && !containsString(r.files, path) {
+			r.files = append(r.files, path)
+		}
+	}
+	r.cache[ref] = v
+	return v, nil
+}
+
+// resolve replaces secret references in all string values of the decoded YAML.
+func (r *secretResolver) resolve(v interface{}) (interface{}, error) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			resolved, err := r.resolve(child)
+			if err != nil {
+				return nil, err
+			}
+			t[k] = resolved
+		}
+	case []interface{}:
+		for i, child := range t {
+			resolved, err := r.resolve(child)
+			if err != nil {
+				return nil, err
+			}
+			t[i] = resolved
+		}
+	case string:
+		var err error
+		resolved := secretPattern.ReplaceAllStringFunc(t, func(ref string) string {
+			m := secretPattern.FindStringSubmatch(ref)
+			s, serr := r.secret(m[1], m[2])
+			if serr != nil && err == nil {
+				err = serr
+			}
+			return s
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resolved != t && resolved != "" {
+			resolvedSecrets.Store(resolved, struct{}{})
+		}
+		return resolved, nil
+	}
+	return v, nil
+}
+
+func containsString(l []string, s string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
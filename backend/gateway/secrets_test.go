@@ -0,0 +1,117 @@
+package gateway
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+)
+
+func TestFileSecretProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clutch-secrets")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "token"), []byte("hunter2\r\n"), 0600))
+
+	p := &fileSecretProvider{}
+	v, err := p.Secret(filepath.Join(dir, "token"))
+	assert.NoError(t, err)
+	assert.Equal(t, "hunter2", v)
+
+	_, err = p.Secret("token")
+	assert.Error(t, err)
+
+	p = &fileSecretProvider{directory: dir}
+	v, err = p.Secret("token")
+	assert.NoError(t, err)
+	assert.Equal(t, "hunter2", v)
+
+	_, err = p.Secret("../token")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "outside of directory")
+
+	// Names starting with two dots are not outside of the directory.
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "..token"), []byte("hunter3"), 0600))
+	v, err = p.Secret("..token")
+	assert.NoError(t, err)
+	assert.Equal(t, "hunter3", v)
+
+	_, err = p.Secret("missing")
+	assert.Error(t, err)
+}
+
+func TestEnvSecretProvider(t *testing.T) {
+	os.Setenv("CLUTCH_TEST_SECRET", "hunter2")
+	defer os.Unsetenv("CLUTCH_TEST_SECRET")
+
+	v, err := envSecretProvider{}.Secret("CLUTCH_TEST_SECRET")
+	assert.NoError(t, err)
+	assert.Equal(t, "hunter2", v)
+
+	_, err = envSecretProvider{}.Secret("CLUTCH_TEST_MISSING")
+	assert.EqualError(t, err, "environment variable 'CLUTCH_TEST_MISSING' is not set")
+}
+
+func TestExecSecretProvider(t *testing.T) {
+	p, err := newSecretProvider(&gatewayv1.SecretProvider{
+		Name: "exec",
+		Type: &gatewayv1.SecretProvider_Exec_{Exec: &gatewayv1.SecretProvider_Exec{Command: "echo", Args: []string{"-n"}}},
+	})
+	assert.NoError(t, err)
+	v, err := p.Secret("hunter2")
+	assert.NoError(t, err)
+	assert.Equal(t, "hunter2", v)
+
+	// Stderr is included in errors, stdout is not.
+	p, err = newSecretProvider(&gatewayv1.SecretProvider{
+		Name: "exec",
+		Type: &gatewayv1.SecretProvider_Exec_{Exec: &gatewayv1.SecretProvider_Exec{
+			Command: "sh",
+			Args:    []string{"-c", `echo hunter2; echo "no secret named $1" >&2; exit 1`, "sh"},
+		}},
+	})
+	assert.NoError(t, err)
+	_, err = p.Secret("foo")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no secret named foo")
+	assert.NotContains(t, err.Error(), "hunter2")
+}
+
+type staticSecretProvider map[string]string
+
+func (p staticSecretProvider) Secret(key string) (string, error) {
+	return p[key], nil
+}
+
+func TestSecretResolver(t *testing.T) {
+	RegisterSecretProvider("static", staticSecretProvider{"foo": "bar"})
+	defer func() {
+		secretProvidersMu.Lock()
+		delete(secretProviders, "static")
+		secretProvidersMu.Unlock()
+	}()
+
+	r, err := newSecretResolver(nil)
+	assert.NoError(t, err)
+
+	raw := map[string]interface{}{
+		"a": "${secret:static:foo}",
+		"b": []interface{}{"x-${secret:static:foo}-${secret:static:foo}", 1},
+		"c": map[string]interface{}{"d": "${notasecret}"},
+	}
+	_, err = r.resolve(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"a": "bar",
+		"b": []interface{}{"x-bar-bar", 1},
+		"c": map[string]interface{}{"d": "${notasecret}"},
+	}, raw)
+
+	// Invalid provider configuration is rejected.
+	_, err = newSecretResolver([]*gatewayv1.SecretProvider{{Name: "empty"}})
+	assert.Error(t, err)
+}
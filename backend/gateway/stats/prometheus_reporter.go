@@ -0,0 +1,48 @@
+package stats
+
+import (
+	"os"
+
+	"github.com/m3db/prometheus_client_golang/prometheus"
+	tallyprom "github.com/uber-go/tally/prometheus"
+	"go.uber.org/zap"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+)
+
+const DefaultPrometheusPath = "/metrics"
+
+// NewPrometheusReporter returns a reporter backed by its own registry, which also includes the Go runtime and process
+// collectors. Metrics are exposed for scraping via the reporter's HTTPHandler.
+func NewPrometheusReporter(cfg *gatewayv1.Stats_PrometheusReporter, logger *zap.Logger) (tallyprom.Reporter, error) {
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(prometheus.NewGoCollector()); err != nil {
+		return nil, err
+	}
+	if err := registry.Register(prometheus.NewProcessCollector(os.Getpid(), "")); err != nil {
+		return nil, err
+	}
+
+	timerType := tallyprom.SummaryTimerType
+	if cfg.TimerType == gatewayv1.Stats_PrometheusReporter_HISTOGRAM {
+		timerType = tallyprom.HistogramTimerType
+	}
+
+	return tallyprom.NewReporter(tallyprom.Options{
+		Registerer:       registry,
+		DefaultTimerType: timerType,
+		// Prometheus requires every series of a metric to have the same set of tags. Rather than panicking at runtime
+		// when that isn't the case (the default behavior), log an error and drop the metric.
+		OnRegisterError: func(err error) {
+			logger.Error("could not register prometheus metric", zap.Error(err))
+		},
+	}), nil
+}
+
+// PrometheusPath returns the configured path for serving metrics, or the default if none is configured.
+func PrometheusPath(cfg *gatewayv1.Stats_PrometheusReporter) string {
+	if cfg.Path == "" {
+		return DefaultPrometheusPath
+	}
+	return cfg.Path
+}
@@ -0,0 +1,57 @@
+package stats
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+	tallyprom "github.com/uber-go/tally/prometheus"
+	"go.uber.org/zap/zaptest"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+)
+
+func TestPrometheusReporter(t *testing.T) {
+	cfg := &gatewayv1.Stats_PrometheusReporter{TimerType: gatewayv1.Stats_PrometheusReporter_HISTOGRAM}
+	reporter, err := NewPrometheusReporter(cfg, zaptest.NewLogger(t))
+	assert.NoError(t, err)
+
+	scope, closer := tally.NewRootScope(tally.ScopeOptions{
+		Prefix:          "clutch",
+		CachedReporter:  reporter,
+		Separator:       tallyprom.DefaultSeparator,
+		SanitizeOptions: &tallyprom.DefaultSanitizerOpts,
+	}, time.Millisecond)
+	defer closer.Close()
+
+	grpcScope := scope.SubScope("module").Tagged(map[string]string{
+		"grpc_service": "clutch.k8s.v1.K8sAPI",
+		"grpc_method":  "DescribePod",
+	})
+	grpcScope.Timer("rpc_latency").Record(time.Millisecond * 5)
+	grpcScope.Tagged(map[string]string{"grpc_status": "OK"}).Counter("rpc_total").Inc(1)
+	scope.Gauge("gauge").Update(42)
+
+	// Registering the same metric with different tags is logged rather than panicking.
+	scope.Tagged(map[string]string{"foo": "bar"}).Gauge("gauge").Update(1)
+
+	assert.NoError(t, closer.Close())
+
+	rec := httptest.NewRecorder()
+	reporter.HTTPHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	assert.Contains(t, body, `clutch_module_rpc_latency_bucket{grpc_method="DescribePod",grpc_service="clutch_k8s_v1_K8sAPI"`)
+	assert.Contains(t, body, `clutch_module_rpc_total{grpc_method="DescribePod",grpc_service="clutch_k8s_v1_K8sAPI",grpc_status="OK"} 1`)
+	assert.Contains(t, body, "clutch_gauge 42")
+	assert.Contains(t, body, "go_goroutines")
+}
+
+func TestPrometheusPath(t *testing.T) {
+	assert.Equal(t, "/metrics", PrometheusPath(&gatewayv1.Stats_PrometheusReporter{}))
+	assert.Equal(t, "/stats", PrometheusPath(&gatewayv1.Stats_PrometheusReporter{Path: "/stats"}))
+}
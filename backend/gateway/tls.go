@@ -0,0 +1,224 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+)
+
+const defaultCertificateReloadInterval = time.Minute
+
+// certificateReloader holds the listener's TLS material and re-reads it from disk on an interval. Each new TLS
+// handshake uses the most recently loaded certificate and client CA pool, allowing certificates to be rotated without
+// restarting the gateway.
+type certificateReloader struct {
+	cfg    *gatewayv1.TLS
+	logger *zap.Logger
+
+	mu         sync.RWMutex
+	contents   []byte
+	cert       *tls.Certificate
+	clientCAs  *x509.CertPool
+	clientCert *tls.Certificate
+}
+
+func newCertificateReloader(cfg *gatewayv1.TLS, logger *zap.Logger) (*certificateReloader, error) {
+	if cfg == nil {
+		return nil, errors.New("'secure' set to true but no TLS configuration was provided")
+	}
+	if (cfg.ClientCertFile == "") != (cfg.ClientKeyFile == "") {
+		return nil, errors.New("'client_cert_file' and 'client_key_file' must be set together")
+	}
+
+	r := &certificateReloader{cfg: cfg, logger: logger}
+	if _, err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// load reads the certificate, key, client CA, and client certificate files from disk. The new material is only parsed and swapped in if
+// the contents of the files changed since the last successful load.
+func (r *certificateReloader) load() (bool, error) {
+	certPEM, err := ioutil.ReadFile(r.cfg.CertFile)
+	if err != nil {
+		return false, err
+	}
+	keyPEM, err := ioutil.ReadFile(r.cfg.KeyFile)
+	if err != nil {
+		return false, err
+	}
+	var caPEM []byte
+	if r.cfg.ClientCaFile != "" {
+		caPEM, err = ioutil.ReadFile(r.cfg.ClientCaFile)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	var clientCertPEM, clientKeyPEM []byte
+	if r.cfg.ClientCertFile != "" {
+		clientCertPEM, err = ioutil.ReadFile(r.cfg.ClientCertFile)
+		if err != nil {
+			return false, err
+		}
+		clientKeyPEM, err = ioutil.ReadFile(r.cfg.ClientKeyFile)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	contents := bytes.Join([][]byte{certPEM, keyPEM, caPEM, clientCertPEM, clientKeyPEM}, nil)
+	r.mu.RLock()
+	unchanged := bytes.Equal(contents, r.contents)
+	r.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return false, fmt.Errorf("could not parse TLS certificate and key: %w", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if caPEM != nil {
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(caPEM) {
+			return false, fmt.Errorf("no certificates found in client CA file '%s'", r.cfg.ClientCaFile)
+		}
+	}
+
+	clientCert := &cert
+	if clientCertPEM != nil {
+		c, err := tls.X509KeyPair(clientCertPEM, clientKeyPEM)
+		if err != nil {
+			return false, fmt.Errorf("could not parse TLS client certificate and key: %w", err)
+		}
+		clientCert = &c
+	}
+	if clientCAs != nil {
+		if err := checkClientAuthUsage(clientCert); err != nil {
+			return false, err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.contents = contents
+	r.cert = &cert
+	r.clientCAs = clientCAs
+	r.clientCert = clientCert
+	return true, nil
+}
+
+// checkClientAuthUsage returns an error if the certificate can't be presented as a client certificate, e.g. because
+// it was issued by a public CA for server authentication only.
+func checkClientAuthUsage(cert *tls.Certificate) error {
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return err
+	}
+	if len(leaf.ExtKeyUsage) == 0 {
+		return nil
+	}
+	for _, usage := range leaf.ExtKeyUsage {
+		if usage == x509.ExtKeyUsageClientAuth || usage == x509.ExtKeyUsageAny {
+			return nil
+		}
+	}
+	return errors.New("the certificate presented to the listener does not allow client authentication, set 'client_cert_file' and 'client_key_file' to a client certificate")
+}
+
+// This should be called via `go` in order to avoid blocking main execution. Polling stops when the context is done.
+func (r *certificateReloader) poll(ctx context.Context) {
+	interval := defaultCertificateReloadInterval
+	if r.cfg.ReloadInterval != nil {
+		interval = duration(r.cfg.ReloadInterval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		changed, err := r.load()
+		if err != nil {
+			r.logger.Error("failed to reload TLS certificates, continuing with previous certificates", zap.Error(err))
+			continue
+		}
+		if changed {
+			r.logger.Info("reloaded TLS certificates")
+		}
+	}
+}
+
+func (r *certificateReloader) certificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert
+}
+
+func (r *certificateReloader) clientCertificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.clientCert
+}
+
+// serverConfig returns a TLS configuration for the listener that resolves the current certificate on every handshake.
+func (r *certificateReloader) serverConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{"h2", "http/1.1"},
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+
+			c := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				NextProtos:   []string{"h2", "http/1.1"},
+				Certificates: []tls.Certificate{*r.cert},
+			}
+			if r.clientCAs != nil {
+				c.ClientCAs = r.clientCAs
+				c.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+			return c, nil
+		},
+	}
+}
+
+// clientConfig returns a TLS configuration for the gateway to connect to its own listener, e.g. for the grpc-gateway
+// handlers. The listener's certificate is pinned rather than verified against a CA, and the client certificate, or the
+// listener's certificate if none is configured, is presented if the listener requires client certificates.
+func (r *certificateReloader) clientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// Verification is performed in VerifyPeerCertificate by comparing against the listener's own certificate.
+		InsecureSkipVerify: true, // #nosec G402
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			cert := r.certificate()
+			if len(rawCerts) == 0 || len(cert.Certificate) == 0 || !bytes.Equal(rawCerts[0], cert.Certificate[0]) {
+				return errors.New("certificate presented by the listener does not match the configured certificate")
+			}
+			return nil
+		},
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.clientCertificate(), nil
+		},
+	}
+}
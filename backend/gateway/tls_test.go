@@ -0,0 +1,206 @@
+package gateway
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+)
+
+// Writes a self-signed certificate and key to the given directory and returns the certificate PEM.
+func writeTestCertificate(t *testing.T, dir string, commonName string) []byte {
+	return writeTestCertificateFiles(t, dir, "cert.pem", "key.pem", commonName, x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth)
+}
+
+// Writes a self-signed certificate with the given extended key usages and its key to the given files in the directory
+// and returns the certificate PEM.
+func writeTestCertificateFiles(t *testing.T, dir, certFile, keyFile, commonName string, usages ...x509.ExtKeyUsage) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           usages,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, certFile), certPEM, 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, keyFile), keyPEM, 0600))
+	return certPEM
+}
+
+func TestCertificateReloaderErrors(t *testing.T) {
+	_, err := newCertificateReloader(nil, zaptest.NewLogger(t))
+	assert.Error(t, err)
+
+	_, err = newCertificateReloader(&gatewayv1.TLS{CertFile: "/does/not/exist", KeyFile: "/does/not/exist"}, zaptest.NewLogger(t))
+	assert.Error(t, err)
+
+	dir, err := ioutil.TempDir("", "clutch-tls")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeTestCertificate(t, dir, "clutch")
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "ca.pem"), []byte("not a certificate"), 0600))
+	_, err = newCertificateReloader(&gatewayv1.TLS{
+		CertFile:     filepath.Join(dir, "cert.pem"),
+		KeyFile:      filepath.Join(dir, "key.pem"),
+		ClientCaFile: filepath.Join(dir, "ca.pem"),
+	}, zaptest.NewLogger(t))
+	assert.Error(t, err)
+}
+
+func TestCertificateReloaderReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clutch-tls")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeTestCertificate(t, dir, "first")
+	r, err := newCertificateReloader(&gatewayv1.TLS{
+		CertFile: filepath.Join(dir, "cert.pem"),
+		KeyFile:  filepath.Join(dir, "key.pem"),
+	}, zaptest.NewLogger(t))
+	assert.NoError(t, err)
+
+	sc, err := r.serverConfig().GetConfigForClient(&tls.ClientHelloInfo{})
+	assert.NoError(t, err)
+	assert.Equal(t, tls.NoClientCert, sc.ClientAuth)
+	leaf, err := x509.ParseCertificate(sc.Certificates[0].Certificate[0])
+	assert.NoError(t, err)
+	assert.Equal(t, "first", leaf.Subject.CommonName)
+
+	// No change on disk.
+	changed, err := r.load()
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	// Rotate the certificate.
+	writeTestCertificate(t, dir, "second")
+	changed, err = r.load()
+	assert.NoError(t, err)
+	assert.True(t, changed)
+
+	sc, err = r.serverConfig().GetConfigForClient(&tls.ClientHelloInfo{})
+	assert.NoError(t, err)
+	leaf, err = x509.ParseCertificate(sc.Certificates[0].Certificate[0])
+	assert.NoError(t, err)
+	assert.Equal(t, "second", leaf.Subject.CommonName)
+
+	// A bad key on disk is an error and the previous certificate is kept.
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "key.pem"), []byte("garbage"), 0600))
+	_, err = r.load()
+	assert.Error(t, err)
+	leaf, err = x509.ParseCertificate(r.certificate().Certificate[0])
+	assert.NoError(t, err)
+	assert.Equal(t, "second", leaf.Subject.CommonName)
+}
+
+func TestCertificateReloaderMutualTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clutch-tls")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// The self-signed certificate doubles as the client CA so that the client config is trusted by the server.
+	certPEM := writeTestCertificate(t, dir, "clutch")
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "ca.pem"), certPEM, 0600))
+	r, err := newCertificateReloader(&gatewayv1.TLS{
+		CertFile:     filepath.Join(dir, "cert.pem"),
+		KeyFile:      filepath.Join(dir, "key.pem"),
+		ClientCaFile: filepath.Join(dir, "ca.pem"),
+	}, zaptest.NewLogger(t))
+	assert.NoError(t, err)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	srv.TLS = r.serverConfig()
+	srv.StartTLS()
+	defer srv.Close()
+
+	// Clients presenting the certificate are accepted.
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: r.clientConfig()}}
+	resp, err := client.Get(srv.URL)
+	assert.NoError(t, err)
+	if err == nil {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		resp.Body.Close()
+	}
+
+	// Clients without a certificate are rejected.
+	noCert := r.clientConfig()
+	noCert.GetClientCertificate = nil
+	client = &http.Client{Transport: &http.Transport{TLSClientConfig: noCert}}
+	_, err = client.Get(srv.URL)
+	assert.Error(t, err)
+}
+
+func TestCertificateReloaderClientCertificate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clutch-tls")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Certificates from public CAs usually only allow server authentication.
+	writeTestCertificateFiles(t, dir, "cert.pem", "key.pem", "clutch", x509.ExtKeyUsageServerAuth)
+	clientPEM := writeTestCertificateFiles(t, dir, "client.pem", "client-key.pem", "client", x509.ExtKeyUsageClientAuth)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "ca.pem"), clientPEM, 0600))
+
+	cfg := &gatewayv1.TLS{
+		CertFile:     filepath.Join(dir, "cert.pem"),
+		KeyFile:      filepath.Join(dir, "key.pem"),
+		ClientCaFile: filepath.Join(dir, "ca.pem"),
+	}
+	_, err = newCertificateReloader(cfg, zaptest.NewLogger(t))
+	assert.Error(t, err)
+
+	cfg.ClientCertFile = filepath.Join(dir, "client.pem")
+	_, err = newCertificateReloader(cfg, zaptest.NewLogger(t))
+	assert.EqualError(t, err, "'client_cert_file' and 'client_key_file' must be set together")
+
+	cfg.ClientKeyFile = filepath.Join(dir, "client-key.pem")
+	r, err := newCertificateReloader(cfg, zaptest.NewLogger(t))
+	assert.NoError(t, err)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	srv.TLS = r.serverConfig()
+	srv.StartTLS()
+	defer srv.Close()
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: r.clientConfig()}}
+	resp, err := client.Get(srv.URL)
+	assert.NoError(t, err)
+	if err == nil {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		resp.Body.Close()
+	}
+}
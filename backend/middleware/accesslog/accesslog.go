@@ -0,0 +1,152 @@
+package accesslog
+
+// <!-- START clutchdoc -->
+// description: Logs the method, subject, status, latency, and request size of each request, with sampling and per-method rules.
+// <!-- END clutchdoc -->
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	accesslogv1 "github.com/lyft/clutch/backend/api/config/middleware/accesslog/v1"
+	"github.com/lyft/clutch/backend/middleware"
+	"github.com/lyft/clutch/backend/requestid"
+	"github.com/lyft/clutch/backend/service/authn"
+)
+
+const Name = "clutch.middleware.accesslog"
+
+func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (middleware.Middleware, error) {
+	m := &mid{logger: logger, sample: rand.Float64} // #nosec G404
+	apply, err := m.Reconfigure(cfg)
+	if err != nil {
+		return nil, err
+	}
+	apply()
+	return m, nil
+}
+
+type mid struct {
+	logger *zap.Logger
+	// Returns a number in [0, 1) for sampling decisions.
+	sample func() float64
+
+	mu     sync.RWMutex
+	config *accesslogv1.Config
+}
+
+// Reconfigure replaces the sampling and method rules. Without a configuration, every request is logged.
+func (m *mid) Reconfigure(cfg *any.Any) (func(), error) {
+	config := &accesslogv1.Config{}
+	if cfg != nil {
+		if err := ptypes.UnmarshalAny(cfg, config); err != nil {
+			return nil, err
+		}
+	}
+
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.config = config
+	}, nil
+}
+
+func (m *mid) getConfig() *accesslogv1.Config {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.config
+}
+
+// logged returns whether requests to the method are logged at all, which is decided before calling the handler.
+func logged(config *accesslogv1.Config, fullMethod string) bool {
+	for _, pattern := range config.ExcludeMethods {
+		if middleware.MatchMethodOrResource(pattern, fullMethod) {
+			return false
+		}
+	}
+	if len(config.IncludeMethods) == 0 {
+		return true
+	}
+	for _, pattern := range config.IncludeMethods {
+		if middleware.MatchMethodOrResource(pattern, fullMethod) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *mid) sampled(config *accesslogv1.Config, err error) bool {
+	if err != nil && config.AlwaysLogErrors {
+		return true
+	}
+	if config.SampleRatio == nil || config.SampleRatio.Value >= 1 {
+		return true
+	}
+	return m.sample() < config.SampleRatio.Value
+}
+
+func (m *mid) log(ctx context.Context, fullMethod string, start time.Time, err error, fields ...zap.Field) {
+	service, method, _ := middleware.SplitFullMethod(fullMethod)
+
+	// Claims are only present if the authentication middleware runs earlier in the chain.
+	subject := ""
+	if claims, cerr := authn.ClaimsFromContext(ctx); cerr == nil {
+		subject = claims.Subject
+	}
+
+	fields = append([]zap.Field{
+		zap.String("service", service),
+		zap.String("method", method),
+		zap.String("subject", subject),
+		zap.String("code", status.Code(err).String()),
+		zap.Duration("latency", time.Since(start)),
+	}, fields...)
+	requestid.Logger(ctx, m.logger).Info("access", fields...)
+}
+
+func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		config := m.getConfig()
+		if !logged(config, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		if m.sampled(config, err) {
+			size := 0
+			if msg, ok := req.(proto.Message); ok {
+				size = proto.Size(msg)
+			}
+			m.log(ctx, info.FullMethod, start, err, zap.Int("requestSize", size))
+		}
+		return resp, err
+	}
+}
+
+// Streams are logged when they close, so the latency is the duration of the stream.
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		config := m.getConfig()
+		if !logged(config, info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		start := time.Now()
+		err := handler(srv, ss)
+		if m.sampled(config, err) {
+			m.log(ss.Context(), info.FullMethod, start, err)
+		}
+		return err
+	}
+}
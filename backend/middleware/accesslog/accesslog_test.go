@@ -0,0 +1,173 @@
+package accesslog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+
+	accesslogv1 "github.com/lyft/clutch/backend/api/config/middleware/accesslog/v1"
+	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
+	k8sv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+	"github.com/lyft/clutch/backend/service/authn"
+)
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context { return s.ctx }
+
+func newMiddleware(t *testing.T, config *accesslogv1.Config) (*mid, *observer.ObservedLogs) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	a, err := ptypes.MarshalAny(config)
+	assert.NoError(t, err)
+	m, err := New(a, zap.New(core), tally.NoopScope)
+	assert.NoError(t, err)
+	return m.(*mid), logs
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	m, logs := newMiddleware(t, &accesslogv1.Config{})
+
+	ctx := authn.ContextWithClaims(context.Background(), &authn.Claims{StandardClaims: &jwt.StandardClaims{Subject: "user@example.com"}})
+	req := &k8sv1.DescribePodRequest{Clientset: "core", Name: "envoy"}
+	info := &grpc.UnaryServerInfo{FullMethod: "/clutch.k8s.v1.K8sAPI/DescribePod"}
+	_, err := m.UnaryInterceptor()(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, errors.New("boom")
+	})
+	assert.Error(t, err)
+
+	if assert.Equal(t, 1, logs.Len()) {
+		fields := logs.All()[0].ContextMap()
+		assert.Equal(t, "clutch.k8s.v1.K8sAPI", fields["service"])
+		assert.Equal(t, "DescribePod", fields["method"])
+		assert.Equal(t, "user@example.com", fields["subject"])
+		assert.Equal(t, "Unknown", fields["code"])
+		assert.Equal(t, int64(proto.Size(req)), fields["requestSize"])
+		assert.Contains(t, fields, "latency")
+	}
+}
+
+func TestRules(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *accesslogv1.Config
+		sample float64
+		method string
+		err    error
+		logged bool
+	}{
+		{name: "default", method: "/clutch.k8s.v1.K8sAPI/DescribePod", logged: true},
+		{
+			name:   "excluded",
+			config: &accesslogv1.Config{ExcludeMethods: []string{"/clutch.healthcheck.v1.HealthcheckAPI/*"}},
+			method: "/clutch.healthcheck.v1.HealthcheckAPI/Healthcheck",
+		},
+		{
+			name: "exclusion takes precedence",
+			config: &accesslogv1.Config{
+				IncludeMethods: []string{"*"},
+				ExcludeMethods: []string{"/clutch.healthcheck.v1.HealthcheckAPI/Healthcheck"},
+			},
+			method: "/clutch.healthcheck.v1.HealthcheckAPI/Healthcheck",
+		},
+		{
+			name:   "not included",
+			config: &accesslogv1.Config{IncludeMethods: []string{"/clutch.k8s.v1.K8sAPI/*"}},
+			method: "/clutch.healthcheck.v1.HealthcheckAPI/Healthcheck",
+		},
+		{
+			name:   "included",
+			config: &accesslogv1.Config{IncludeMethods: []string{"/clutch.k8s.v1.K8sAPI/*"}},
+			method: "/clutch.k8s.v1.K8sAPI/DescribePod",
+			logged: true,
+		},
+		{
+			name:   "sampled",
+			config: &accesslogv1.Config{SampleRatio: &wrappers.DoubleValue{Value: 0.5}},
+			sample: 0.2,
+			method: "/clutch.k8s.v1.K8sAPI/DescribePod",
+			logged: true,
+		},
+		{
+			name:   "not sampled",
+			config: &accesslogv1.Config{SampleRatio: &wrappers.DoubleValue{Value: 0.5}},
+			sample: 0.7,
+			method: "/clutch.k8s.v1.K8sAPI/DescribePod",
+		},
+		{
+			name:   "error not sampled",
+			config: &accesslogv1.Config{SampleRatio: &wrappers.DoubleValue{}},
+			method: "/clutch.k8s.v1.K8sAPI/DescribePod",
+			err:    errors.New("boom"),
+		},
+		{
+			name:   "error always logged",
+			config: &accesslogv1.Config{SampleRatio: &wrappers.DoubleValue{}, AlwaysLogErrors: true},
+			method: "/clutch.k8s.v1.K8sAPI/DescribePod",
+			err:    errors.New("boom"),
+			logged: true,
+		},
+		{
+			name: "excluded error",
+			config: &accesslogv1.Config{
+				ExcludeMethods:  []string{"/clutch.healthcheck.v1.HealthcheckAPI/*"},
+				AlwaysLogErrors: true,
+			},
+			method: "/clutch.healthcheck.v1.HealthcheckAPI/Healthcheck",
+			err:    errors.New("boom"),
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			config := tt.config
+			if config == nil {
+				config = &accesslogv1.Config{}
+			}
+			m, logs := newMiddleware(t, config)
+			m.sample = func() float64 { return tt.sample }
+
+			_, _ = m.UnaryInterceptor()(context.Background(), &healthcheckv1.HealthcheckRequest{}, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(ctx context.Context, req interface{}) (interface{}, error) { return nil, tt.err })
+			_ = m.StreamInterceptor()(nil, &serverStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: tt.method},
+				func(srv interface{}, ss grpc.ServerStream) error { return tt.err })
+
+			expected := 0
+			if tt.logged {
+				expected = 2
+			}
+			assert.Equal(t, expected, logs.Len())
+		})
+	}
+}
+
+func TestReconfigure(t *testing.T) {
+	m, logs := newMiddleware(t, &accesslogv1.Config{})
+	info := &grpc.UnaryServerInfo{FullMethod: "/clutch.healthcheck.v1.HealthcheckAPI/Healthcheck"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+
+	_, _ = m.UnaryInterceptor()(context.Background(), nil, info, handler)
+	assert.Equal(t, 1, logs.Len())
+
+	a, err := ptypes.MarshalAny(&accesslogv1.Config{ExcludeMethods: []string{"/clutch.healthcheck.v1.HealthcheckAPI/*"}})
+	assert.NoError(t, err)
+	apply, err := m.Reconfigure(a)
+	assert.NoError(t, err)
+	apply()
+	_, _ = m.UnaryInterceptor()(context.Background(), nil, info, handler)
+	assert.Equal(t, 1, logs.Len())
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/golang/protobuf/descriptor"
 	"github.com/golang/protobuf/ptypes/any"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -20,6 +21,7 @@ import (
 	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
 	"github.com/lyft/clutch/backend/gateway/meta"
 	"github.com/lyft/clutch/backend/middleware"
+	"github.com/lyft/clutch/backend/requestid"
 	"github.com/lyft/clutch/backend/service"
 	auditservice "github.com/lyft/clutch/backend/service/audit"
 	"github.com/lyft/clutch/backend/service/authn"
@@ -27,8 +29,10 @@ import (
 
 const Name = "clutch.middleware.audit"
 
+var Dependencies = service.Requires(auditservice.Name)
+
 func New(_ *any.Any, logger *zap.Logger, scope tally.Scope) (middleware.Middleware, error) {
-	svc, ok := service.Registry[auditservice.Name]
+	svc, ok := service.Registry.Get(auditservice.Name)
 	if !ok {
 		return nil, fmt.Errorf("no audit svc with path '%s' registered for middleware", auditservice.Name)
 	}
@@ -51,7 +55,7 @@ type auditEntryContextKey struct{}
 
 func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		event := m.eventFromRequest(ctx, req, info)
+		event := m.eventFromRequest(ctx, req, info.FullMethod)
 		id, err := m.audit.WriteRequestEvent(ctx, event)
 		if err != nil && !errors.Is(err, auditservice.ErrFailedFilters) {
 			return nil, fmt.Errorf("could not make call %s because failed to audit: %w", info.FullMethod, err)
@@ -63,7 +67,7 @@ func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 		if id != -1 {
 			update := m.eventFromResponse(resp, err)
 			if auditErr := m.audit.UpdateRequestEvent(ctx, id, update); auditErr != nil {
-				m.logger.Warn("error updating audit event",
+				requestid.Logger(ctx, m.logger).Warn("error updating audit event",
 					zap.Int64("auditID", id),
 					zap.Any("update event", update),
 				)
@@ -74,23 +78,57 @@ func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
-func (m *mid) eventFromRequest(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo) *auditv1.RequestEvent {
-	svc, method, ok := middleware.SplitFullMethod(info.FullMethod)
+// Streams are audited when they are opened, before any messages have been received, so the request event for a stream
+// does not contain any resources. The event is updated with the final status when the stream closes.
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		event := m.eventFromRequest(ctx, nil, info.FullMethod)
+		id, err := m.audit.WriteRequestEvent(ctx, event)
+		if err != nil && !errors.Is(err, auditservice.ErrFailedFilters) {
+			return fmt.Errorf("could not make call %s because failed to audit: %w", info.FullMethod, err)
+		}
+
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = context.WithValue(ctx, auditEntryContextKey{}, id)
+		err = handler(srv, wrapped)
+
+		if id != -1 {
+			update := m.eventFromResponse(nil, err)
+			// The stream's context is likely done by now, so the update is not bound to it.
+			if auditErr := m.audit.UpdateRequestEvent(context.Background(), id, update); auditErr != nil {
+				requestid.Logger(ctx, m.logger).Warn("error updating audit event",
+					zap.Int64("auditID", id),
+					zap.Any("update event", update),
+				)
+			}
+		}
+		return err
+	}
+}
+
+func (m *mid) eventFromRequest(ctx context.Context, req interface{}, fullMethod string) *auditv1.RequestEvent {
+	svc, method, ok := middleware.SplitFullMethod(fullMethod)
 	if !ok {
-		m.logger.Warn("could not parse gRPC method", zap.String("fullMethod", info.FullMethod))
+		requestid.Logger(ctx, m.logger).Warn("could not parse gRPC method", zap.String("fullMethod", fullMethod))
 	}
 
-	username := "UNKNOWN"
+	username, tokenID := "UNKNOWN", ""
 	if claims, err := authn.ClaimsFromContext(ctx); err == nil {
 		username = claims.Subject
+		if claims.IsAPIToken() {
+			tokenID = claims.Id
+		}
 	}
 
 	return &auditv1.RequestEvent{
 		Username:    username,
 		ServiceName: svc,
 		MethodName:  method,
-		Type:        meta.GetAction(info.FullMethod),
-		Resources:   meta.ResourceNames(req.(descriptor.Message)),
+		Type:        meta.GetAction(fullMethod),
+		Resources:   resourceNames(req),
+		RequestId:   requestid.FromContext(ctx),
+		TokenId:     tokenID,
 	}
 }
 
@@ -102,6 +140,13 @@ func (m *mid) eventFromResponse(resp interface{}, err error) *auditv1.RequestEve
 
 	return &auditv1.RequestEvent{
 		Status:    s.Proto(),
-		Resources: meta.ResourceNames(resp.(descriptor.Message)),
+		Resources: resourceNames(resp),
+	}
+}
+
+func resourceNames(msg interface{}) []*auditv1.Resource {
+	if msg == nil {
+		return nil
 	}
+	return meta.ResourceNames(msg.(descriptor.Message))
 }
@@ -7,15 +7,13 @@ package authn
 import (
 	"context"
 	"errors"
-	"net/http"
-	"strings"
 
 	"github.com/golang/protobuf/ptypes/any"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/lyft/clutch/backend/middleware"
@@ -25,15 +23,20 @@ import (
 
 const Name = "clutch.middleware.authn"
 
+var Dependencies = service.Requires("clutch.service.authn")
+
 // List of method patterns that should not be blocked by authn.
 // TODO(maybe): convert this to an API annotation or make configurable on the middleware.
 var allowlist = []string{
-	"/clutch.authn.v1.AuthnAPI/*",
+	"/clutch.authn.v1.AuthnAPI/Login",
+	"/clutch.authn.v1.AuthnAPI/Callback",
+	"/clutch.authn.v1.AuthnAPI/Logout",
 	"/clutch.healthcheck.v1.HealthcheckAPI/*",
+	"/grpc.health.v1.Health/*",
 }
 
 func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (middleware.Middleware, error) {
-	svc, ok := service.Registry["clutch.service.authn"]
+	svc, ok := service.Registry.Get("clutch.service.authn")
 	if !ok {
 		return nil, errors.New("unable to get authn service")
 	}
@@ -56,77 +59,78 @@ type mid struct {
 
 func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		// Check for auth.
-		authenticatedCtx, authErr := m.authenticate(ctx)
-
-		// Determine if it's on the allow list.
-		checkRequired := true
-		for _, allow := range allowlist {
-			if middleware.MatchMethodOrResource(allow, info.FullMethod) {
-				checkRequired = false
-				break
-			}
+		ctx, err := m.contextWithClaims(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		// Assert auth if required.
-		if checkRequired {
-			if authErr != nil {
-				return nil, status.New(codes.Unauthenticated, authErr.Error()).Err()
-			}
-			return handler(authenticatedCtx, req)
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := m.contextWithClaims(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
 		}
 
-		// If auth not required, we still append claims for logging purposes or anonymously accessible APIs.
-		if _, err := authn.ClaimsFromContext(authenticatedCtx); err != nil {
-			// Anonymous claims if there weren't any authenticated claims.
-			return handler(authn.ContextWithAnonymousClaims(ctx), req)
-		}
-		return handler(authenticatedCtx, req)
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
 	}
 }
 
-// getCookieValue is the easiest way to parse a cookie string in a non-HTTP request context.
-func getCookieValue(raw, key string) (string, error) {
-	request := http.Request{Header: http.Header{}}
-	request.Header.Add("Cookie", raw)
-	c, err := request.Cookie(key)
-	if err != nil {
-		return "", err
+// contextWithClaims returns a context with the caller's claims, or an error if auth is required and the caller could not
+// be authenticated.
+func (m *mid) contextWithClaims(ctx context.Context, fullMethod string) (context.Context, error) {
+	// Check for auth.
+	authenticatedCtx, authErr := m.authenticate(ctx)
+
+	// Determine if it's on the allow list.
+	checkRequired := true
+	for _, allow := range allowlist {
+		if middleware.MatchMethodOrResource(allow, fullMethod) {
+			checkRequired = false
+			break
+		}
 	}
-	return c.Value, nil
-}
 
-// getToken looks for the token in the authorization header or cookies.
-func getToken(md metadata.MD) (string, error) {
-	if tokens := md.Get("authorization"); len(tokens) > 0 {
-		splitToken := strings.Split(tokens[0], "Token")
-		if len(splitToken) != 2 {
-			return "", errors.New("bad token format, expected Authorization: Token <token>")
+	// Assert auth if required.
+	if checkRequired {
+		if authErr != nil {
+			return nil, status.New(codes.Unauthenticated, authErr.Error()).Err()
+		}
+		if err := checkScopes(authenticatedCtx, fullMethod); err != nil {
+			return nil, err
 		}
-		return strings.TrimSpace(splitToken[1]), nil
+		return authenticatedCtx, nil
 	}
 
-	v := md.Get("grpcgateway-cookie")
-	if len(v) == 0 {
-		return "", errors.New("token not present in authorization header or cookies")
+	// If auth not required, we still append claims for logging purposes or anonymously accessible APIs.
+	if _, err := authn.ClaimsFromContext(authenticatedCtx); err != nil {
+		// Anonymous claims if there weren't any authenticated claims.
+		return authn.ContextWithAnonymousClaims(ctx), nil
 	}
-
-	return getCookieValue(v[0], "token")
+	return authenticatedCtx, nil
 }
 
-func (m *mid) authenticate(ctx context.Context) (context.Context, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, errors.New("no headers present on request")
+// checkScopes returns an error if the caller authenticated with an API token that is not allowed to call the method.
+func checkScopes(ctx context.Context, fullMethod string) error {
+	claims, err := authn.ClaimsFromContext(ctx)
+	if err != nil || len(claims.Scopes) == 0 {
+		return nil
 	}
 
-	token, err := getToken(md)
-	if err != nil {
-		return nil, err
+	for _, scope := range claims.Scopes {
+		if middleware.MatchMethodOrResource(scope, fullMethod) {
+			return nil
+		}
 	}
+	return status.Errorf(codes.PermissionDenied, "token is not scoped to call '%s'", fullMethod)
+}
 
-	// Verify token.
-	claims, err := m.provider.Verify(ctx, token)
+func (m *mid) authenticate(ctx context.Context) (context.Context, error) {
+	claims, err := authn.AuthenticateRequest(ctx, m.provider)
 	if err != nil {
 		return nil, err
 	}
@@ -1,37 +1,33 @@
 package authn
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/dgrijalva/jwt-go"
 	"github.com/stretchr/testify/assert"
-	"google.golang.org/grpc/metadata"
-)
-
-func TestGetCookieValue(t *testing.T) {
-	cookie := "foo=bar;baz=bang;foo=qux"
-	res, err := getCookieValue(cookie, "foo")
-	assert.NoError(t, err)
-	assert.Equal(t, "bar", res)
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
-	res, err = getCookieValue(cookie, "baz")
-	assert.NoError(t, err)
-	assert.Equal(t, "bang", res)
-}
+	"github.com/lyft/clutch/backend/service/authn"
+)
 
-func TestGetToken(t *testing.T) {
-	tokenVal := "quux"
+func TestCheckScopes(t *testing.T) {
+	method := "/clutch.k8s.v1.K8sAPI/DescribePod"
 
 	tests := []struct {
-		md  metadata.MD
-		err bool
+		claims *authn.Claims
+		code   codes.Code
 	}{
-		{md: metadata.Pairs("authorization", "Token "+tokenVal)},
-		{md: metadata.Pairs("Authorization", "Token "+tokenVal)},
-		{md: metadata.Pairs("grpcgateway-cookie", "foo=bar;token="+tokenVal)},
-		{md: metadata.Pairs("GRPCGateway-Cookie", "foo=bar;token="+tokenVal)},
-		{md: metadata.Pairs("Authorization", tokenVal), err: true},
-		{md: metadata.Pairs(), err: true},
+		{claims: nil},
+		{claims: &authn.Claims{StandardClaims: &jwt.StandardClaims{Subject: "user@example.com"}}},
+		{claims: &authn.Claims{StandardClaims: &jwt.StandardClaims{Subject: "svc"}, Scopes: []string{"*"}}},
+		{claims: &authn.Claims{StandardClaims: &jwt.StandardClaims{Subject: "svc"}, Scopes: []string{"/clutch.k8s.v1.K8sAPI/*"}}},
+		{
+			claims: &authn.Claims{StandardClaims: &jwt.StandardClaims{Subject: "svc"}, Scopes: []string{"/clutch.aws.*/*", "/clutch.k8s.v1.K8sAPI/Delete*"}},
+			code:   codes.PermissionDenied,
+		},
 	}
 
 	for idx, tt := range tests {
@@ -39,13 +35,11 @@ func TestGetToken(t *testing.T) {
 		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
 			t.Parallel()
 
-			result, err := getToken(tt.md)
-			if tt.err {
-				assert.Error(t, err)
-			} else {
-				assert.NoError(t, err)
-				assert.Equal(t, tokenVal, result)
+			ctx := context.Background()
+			if tt.claims != nil {
+				ctx = authn.ContextWithClaims(ctx, tt.claims)
 			}
+			assert.Equal(t, tt.code, status.Code(checkScopes(ctx, method)))
 		})
 	}
 }
@@ -26,15 +26,21 @@ import (
 
 const Name = "clutch.middleware.authz"
 
+var Dependencies = service.Requires("clutch.service.authz")
+
 // List of method patterns that should never go through the authz engine.
 // TODO(maybe): convert this to an API annotation or make configurable on the authz middleware.
 var allowlist = []string{
-	"/clutch.authn.v1.AuthnAPI/*",
+	"/clutch.authn.v1.AuthnAPI/Login",
+	"/clutch.authn.v1.AuthnAPI/Callback",
+	"/clutch.authn.v1.AuthnAPI/Refresh",
+	"/clutch.authn.v1.AuthnAPI/Logout",
 	"/clutch.healthcheck.v1.HealthcheckAPI/*",
+	"/grpc.health.v1.Health/*",
 }
 
 func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (middleware.Middleware, error) {
-	svc, ok := service.Registry["clutch.service.authz"]
+	svc, ok := service.Registry.Get("clutch.service.authz")
 	if !ok {
 		return nil, errors.New("unable to get authz service")
 	}
@@ -67,45 +73,24 @@ func (m *mid) evaluate(ctx context.Context, r *authzv1.CheckRequest) error {
 func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		// Never interfere with allowlisted flows.
-		for _, allow := range allowlist {
-			if middleware.MatchMethodOrResource(allow, info.FullMethod) {
-				return handler(ctx, req)
-			}
+		if allowed(info.FullMethod) {
+			return handler(ctx, req)
 		}
 
-		claims, err := authn.ClaimsFromContext(ctx)
+		subject, err := subjectFromContext(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		actionType := meta.GetAction(info.FullMethod)
 		resources := meta.ResourceNames(req.(descriptor.Message))
-
-		subject := &authzv1.Subject{
-			User:   claims.Subject,
-			Groups: claims.Groups,
-		}
-
 		if len(resources) == 0 {
-			check := &authzv1.CheckRequest{
-				Subject:    subject,
-				Method:     info.FullMethod,
-				ActionType: actionType,
-			}
-			if err := m.evaluate(ctx, check); err != nil {
+			if err := m.authorize(ctx, subject, info.FullMethod, ""); err != nil {
 				return nil, err
 			}
 		}
 
 		for _, resource := range resources {
-			check := &authzv1.CheckRequest{
-				Subject:    subject,
-				Method:     info.FullMethod,
-				ActionType: actionType,
-				Resource:   resource.Id,
-			}
-
-			if err := m.evaluate(ctx, check); err != nil {
+			if err := m.authorize(ctx, subject, info.FullMethod, resource.Id); err != nil {
 				return nil, err
 			}
 		}
@@ -113,3 +98,82 @@ func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 		return handler(ctx, req)
 	}
 }
+
+// Streams are authorized for the method when they are opened, and each message received on the stream is then checked
+// against the resources it references.
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		// Never interfere with allowlisted flows.
+		if allowed(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		subject, err := subjectFromContext(ss.Context())
+		if err != nil {
+			return err
+		}
+
+		if err := m.authorize(ss.Context(), subject, info.FullMethod, ""); err != nil {
+			return err
+		}
+
+		return handler(srv, &authorizedStream{ServerStream: ss, m: m, subject: subject, fullMethod: info.FullMethod})
+	}
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+
+	m          *mid
+	subject    *authzv1.Subject
+	fullMethod string
+}
+
+func (s *authorizedStream) RecvMsg(msg interface{}) error {
+	if err := s.ServerStream.RecvMsg(msg); err != nil {
+		return err
+	}
+
+	dm, ok := msg.(descriptor.Message)
+	if !ok {
+		return nil
+	}
+
+	for _, resource := range meta.ResourceNames(dm) {
+		if err := s.m.authorize(s.Context(), s.subject, s.fullMethod, resource.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func allowed(fullMethod string) bool {
+	for _, allow := range allowlist {
+		if middleware.MatchMethodOrResource(allow, fullMethod) {
+			return true
+		}
+	}
+	return false
+}
+
+func subjectFromContext(ctx context.Context) (*authzv1.Subject, error) {
+	claims, err := authn.ClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authzv1.Subject{
+		User:   claims.Subject,
+		Groups: claims.Groups,
+	}, nil
+}
+
+// authorize checks access to the method, and to the resource if one is given.
+func (m *mid) authorize(ctx context.Context, subject *authzv1.Subject, fullMethod string, resource string) error {
+	return m.evaluate(ctx, &authzv1.CheckRequest{
+		Subject:    subject,
+		Method:     fullMethod,
+		ActionType: meta.GetAction(fullMethod),
+		Resource:   resource,
+	})
+}
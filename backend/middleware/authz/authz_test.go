@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc"
 
 	authzv1 "github.com/lyft/clutch/backend/api/authz/v1"
+	ec2v1 "github.com/lyft/clutch/backend/api/aws/ec2/v1"
 	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
 	"github.com/lyft/clutch/backend/middleware"
 	"github.com/lyft/clutch/backend/mock/service/authzmock"
@@ -22,7 +23,10 @@ func newWithMock(c authz.Client) (middleware.Middleware, error) {
 	if c == nil {
 		c = authzmock.New()
 	}
-	service.Registry["clutch.service.authz"] = c
+	service.Registry.Reset()
+	if err := service.Registry.Register("clutch.service.authz", c); err != nil {
+		return nil, err
+	}
 	return New(nil, nil, nil)
 }
 
@@ -57,7 +61,7 @@ func TestWhitelist(t *testing.T) {
 	ctx := context.Background()
 
 	req := &healthcheckv1.HealthcheckRequest{}
-	info := &grpc.UnaryServerInfo{FullMethod: "/clutch.authn.v1.AuthnAPI/FooBar"}
+	info := &grpc.UnaryServerInfo{FullMethod: "/clutch.authn.v1.AuthnAPI/Login"}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return &healthcheckv1.HealthcheckResponse{}, nil
 	}
@@ -107,3 +111,59 @@ func TestNoResources(t *testing.T) {
 	assert.Equal(t, claims.Subject, s.lastSubject.User)
 	assert.EqualValues(t, claims.Groups, s.lastSubject.Groups)
 }
+
+type streamMock struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *streamMock) Context() context.Context {
+	return s.ctx
+}
+
+func (s *streamMock) RecvMsg(m interface{}) error {
+	req := m.(*ec2v1.TerminateInstanceRequest)
+	req.InstanceId = "i-123"
+	req.Region = "us-east-1"
+	return nil
+}
+
+func TestStreamNoClaims(t *testing.T) {
+	s := &svcMock{}
+	m, _ := newWithMock(s)
+	interceptor := m.StreamInterceptor()
+
+	info := &grpc.StreamServerInfo{FullMethod: "/clutch.foo/Bar"}
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		return nil
+	}
+
+	err := interceptor(nil, &streamMock{ctx: context.Background()}, info, handler)
+	assert.Error(t, err)
+	assert.EqualValues(t, 0, s.called)
+}
+
+func TestStreamResources(t *testing.T) {
+	s := &svcMock{}
+	m, _ := newWithMock(s)
+	interceptor := m.StreamInterceptor()
+
+	claims := &authn.Claims{
+		StandardClaims: &jwt.StandardClaims{Subject: "foo@example.com"},
+	}
+	ctx := authn.ContextWithClaims(context.Background(), claims)
+	info := &grpc.StreamServerInfo{FullMethod: "/clutch.foo/Bar"}
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		// The method is checked when the stream is opened.
+		assert.EqualValues(t, 1, s.called)
+
+		// Each message received is checked against its resources.
+		assert.NoError(t, ss.RecvMsg(&ec2v1.TerminateInstanceRequest{}))
+		assert.EqualValues(t, 2, s.called)
+		return nil
+	}
+
+	err := interceptor(nil, &streamMock{ctx: ctx}, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, claims.Subject, s.lastSubject.User)
+}
@@ -0,0 +1,173 @@
+package maintenance
+
+// <!-- START clutchdoc -->
+// description: Rejects requests that make changes while maintenance mode is enabled.
+// <!-- END clutchdoc -->
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
+	maintenancev1 "github.com/lyft/clutch/backend/api/config/middleware/maintenance/v1"
+	"github.com/lyft/clutch/backend/gateway/meta"
+	"github.com/lyft/clutch/backend/middleware"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/authn"
+	maintenanceservice "github.com/lyft/clutch/backend/service/maintenance"
+)
+
+const Name = "clutch.middleware.maintenance"
+
+var Dependencies = service.Requires(maintenanceservice.Name)
+
+// The maintenance API is always allowed so that maintenance mode can be disabled, as are logging in and out and changing
+// the log level to debug an incident.
+var allowlist = []string{
+	"/clutch.maintenance.v1.MaintenanceAPI/*",
+	"/clutch.gateway.v1.GatewayAPI/UpdateLogLevel",
+	"/clutch.authn.v1.AuthnAPI/Callback",
+	"/clutch.authn.v1.AuthnAPI/Refresh",
+	"/clutch.authn.v1.AuthnAPI/Logout",
+}
+
+func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (middleware.Middleware, error) {
+	config := &maintenancev1.Config{}
+	if cfg != nil {
+		if err := ptypes.UnmarshalAny(cfg, config); err != nil {
+			return nil, err
+		}
+	}
+
+	svc, ok := service.Registry.Get(maintenanceservice.Name)
+	if !ok {
+		return nil, errors.New("unable to get maintenance service")
+	}
+
+	state, ok := svc.(maintenanceservice.Service)
+	if !ok {
+		return nil, errors.New("maintenance service was not the correct type")
+	}
+
+	return &mid{
+		state:  state,
+		scope:  scope.SubScope("maintenance"),
+		config: config,
+	}, nil
+}
+
+type mid struct {
+	state maintenanceservice.Service
+	scope tally.Scope
+
+	// Guards the config, which is replaced when the middleware is reconfigured.
+	mu     sync.RWMutex
+	config *maintenancev1.Config
+}
+
+// Reconfigure replaces the exemptions.
+func (m *mid) Reconfigure(cfg *any.Any) (func(), error) {
+	config := &maintenancev1.Config{}
+	if cfg != nil {
+		if err := ptypes.UnmarshalAny(cfg, config); err != nil {
+			return nil, err
+		}
+	}
+
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.config = config
+	}, nil
+}
+
+func mutating(action apiv1.ActionType) bool {
+	switch action {
+	case apiv1.ActionType_CREATE, apiv1.ActionType_UPDATE, apiv1.ActionType_DELETE:
+		return true
+	default:
+		return false
+	}
+}
+
+func (m *mid) exempt(ctx context.Context, fullMethod string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if matchAny(allowlist, fullMethod) || matchAny(m.config.ExemptMethods, fullMethod) {
+		return true
+	}
+
+	if len(m.config.ExemptGroups) == 0 {
+		return false
+	}
+	claims, err := authn.ClaimsFromContext(ctx)
+	if err != nil {
+		return false
+	}
+	for _, group := range claims.Groups {
+		for _, exempt := range m.config.ExemptGroups {
+			if group == exempt {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchAny(patterns []string, fullMethod string) bool {
+	for _, pattern := range patterns {
+		if middleware.MatchMethodOrResource(pattern, fullMethod) {
+			return true
+		}
+	}
+	return false
+}
+
+// check returns an Unavailable error if maintenance mode is enabled and the method makes changes.
+func (m *mid) check(ctx context.Context, fullMethod string) error {
+	state := m.state.Get()
+	if !state.Enabled || !mutating(meta.GetAction(fullMethod)) || m.exempt(ctx, fullMethod) {
+		return nil
+	}
+
+	service, method, _ := middleware.SplitFullMethod(fullMethod)
+	m.scope.Tagged(map[string]string{
+		"grpc_service": service,
+		"grpc_method":  method,
+	}).Counter("rejected").Inc(1)
+
+	msg := "Clutch is in maintenance mode and changes are disabled"
+	if state.Message != "" {
+		msg = fmt.Sprintf("%s: %s", msg, state.Message)
+	}
+	return status.Error(codes.Unavailable, msg)
+}
+
+func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := m.check(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := m.check(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,184 @@
+package maintenance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	maintenanceconfigv1 "github.com/lyft/clutch/backend/api/config/middleware/maintenance/v1"
+	gatewayv1 "github.com/lyft/clutch/backend/api/gateway/v1"
+	k8sv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+	maintenancev1 "github.com/lyft/clutch/backend/api/maintenance/v1"
+	"github.com/lyft/clutch/backend/gateway/meta"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/authn"
+	maintenanceservice "github.com/lyft/clutch/backend/service/maintenance"
+)
+
+type stateMock struct {
+	maintenanceservice.Service
+
+	state *maintenancev1.Maintenance
+}
+
+func (s *stateMock) Get() *maintenancev1.Maintenance { return s.state }
+
+func newWithMock(t *testing.T, state *maintenancev1.Maintenance, config *maintenanceconfigv1.Config) *mid {
+	s := grpc.NewServer()
+	k8sv1.RegisterK8SAPIServer(s, &k8sv1.UnimplementedK8SAPIServer{})
+	maintenancev1.RegisterMaintenanceAPIServer(s, &maintenancev1.UnimplementedMaintenanceAPIServer{})
+	gatewayv1.RegisterGatewayAPIServer(s, &gatewayv1.UnimplementedGatewayAPIServer{})
+	assert.NoError(t, meta.GenerateGRPCMetadata(s))
+
+	service.Registry.Reset()
+	assert.NoError(t, service.Registry.Register(maintenanceservice.Name, &stateMock{state: state}))
+
+	cfg, _ := ptypes.MarshalAny(config)
+	m, err := New(cfg, nil, tally.NewTestScope("", nil))
+	assert.NoError(t, err)
+	return m.(*mid)
+}
+
+func TestNew(t *testing.T) {
+	service.Registry.Reset()
+	defer service.Registry.Reset()
+
+	_, err := New(nil, nil, tally.NoopScope)
+	assert.EqualError(t, err, "unable to get maintenance service")
+
+	assert.NoError(t, service.Registry.Register(maintenanceservice.Name, &stateMock{}))
+	m, err := New(nil, nil, tally.NoopScope)
+	assert.NoError(t, err)
+	assert.NotNil(t, m)
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	defer service.Registry.Reset()
+
+	tests := []struct {
+		id       string
+		state    *maintenancev1.Maintenance
+		config   *maintenanceconfigv1.Config
+		method   string
+		groups   []string
+		expected error
+	}{
+		{
+			id:     "disabled",
+			state:  &maintenancev1.Maintenance{},
+			method: "/clutch.k8s.v1.K8sAPI/DeletePod",
+		},
+		{
+			id:     "read",
+			state:  &maintenancev1.Maintenance{Enabled: true},
+			method: "/clutch.k8s.v1.K8sAPI/DescribePod",
+		},
+		{
+			id:       "delete",
+			state:    &maintenancev1.Maintenance{Enabled: true},
+			method:   "/clutch.k8s.v1.K8sAPI/DeletePod",
+			expected: status.Error(codes.Unavailable, "Clutch is in maintenance mode and changes are disabled"),
+		},
+		{
+			id:       "message",
+			state:    &maintenancev1.Maintenance{Enabled: true, Message: "change freeze until Monday"},
+			method:   "/clutch.k8s.v1.K8sAPI/UpdatePod",
+			expected: status.Error(codes.Unavailable, "Clutch is in maintenance mode and changes are disabled: change freeze until Monday"),
+		},
+		{
+			id:     "maintenance api",
+			state:  &maintenancev1.Maintenance{Enabled: true},
+			method: "/clutch.maintenance.v1.MaintenanceAPI/UpdateMaintenance",
+		},
+		{
+			id:     "log level",
+			state:  &maintenancev1.Maintenance{Enabled: true},
+			method: "/clutch.gateway.v1.GatewayAPI/UpdateLogLevel",
+		},
+		{
+			id:     "exempt method",
+			state:  &maintenancev1.Maintenance{Enabled: true},
+			config: &maintenanceconfigv1.Config{ExemptMethods: []string{"/clutch.k8s.v1.K8sAPI/*"}},
+			method: "/clutch.k8s.v1.K8sAPI/DeletePod",
+		},
+		{
+			id:     "exempt group",
+			state:  &maintenancev1.Maintenance{Enabled: true},
+			config: &maintenanceconfigv1.Config{ExemptGroups: []string{"sre"}},
+			method: "/clutch.k8s.v1.K8sAPI/DeletePod",
+			groups: []string{"eng", "sre"},
+		},
+		{
+			id:       "other group",
+			state:    &maintenancev1.Maintenance{Enabled: true},
+			config:   &maintenanceconfigv1.Config{ExemptGroups: []string{"sre"}},
+			method:   "/clutch.k8s.v1.K8sAPI/DeletePod",
+			groups:   []string{"eng"},
+			expected: status.Error(codes.Unavailable, "Clutch is in maintenance mode and changes are disabled"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			config := tt.config
+			if config == nil {
+				config = &maintenanceconfigv1.Config{}
+			}
+			m := newWithMock(t, tt.state, config)
+
+			ctx := authn.ContextWithClaims(context.Background(), &authn.Claims{
+				StandardClaims: &jwt.StandardClaims{Subject: "foo@example.com"},
+				Groups:         tt.groups,
+			})
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			}
+
+			_, err := m.UnaryInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			assert.Equal(t, tt.expected, err)
+			assert.Equal(t, tt.expected == nil, called)
+		})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+}
+
+func (s *serverStream) Context() context.Context { return context.Background() }
+
+func TestStreamInterceptor(t *testing.T) {
+	defer service.Registry.Reset()
+
+	m := newWithMock(t, &maintenancev1.Maintenance{Enabled: true}, &maintenanceconfigv1.Config{})
+	handler := func(srv interface{}, stream grpc.ServerStream) error { return nil }
+
+	err := m.StreamInterceptor()(nil, &serverStream{}, &grpc.StreamServerInfo{FullMethod: "/clutch.k8s.v1.K8sAPI/DeletePod"}, handler)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	err = m.StreamInterceptor()(nil, &serverStream{}, &grpc.StreamServerInfo{FullMethod: "/clutch.k8s.v1.K8sAPI/DescribePod"}, handler)
+	assert.NoError(t, err)
+}
+
+func TestReconfigure(t *testing.T) {
+	defer service.Registry.Reset()
+
+	m := newWithMock(t, &maintenancev1.Maintenance{Enabled: true}, &maintenanceconfigv1.Config{})
+	assert.Error(t, m.check(context.Background(), "/clutch.k8s.v1.K8sAPI/DeletePod"))
+
+	cfg, _ := ptypes.MarshalAny(&maintenanceconfigv1.Config{ExemptMethods: []string{"/clutch.k8s.v1.K8sAPI/DeletePod"}})
+	apply, err := m.Reconfigure(cfg)
+	assert.NoError(t, err)
+	assert.Error(t, m.check(context.Background(), "/clutch.k8s.v1.K8sAPI/DeletePod"))
+	apply()
+	assert.NoError(t, m.check(context.Background(), "/clutch.k8s.v1.K8sAPI/DeletePod"))
+}
@@ -8,12 +8,26 @@ import (
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+
+	"github.com/lyft/clutch/backend/service"
 )
 
-type Factory map[string]func(*any.Any, *zap.Logger, tally.Scope) (Middleware, error)
+// Factory maps each middleware's name to its registration.
+type Factory map[string]Registration
+
+// Registration is how the gateway instantiates a middleware.
+type Registration struct {
+	New func(*any.Any, *zap.Logger, tally.Scope) (Middleware, error)
+
+	// Dependencies declares the services the middleware requires. It can be omitted if it doesn't require any.
+	Dependencies service.DependencyFunc
+}
 
+// Middleware intercepts both unary and streaming RPCs. Streaming interceptors should apply the same policy as their unary
+// counterparts, since streams that skip the chain also skip authentication, authorization, and auditing.
 type Middleware interface {
 	UnaryInterceptor() grpc.UnaryServerInterceptor
+	StreamInterceptor() grpc.StreamServerInterceptor
 }
 
 func SplitFullMethod(fullMethod string) (service string, method string, ok bool) {
@@ -0,0 +1,95 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Buckets that have refilled are removed at this interval, since they are equivalent to buckets that don't exist.
+const memorySweepInterval = time.Minute
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	rate   Rate
+}
+
+// refill adds the tokens accumulated since the bucket was last updated.
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.last)
+	b.last = now
+	b.tokens += float64(elapsed) / float64(b.rate.Interval) * float64(b.rate.Requests)
+	if b.tokens > float64(b.rate.Burst) {
+		b.tokens = float64(b.rate.Burst)
+	}
+}
+
+// memoryBackend stores buckets in memory, so limits apply to each gateway instance separately.
+type memoryBackend struct {
+	now func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+func newMemoryBackend() *memoryBackend {
+	return &memoryBackend{now: time.Now, buckets: make(map[string]*bucket)}
+}
+
+func (m *memoryBackend) Take(_ context.Context, key string, rate Rate) (bool, time.Duration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(rate.Burst), last: now}
+		m.buckets[key] = b
+	}
+	// The rate may have changed if the limit was reconfigured.
+	b.rate = rate
+	b.refill(now)
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0, nil
+	}
+	wait := time.Duration((1 - b.tokens) / float64(rate.Requests) * float64(rate.Interval))
+	return false, wait, nil
+}
+
+func (m *memoryBackend) Refund(_ context.Context, key string, rate Rate) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// A bucket that was swept has refilled, so there's nothing to return.
+	b, ok := m.buckets[key]
+	if !ok {
+		return nil
+	}
+	b.rate = rate
+	b.refill(m.now())
+	b.tokens++
+	if b.tokens > float64(rate.Burst) {
+		b.tokens = float64(rate.Burst)
+	}
+	return nil
+}
+
+func (m *memoryBackend) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < memorySweepInterval {
+		return
+	}
+	m.lastSweep = now
+
+	for key, b := range m.buckets {
+		b.refill(now)
+		if b.tokens >= float64(b.rate.Burst) {
+			delete(m.buckets, key)
+		}
+	}
+}
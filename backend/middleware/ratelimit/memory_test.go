@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryBackend(t *testing.T) {
+	now := time.Unix(0, 0)
+	m := newMemoryBackend()
+	m.now = func() time.Time { return now }
+	m.lastSweep = now
+
+	rate := Rate{Requests: 2, Interval: time.Second, Burst: 3}
+	ctx := context.Background()
+
+	// The bucket starts full.
+	for i := 0; i < 3; i++ {
+		ok, _, err := m.Take(ctx, "foo", rate)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	}
+	ok, wait, err := m.Take(ctx, "foo", rate)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, time.Millisecond*500, wait)
+
+	// Other buckets are unaffected.
+	ok, _, _ = m.Take(ctx, "bar", rate)
+	assert.True(t, ok)
+
+	// Tokens are added at the rate.
+	now = now.Add(time.Millisecond * 250)
+	ok, wait, _ = m.Take(ctx, "foo", rate)
+	assert.False(t, ok)
+	assert.Equal(t, time.Millisecond*250, wait)
+	now = now.Add(time.Millisecond * 250)
+	ok, _, _ = m.Take(ctx, "foo", rate)
+	assert.True(t, ok)
+
+	// Buckets don't fill beyond the burst.
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		ok, _, _ = m.Take(ctx, "foo", rate)
+		assert.True(t, ok)
+	}
+	ok, _, _ = m.Take(ctx, "foo", rate)
+	assert.False(t, ok)
+
+	// Refunded tokens can be taken again, up to the burst.
+	assert.NoError(t, m.Refund(ctx, "foo", rate))
+	ok, _, _ = m.Take(ctx, "foo", rate)
+	assert.True(t, ok)
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, m.Refund(ctx, "foo", rate))
+	}
+	assert.Equal(t, float64(rate.Burst), m.buckets["foo"].tokens)
+	assert.NoError(t, m.Refund(ctx, "missing", rate))
+
+	// Full buckets are removed when swept, which happened for "bar" when the time advanced.
+	assert.Len(t, m.buckets, 1)
+	now = now.Add(memorySweepInterval)
+	m.sweep(now)
+	assert.Empty(t, m.buckets)
+}
@@ -0,0 +1,308 @@
+package ratelimit
+
+// <!-- START clutchdoc -->
+// description: Limits the rate of requests by user, group, method, and action type using token buckets.
+// <!-- END clutchdoc -->
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
+	ratelimitv1 "github.com/lyft/clutch/backend/api/config/middleware/ratelimit/v1"
+	"github.com/lyft/clutch/backend/gateway/meta"
+	"github.com/lyft/clutch/backend/gateway/mux"
+	"github.com/lyft/clutch/backend/middleware"
+	"github.com/lyft/clutch/backend/requestid"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/authn"
+)
+
+const Name = "clutch.middleware.ratelimit"
+
+// Dependencies requires the backend service if one is configured.
+func Dependencies(cfg *any.Any) ([]string, error) {
+	config := &ratelimitv1.Config{}
+	if err := ptypes.UnmarshalAny(cfg, config); err != nil {
+		return nil, err
+	}
+	if config.BackendService == "" {
+		return nil, nil
+	}
+	return []string{config.BackendService}, nil
+}
+
+// Rate is the rate at which tokens are added to a bucket, and the maximum number of tokens it holds.
+type Rate struct {
+	Requests uint32
+	Interval time.Duration
+	Burst    uint32
+}
+
+// Backend stores token buckets. A shared store allows limits to apply across gateway instances, and can be provided by
+// a service that implements this interface.
+type Backend interface {
+	// Take removes a token from the bucket with the given key, creating a full bucket if it doesn't exist. If the bucket
+	// is empty, it returns false and how long until a token will be available.
+	Take(ctx context.Context, key string, rate Rate) (bool, time.Duration, error)
+	// Refund returns a token taken from the bucket with the given key, since requests rejected by another limit don't
+	// count against it.
+	Refund(ctx context.Context, key string, rate Rate) error
+}
+
+func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (middleware.Middleware, error) {
+	config := &ratelimitv1.Config{}
+	if err := ptypes.UnmarshalAny(cfg, config); err != nil {
+		return nil, err
+	}
+
+	var backend Backend = newMemoryBackend()
+	if config.BackendService != "" {
+		svc, ok := service.Registry.Get(config.BackendService)
+		if !ok {
+			return nil, fmt.Errorf("unable to get backend service '%s'", config.BackendService)
+		}
+		backend, ok = svc.(Backend)
+		if !ok {
+			return nil, fmt.Errorf("backend service '%s' does not implement the ratelimit backend interface", config.BackendService)
+		}
+	}
+
+	limits, err := newLimits(config)
+	if err != nil {
+		return nil, err
+	}
+	return &mid{
+		logger:         logger,
+		scope:          scope.SubScope("ratelimit"),
+		backend:        backend,
+		backendService: config.BackendService,
+		limits:         limits,
+	}, nil
+}
+
+type limit struct {
+	config *ratelimitv1.Limit
+	rate   Rate
+}
+
+type mid struct {
+	logger         *zap.Logger
+	scope          tally.Scope
+	backend        Backend
+	backendService string
+
+	mu     sync.RWMutex
+	limits []*limit
+}
+
+// newLimits validates the configuration, since a limit without requests or an interval can't be enforced, and creates
+// the limits from it.
+func newLimits(config *ratelimitv1.Config) ([]*limit, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	limits := make([]*limit, len(config.Limits))
+	for i, l := range config.Limits {
+		interval, err := ptypes.Duration(l.Interval)
+		if err != nil {
+			return nil, fmt.Errorf("limit '%s' has an invalid interval: %w", l.Name, err)
+		}
+		burst := l.Burst
+		if burst == 0 {
+			burst = l.Requests
+		}
+		limits[i] = &limit{config: l, rate: Rate{Requests: l.Requests, Interval: interval, Burst: burst}}
+	}
+	return limits, nil
+}
+
+// Reconfigure replaces the limits. Buckets in the backend are kept, so changing the rate of a limit doesn't reset it.
+func (m *mid) Reconfigure(cfg *any.Any) (func(), error) {
+	config := &ratelimitv1.Config{}
+	if err := ptypes.UnmarshalAny(cfg, config); err != nil {
+		return nil, err
+	}
+	if config.BackendService != m.backendService {
+		return nil, errors.New("changing the backend service requires a restart")
+	}
+	limits, err := newLimits(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.limits = limits
+	}, nil
+}
+
+func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := m.take(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Streams take a token when they are opened.
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := m.take(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// take removes a token from the bucket of every limit that applies to the request, and returns a ResourceExhausted
+// error if any of them are empty. Tokens taken before an empty bucket was found are refunded, so that requests rejected
+// by a narrow limit don't also drain broader ones. The backend failing doesn't fail the request.
+func (m *mid) take(ctx context.Context, fullMethod string) error {
+	user, groups := authn.AnonymousSubject, []string(nil)
+	if claims, err := authn.ClaimsFromContext(ctx); err == nil {
+		user, groups = claims.Subject, claims.Groups
+	}
+	action := meta.GetAction(fullMethod)
+
+	m.mu.RLock()
+	limits := m.limits
+	m.mu.RUnlock()
+
+	type taken struct {
+		limit *limit
+		key   string
+	}
+	var took []taken
+	var throttled *limit
+	var retryAfter time.Duration
+check:
+	for _, l := range limits {
+		for _, key := range l.keys(fullMethod, action, user, groups) {
+			ok, wait, err := m.backend.Take(ctx, key, l.rate)
+			if err != nil {
+				m.backendFailure(ctx, l, err)
+				continue
+			}
+			if !ok {
+				throttled, retryAfter = l, wait
+				break check
+			}
+			took = append(took, taken{limit: l, key: key})
+		}
+	}
+	if throttled == nil {
+		return nil
+	}
+
+	for _, t := range took {
+		if err := m.backend.Refund(ctx, t.key, t.limit.rate); err != nil {
+			m.backendFailure(ctx, t.limit, err)
+		}
+	}
+
+	svc, method, _ := middleware.SplitFullMethod(fullMethod)
+	m.scope.Tagged(map[string]string{
+		"limit":        throttled.config.Name,
+		"grpc_service": svc,
+		"grpc_method":  method,
+	}).Counter("throttled").Inc(1)
+
+	// Round up so that clients retrying after the header's number of seconds aren't throttled again.
+	seconds := int64((retryAfter + time.Second - 1) / time.Second)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(mux.RetryAfterMetadataKey, strconv.FormatInt(seconds, 10)))
+
+	s := status.New(codes.ResourceExhausted, fmt.Sprintf("rate limit '%s' exceeded", throttled.config.Name))
+	if ds, err := s.WithDetails(&errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(retryAfter)}); err == nil {
+		s = ds
+	}
+	return s.Err()
+}
+
+func (m *mid) backendFailure(ctx context.Context, l *limit, err error) {
+	m.scope.Counter("backend_failure").Inc(1)
+	requestid.Logger(ctx, m.logger).Warn("rate limit backend failed", zap.String("limit", l.config.Name), zap.Error(err))
+}
+
+// keys returns the keys of the buckets that the request takes a token from, or none if the limit doesn't apply.
+func (l *limit) keys(fullMethod string, action apiv1.ActionType, user string, groups []string) []string {
+	c := l.config
+	if c.Method != "" && !middleware.MatchMethodOrResource(c.Method, fullMethod) {
+		return nil
+	}
+	if len(c.ActionTypes) > 0 && !containsAction(c.ActionTypes, action) {
+		return nil
+	}
+
+	// Groups that the limit applies to, which are the buckets for the GROUP key.
+	matchedGroups := groups
+	if len(c.Users) > 0 || len(c.Groups) > 0 {
+		matchedGroups = intersect(groups, c.Groups)
+		if !contains(c.Users, user) && len(matchedGroups) == 0 {
+			return nil
+		}
+	}
+
+	prefix := c.Name + "/"
+	if c.PerMethod {
+		prefix += fullMethod + "/"
+	}
+
+	switch c.Key {
+	case ratelimitv1.Limit_GROUP:
+		ret := make([]string, len(matchedGroups))
+		for i, g := range matchedGroups {
+			ret[i] = prefix + "group:" + g
+		}
+		return ret
+	case ratelimitv1.Limit_GLOBAL:
+		return []string{prefix + "global"}
+	default:
+		return []string{prefix + "user:" + user}
+	}
+}
+
+func containsAction(l []apiv1.ActionType, action apiv1.ActionType) bool {
+	for _, a := range l {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(l []string, s string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func intersect(a, b []string) []string {
+	var ret []string
+	for _, v := range a {
+		if contains(b, v) {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,277 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
+	ratelimitv1 "github.com/lyft/clutch/backend/api/config/middleware/ratelimit/v1"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/authn"
+)
+
+func newConfig(t *testing.T, config *ratelimitv1.Config) *any.Any {
+	assert.NoError(t, config.Validate())
+	cfg, err := ptypes.MarshalAny(config)
+	assert.NoError(t, err)
+	return cfg
+}
+
+func newLimit(name string, requests uint32) *ratelimitv1.Limit {
+	return &ratelimitv1.Limit{Name: name, Requests: requests, Interval: ptypes.DurationProto(time.Minute)}
+}
+
+func contextWithClaims(user string, groups ...string) context.Context {
+	return authn.ContextWithClaims(context.Background(), &authn.Claims{
+		StandardClaims: &jwt.StandardClaims{Subject: user},
+		Groups:         groups,
+	})
+}
+
+func TestKeys(t *testing.T) {
+	const method = "/clutch.resolver.v1.ResolverAPI/Search"
+
+	testCases := []struct {
+		name   string
+		limit  *ratelimitv1.Limit
+		user   string
+		groups []string
+		keys   []string
+	}{
+		{
+			name:  "user",
+			limit: &ratelimitv1.Limit{Name: "l"},
+			user:  "alice",
+			keys:  []string{"l/user:alice"},
+		},
+		{
+			name:  "global per method",
+			limit: &ratelimitv1.Limit{Name: "l", Key: ratelimitv1.Limit_GLOBAL, PerMethod: true},
+			user:  "alice",
+			keys:  []string{"l/" + method + "/global"},
+		},
+		{
+			name:   "all groups",
+			limit:  &ratelimitv1.Limit{Name: "l", Key: ratelimitv1.Limit_GROUP},
+			user:   "alice",
+			groups: []string{"a", "b"},
+			keys:   []string{"l/group:a", "l/group:b"},
+		},
+		{
+			name:   "matched groups",
+			limit:  &ratelimitv1.Limit{Name: "l", Key: ratelimitv1.Limit_GROUP, Groups: []string{"b", "c"}},
+			user:   "alice",
+			groups: []string{"a", "b"},
+			keys:   []string{"l/group:b"},
+		},
+		{
+			name:   "unmatched group",
+			limit:  &ratelimitv1.Limit{Name: "l", Groups: []string{"c"}},
+			user:   "alice",
+			groups: []string{"a", "b"},
+		},
+		{
+			name:  "matched user",
+			limit: &ratelimitv1.Limit{Name: "l", Users: []string{"alice"}, Groups: []string{"c"}},
+			user:  "alice",
+			keys:  []string{"l/user:alice"},
+		},
+		{
+			name:  "matched method",
+			limit: &ratelimitv1.Limit{Name: "l", Method: "/clutch.resolver.v1.ResolverAPI/*"},
+			user:  "alice",
+			keys:  []string{"l/user:alice"},
+		},
+		{
+			name:  "unmatched method",
+			limit: &ratelimitv1.Limit{Name: "l", Method: "/clutch.k8s.v1.K8sAPI/*"},
+			user:  "alice",
+		},
+		{
+			name:  "matched action type",
+			limit: &ratelimitv1.Limit{Name: "l", ActionTypes: []apiv1.ActionType{apiv1.ActionType_READ}},
+			user:  "alice",
+			keys:  []string{"l/user:alice"},
+		},
+		{
+			name:  "unmatched action type",
+			limit: &ratelimitv1.Limit{Name: "l", ActionTypes: []apiv1.ActionType{apiv1.ActionType_DELETE}},
+			user:  "alice",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			l := &limit{config: tt.limit}
+			assert.Equal(t, tt.keys, l.keys(method, apiv1.ActionType_READ, tt.user, tt.groups))
+		})
+	}
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	m, err := New(newConfig(t, &ratelimitv1.Config{Limits: []*ratelimitv1.Limit{newLimit("search", 2)}}), zaptest.NewLogger(t), scope)
+	assert.NoError(t, err)
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/clutch.resolver.v1.ResolverAPI/Search"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	for i := 0; i < 2; i++ {
+		resp, err := m.UnaryInterceptor()(contextWithClaims("alice"), nil, info, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	}
+
+	_, err = m.UnaryInterceptor()(contextWithClaims("alice"), nil, info, handler)
+	s := status.Convert(err)
+	assert.Equal(t, codes.ResourceExhausted, s.Code())
+	if assert.Len(t, s.Details(), 1) {
+		retry := s.Details()[0].(*errdetails.RetryInfo)
+		d, err := ptypes.Duration(retry.RetryDelay)
+		assert.NoError(t, err)
+		assert.True(t, d > 0 && d <= time.Second*30)
+	}
+	assert.Equal(t, int64(1), scope.Snapshot().Counters()["ratelimit.throttled+grpc_method=Search,grpc_service=clutch.resolver.v1.ResolverAPI,limit=search"].Value())
+
+	// Other users have their own bucket.
+	_, err = m.UnaryInterceptor()(contextWithClaims("bob"), nil, info, handler)
+	assert.NoError(t, err)
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	m, err := New(newConfig(t, &ratelimitv1.Config{Limits: []*ratelimitv1.Limit{newLimit("stream", 1)}}), zaptest.NewLogger(t), tally.NoopScope)
+	assert.NoError(t, err)
+
+	info := &grpc.StreamServerInfo{FullMethod: "/clutch.chaos.v1.RTDS/Stream"}
+	handler := func(srv interface{}, stream grpc.ServerStream) error { return nil }
+	ss := &serverStream{ctx: contextWithClaims("alice")}
+
+	assert.NoError(t, m.StreamInterceptor()(nil, ss, info, handler))
+	err = m.StreamInterceptor()(nil, ss, info, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestOverlappingLimits(t *testing.T) {
+	perMethod := newLimit("search", 1)
+	perMethod.Method = "/clutch.resolver.v1.ResolverAPI/Search"
+	m, err := New(newConfig(t, &ratelimitv1.Config{Limits: []*ratelimitv1.Limit{newLimit("user", 3), perMethod}}), zaptest.NewLogger(t), tally.NoopScope)
+	assert.NoError(t, err)
+
+	search := &grpc.UnaryServerInfo{FullMethod: "/clutch.resolver.v1.ResolverAPI/Search"}
+	other := &grpc.UnaryServerInfo{FullMethod: "/clutch.k8s.v1.K8sAPI/DescribePod"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+
+	_, err = m.UnaryInterceptor()(contextWithClaims("alice"), nil, search, handler)
+	assert.NoError(t, err)
+
+	// Requests rejected by the per-method limit don't take from the per-user limit.
+	for i := 0; i < 5; i++ {
+		_, err = m.UnaryInterceptor()(contextWithClaims("alice"), nil, search, handler)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	}
+	for i := 0; i < 2; i++ {
+		_, err = m.UnaryInterceptor()(contextWithClaims("alice"), nil, other, handler)
+		assert.NoError(t, err)
+	}
+	_, err = m.UnaryInterceptor()(contextWithClaims("alice"), nil, other, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context { return s.ctx }
+
+func TestReconfigure(t *testing.T) {
+	m, err := New(newConfig(t, &ratelimitv1.Config{Limits: []*ratelimitv1.Limit{newLimit("a", 1)}}), zaptest.NewLogger(t), tally.NoopScope)
+	assert.NoError(t, err)
+	r := m.(*mid)
+
+	apply, err := r.Reconfigure(newConfig(t, &ratelimitv1.Config{Limits: []*ratelimitv1.Limit{newLimit("b", 5)}}))
+	assert.NoError(t, err)
+	assert.Equal(t, "a", r.limits[0].config.Name)
+	apply()
+	assert.Equal(t, "b", r.limits[0].config.Name)
+	assert.Equal(t, Rate{Requests: 5, Interval: time.Minute, Burst: 5}, r.limits[0].rate)
+
+	_, err = r.Reconfigure(newConfig(t, &ratelimitv1.Config{Limits: []*ratelimitv1.Limit{newLimit("c", 1)}, BackendService: "clutch.service.redis"}))
+	assert.Error(t, err)
+	assert.Equal(t, "b", r.limits[0].config.Name)
+}
+
+func TestNewInvalidConfig(t *testing.T) {
+	// Configurations are validated, since a limit without requests or an interval would divide by zero.
+	for _, l := range []*ratelimitv1.Limit{
+		newLimit("requests", 0),
+		{Name: "interval", Requests: 1, Interval: ptypes.DurationProto(0)},
+	} {
+		cfg, err := ptypes.MarshalAny(&ratelimitv1.Config{Limits: []*ratelimitv1.Limit{l}})
+		assert.NoError(t, err)
+
+		_, err = New(cfg, zaptest.NewLogger(t), tally.NoopScope)
+		assert.Error(t, err, l.Name)
+
+		m, err := New(newConfig(t, &ratelimitv1.Config{Limits: []*ratelimitv1.Limit{newLimit("a", 1)}}), zaptest.NewLogger(t), tally.NoopScope)
+		assert.NoError(t, err)
+		_, err = m.(*mid).Reconfigure(cfg)
+		assert.Error(t, err, l.Name)
+	}
+}
+
+type failingBackend struct {
+	calls int
+}
+
+func (b *failingBackend) Take(context.Context, string, Rate) (bool, time.Duration, error) {
+	b.calls++
+	return false, 0, errors.New("unavailable")
+}
+
+func (b *failingBackend) Refund(context.Context, string, Rate) error {
+	return errors.New("unavailable")
+}
+
+func TestBackendService(t *testing.T) {
+	service.Registry.Reset()
+	defer service.Registry.Reset()
+
+	config := &ratelimitv1.Config{Limits: []*ratelimitv1.Limit{newLimit("a", 1)}, BackendService: "clutch.service.ratelimitstore"}
+	deps, err := Dependencies(newConfig(t, config))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"clutch.service.ratelimitstore"}, deps)
+
+	_, err = New(newConfig(t, config), zaptest.NewLogger(t), tally.NoopScope)
+	assert.Error(t, err)
+
+	backend := &failingBackend{}
+	assert.NoError(t, service.Registry.Register("clutch.service.ratelimitstore", backend))
+	m, err := New(newConfig(t, config), zaptest.NewLogger(t), tally.NoopScope)
+	assert.NoError(t, err)
+
+	// Requests are allowed if the backend fails.
+	info := &grpc.UnaryServerInfo{FullMethod: "/clutch.resolver.v1.ResolverAPI/Search"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	_, err = m.UnaryInterceptor()(contextWithClaims("alice"), nil, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, backend.calls)
+
+	deps, err = Dependencies(newConfig(t, &ratelimitv1.Config{Limits: config.Limits}))
+	assert.NoError(t, err)
+	assert.Empty(t, deps)
+}
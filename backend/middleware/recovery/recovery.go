@@ -0,0 +1,70 @@
+package recovery
+
+// <!-- START clutchdoc -->
+// description: Converts panics in handlers and middleware into internal errors instead of crashing the gateway.
+// <!-- END clutchdoc -->
+
+import (
+	"context"
+	"runtime/debug"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/lyft/clutch/backend/middleware"
+	"github.com/lyft/clutch/backend/requestid"
+)
+
+func New(logger *zap.Logger, scope tally.Scope) (middleware.Middleware, error) {
+	return &mid{
+		logger: logger,
+		scope:  scope.SubScope("recovery"),
+	}, nil
+}
+
+type mid struct {
+	logger *zap.Logger
+	scope  tally.Scope
+}
+
+// recover logs the panic with its stack trace and returns the error for the caller. The panic value is not returned
+// since it may contain internal details.
+func (m *mid) recover(ctx context.Context, fullMethod string, p interface{}) error {
+	service, method, _ := middleware.SplitFullMethod(fullMethod)
+	m.scope.Tagged(map[string]string{
+		"grpc_service": service,
+		"grpc_method":  method,
+	}).Counter("panic").Inc(1)
+
+	requestid.Logger(ctx, m.logger).Error("recovered from panic",
+		zap.String("fullMethod", fullMethod),
+		zap.Any("panic", p),
+		zap.ByteString("stack", debug.Stack()),
+	)
+	return status.Error(codes.Internal, "internal error")
+}
+
+func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				resp, err = nil, m.recover(ctx, info.FullMethod, p)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = m.recover(ss.Context(), info.FullMethod, p)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,68 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context { return s.ctx }
+
+func TestUnaryInterceptor(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	m, err := New(zaptest.NewLogger(t), scope)
+	assert.NoError(t, err)
+	info := &grpc.UnaryServerInfo{FullMethod: "/clutch.k8s.v1.K8sAPI/UpdateHPA"}
+
+	resp, err := m.UnaryInterceptor()(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		var p *int
+		return *p, nil
+	})
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.NotContains(t, err.Error(), "nil pointer")
+
+	counters := scope.Snapshot().Counters()
+	if assert.Len(t, counters, 1) {
+		for _, c := range counters {
+			assert.Equal(t, "recovery.panic", c.Name())
+			assert.Equal(t, int64(1), c.Value())
+			assert.Equal(t, "UpdateHPA", c.Tags()["grpc_method"])
+		}
+	}
+
+	// Errors are passed through.
+	expected := errors.New("boom")
+	_, err = m.UnaryInterceptor()(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, expected
+	})
+	assert.Equal(t, expected, err)
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	m, err := New(zaptest.NewLogger(t), tally.NoopScope)
+	assert.NoError(t, err)
+	info := &grpc.StreamServerInfo{FullMethod: "/envoy.service.discovery.v2.RuntimeDiscoveryService/StreamRuntime"}
+
+	err = m.StreamInterceptor()(nil, &serverStream{ctx: context.Background()}, info, func(srv interface{}, ss grpc.ServerStream) error {
+		panic("boom")
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	err = m.StreamInterceptor()(nil, &serverStream{ctx: context.Background()}, info, func(srv interface{}, ss grpc.ServerStream) error {
+		return nil
+	})
+	assert.NoError(t, err)
+}
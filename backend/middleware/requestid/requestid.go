@@ -0,0 +1,65 @@
+package requestid
+
+// <!-- START clutchdoc -->
+// description: Accepts or generates an ID for each request and returns it in the X-Request-Id response header.
+// <!-- END clutchdoc -->
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/any"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/uber-go/tally"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/lyft/clutch/backend/middleware"
+	"github.com/lyft/clutch/backend/requestid"
+)
+
+const Name = "clutch.middleware.requestid"
+
+func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (middleware.Middleware, error) {
+	return &mid{}, nil
+}
+
+type mid struct{}
+
+// requestID returns the ID supplied by the caller in the request metadata if it is valid, otherwise a new ID.
+func requestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		// Headers from the JSON gateway are forwarded as metadata with the same name.
+		if values := md.Get(requestid.MetadataKey); len(values) > 0 && requestid.Valid(values[0]) {
+			return values[0]
+		}
+	}
+	return requestid.New()
+}
+
+func newContext(ctx context.Context) (context.Context, metadata.MD) {
+	id := requestID(ctx)
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("clutch.request_id", id))
+	return requestid.NewContext(ctx, id), metadata.Pairs(requestid.MetadataKey, id)
+}
+
+func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, md := newContext(ctx)
+		// Setting the header only fails if headers were already sent, which can't happen before the handler is called.
+		_ = grpc.SetHeader(ctx, md)
+		return handler(ctx, req)
+	}
+}
+
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, md := newContext(ss.Context())
+		_ = ss.SetHeader(md)
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
@@ -0,0 +1,102 @@
+package requestid
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/lyft/clutch/backend/requestid"
+)
+
+// transportStream records the headers set by the interceptor.
+type transportStream struct {
+	header metadata.MD
+}
+
+func (s *transportStream) Method() string { return "" }
+
+func (s *transportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *transportStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+func (s *transportStream) SetTrailer(metadata.MD) error    { return nil }
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+}
+
+func (s *serverStream) Context() context.Context { return s.ctx }
+
+func (s *serverStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	m, err := New(nil, zaptest.NewLogger(t), tally.NoopScope)
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		incoming string
+		valid    bool
+	}{
+		{name: "generated"},
+		{name: "accepted", incoming: "abc-123", valid: true},
+		{name: "invalid", incoming: "abc 123"},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.incoming != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(requestid.MetadataKey, tt.incoming))
+			}
+			stream := &transportStream{}
+			ctx = grpc.NewContextWithServerTransportStream(ctx, stream)
+
+			var id string
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				id = requestid.FromContext(ctx)
+				return nil, nil
+			}
+			_, err := m.UnaryInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/clutch.k8s.v1.K8sAPI/DescribePod"}, handler)
+			assert.NoError(t, err)
+
+			assert.True(t, requestid.Valid(id))
+			if tt.valid {
+				assert.Equal(t, tt.incoming, id)
+			} else {
+				assert.NotEqual(t, tt.incoming, id)
+			}
+			assert.Equal(t, []string{id}, stream.header.Get(requestid.MetadataKey))
+		})
+	}
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	m, err := New(nil, zaptest.NewLogger(t), tally.NoopScope)
+	assert.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestid.MetadataKey, "abc-123"))
+	ss := &serverStream{ctx: ctx}
+
+	var id string
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		id = requestid.FromContext(stream.Context())
+		return nil
+	}
+	assert.NoError(t, m.StreamInterceptor()(nil, ss, &grpc.StreamServerInfo{FullMethod: "/clutch.chaos.v1.RTDS/Stream"}, handler))
+	assert.Equal(t, "abc-123", id)
+	assert.Equal(t, []string{"abc-123"}, ss.header.Get(requestid.MetadataKey))
+}
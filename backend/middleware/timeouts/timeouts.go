@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -72,6 +73,29 @@ func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// Streams such as xDS are expected to stay open indefinitely, so the default timeout is not applied. A deadline is only
+// set if there is an explicit override for the method.
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		service, method, ok := middleware.SplitFullMethod(info.FullMethod)
+		if !ok {
+			m.logger.Warn("could not parse gRPC method", zap.String("fullMethod", info.FullMethod))
+		}
+
+		timeout, ok := m.overrides[join(service, method)]
+		if !ok {
+			return handler(srv, ss)
+		}
+
+		ctx, cancel := context.WithTimeout(ss.Context(), timeout)
+		defer cancel()
+
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
 func join(service, method string) string {
 	const pattern = "/%s/%s"
 	return fmt.Sprintf(pattern, service, method)
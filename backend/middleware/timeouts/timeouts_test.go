@@ -1,12 +1,14 @@
 package timeouts
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 
 	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
 )
@@ -31,3 +33,39 @@ func TestNew(t *testing.T) {
 		})
 	}
 }
+
+type streamMock struct {
+	grpc.ServerStream
+}
+
+func (s *streamMock) Context() context.Context {
+	return context.Background()
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	m, err := New(&gatewayv1.Timeouts{
+		Default: ptypes.DurationProto(time.Second),
+		Overrides: []*gatewayv1.Timeouts_Entry{
+			{Service: "clutch.foo.v1.FooAPI", Method: "Tail", Timeout: ptypes.DurationProto(time.Minute)},
+		},
+	}, nil, nil)
+	assert.NoError(t, err)
+	interceptor := m.StreamInterceptor()
+
+	// No deadline is applied by default.
+	err = interceptor(nil, &streamMock{}, &grpc.StreamServerInfo{FullMethod: "/clutch.foo.v1.FooAPI/Watch"}, func(srv interface{}, ss grpc.ServerStream) error {
+		_, ok := ss.Context().Deadline()
+		assert.False(t, ok)
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// Overrides apply to streams.
+	err = interceptor(nil, &streamMock{}, &grpc.StreamServerInfo{FullMethod: "/clutch.foo.v1.FooAPI/Tail"}, func(srv interface{}, ss grpc.ServerStream) error {
+		deadline, ok := ss.Context().Deadline()
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second*5)
+		return nil
+	})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,104 @@
+package tracing
+
+// <!-- START clutchdoc -->
+// description: Starts a span for each request, continuing the caller's trace if W3C trace context headers are present.
+// <!-- END clutchdoc -->
+
+import (
+	"context"
+	"strings"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/uber-go/tally"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	"github.com/lyft/clutch/backend/middleware"
+	"github.com/lyft/clutch/backend/tracing"
+)
+
+// New creates the tracer for the gateway and installs it for use by services. The returned middleware stops the
+// tracer, so it should be added to the gateway lifecycle before any other component.
+func New(config *gatewayv1.Tracing, logger *zap.Logger, scope tally.Scope) (middleware.Middleware, error) {
+	tracer, err := tracing.New(config, logger, scope)
+	if err != nil {
+		return nil, err
+	}
+	tracing.SetTracer(tracer)
+	return &mid{tracer: tracer}, nil
+}
+
+type mid struct {
+	tracer *tracing.Tracer
+}
+
+func (m *mid) Start(ctx context.Context) error { return m.tracer.Start(ctx) }
+func (m *mid) Stop(ctx context.Context) error  { return m.tracer.Stop(ctx) }
+
+// metadataCarrier reads trace context from gRPC metadata. Headers from the JSON gateway are forwarded as metadata with
+// the same name.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) { metadata.MD(c).Set(key, value) }
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (m *mid) startSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = tracing.Propagator.Extract(ctx, metadataCarrier(md))
+	}
+
+	service, method, _ := middleware.SplitFullMethod(fullMethod)
+	return m.tracer.StartSpan(ctx, strings.TrimPrefix(fullMethod, "/"),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(semconv.RPCSystemGRPC, semconv.RPCService(service), semconv.RPCMethod(method)),
+	)
+}
+
+func finishSpan(span trace.Span, err error) {
+	s := status.Convert(err)
+	span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int(int(s.Code())))
+	if err != nil {
+		span.SetStatus(codes.Error, s.Message())
+	}
+	span.End()
+}
+
+func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, span := m.startSpan(ctx, info.FullMethod)
+		resp, err := handler(ctx, req)
+		finishSpan(span, err)
+		return resp, err
+	}
+}
+
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := m.startSpan(ss.Context(), info.FullMethod)
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		err := handler(srv, wrapped)
+		finishSpan(span, err)
+		return err
+	}
+}
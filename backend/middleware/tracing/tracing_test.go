@@ -0,0 +1,107 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	"github.com/lyft/clutch/backend/tracing"
+)
+
+// memoryExporter keeps spans after the tracer is stopped, unlike the in-memory exporter it wraps.
+type memoryExporter struct {
+	*tracetest.InMemoryExporter
+}
+
+func (e *memoryExporter) Shutdown(context.Context) error { return nil }
+
+func newTestMiddleware(t *testing.T) (*mid, *memoryExporter) {
+	exporter := &memoryExporter{InMemoryExporter: tracetest.NewInMemoryExporter()}
+	m := &mid{tracer: tracing.NewWithExporter(exporter, "clutch", 1, zaptest.NewLogger(t), tally.NoopScope)}
+	assert.NoError(t, m.Start(context.Background()))
+	return m, exporter
+}
+
+func TestNew(t *testing.T) {
+	cfg := &gatewayv1.Tracing{
+		Exporter: &gatewayv1.Tracing_FileExporter_{FileExporter: &gatewayv1.Tracing_FileExporter{}},
+	}
+	assert.NoError(t, cfg.Validate())
+
+	m, err := New(cfg, zaptest.NewLogger(t), tally.NoopScope)
+	defer tracing.SetTracer(nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, m)
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	m, exporter := newTestMiddleware(t)
+
+	md := metadata.Pairs(
+		"traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"tracestate", "vendor=value",
+	)
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	info := &grpc.UnaryServerInfo{FullMethod: "/clutch.k8s.v1.K8sAPI/DescribePod"}
+	_, err := m.UnaryInterceptor()(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		assert.True(t, trace.SpanFromContext(ctx).IsRecording())
+		return nil, status.Error(codes.NotFound, "not found")
+	})
+	assert.Error(t, err)
+
+	assert.NoError(t, m.Stop(context.Background()))
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "clutch.k8s.v1.K8sAPI/DescribePod", span.Name)
+	assert.Equal(t, trace.SpanKindServer, span.SpanKind)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext.TraceID().String())
+	assert.Equal(t, "vendor=value", span.SpanContext.TraceState().String())
+	assert.Equal(t, "00f067aa0ba902b7", span.Parent.SpanID().String())
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("rpc.system", "grpc"),
+		attribute.String("rpc.service", "clutch.k8s.v1.K8sAPI"),
+		attribute.String("rpc.method", "DescribePod"),
+		attribute.Int("rpc.grpc.status_code", int(codes.NotFound)),
+	}, span.Attributes)
+	assert.Equal(t, otelcodes.Error, span.Status.Code)
+	assert.Equal(t, "not found", span.Status.Description)
+}
+
+type streamMock struct {
+	grpc.ServerStream
+}
+
+func (s *streamMock) Context() context.Context {
+	return context.Background()
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	m, exporter := newTestMiddleware(t)
+
+	info := &grpc.StreamServerInfo{FullMethod: "/clutch.foo.v1.FooAPI/Watch"}
+	err := m.StreamInterceptor()(nil, &streamMock{}, info, func(srv interface{}, ss grpc.ServerStream) error {
+		assert.True(t, trace.SpanFromContext(ss.Context()).IsRecording())
+		return nil
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, m.Stop(context.Background()))
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 1)
+	assert.Contains(t, spans[0].Attributes, attribute.Int("rpc.grpc.status_code", int(codes.OK)))
+	assert.Equal(t, otelcodes.Unset, spans[0].Status.Code)
+	assert.False(t, spans[0].Parent.IsValid())
+}
@@ -1,27 +1,243 @@
 package validate
 
 // <!-- START clutchdoc -->
-// description: Enforces input validation annotations from the proto definition on incoming requests.
+// description: Enforces input validation annotations from the proto definition on incoming requests, and checks responses against them.
 // <!-- END clutchdoc -->
 
 import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
-	validator "github.com/grpc-ecosystem/go-grpc-middleware/validator"
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
+	validatev1 "github.com/lyft/clutch/backend/api/config/middleware/validate/v1"
 	"github.com/lyft/clutch/backend/middleware"
+	"github.com/lyft/clutch/backend/requestid"
 )
 
 const Name = "clutch.middleware.validate"
 
 func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (middleware.Middleware, error) {
-	return &mid{}, nil
+	config := &validatev1.Config{}
+	if cfg != nil {
+		if err := ptypes.UnmarshalAny(cfg, config); err != nil {
+			return nil, err
+		}
+	}
+
+	return &mid{
+		logger:            logger,
+		scope:             scope.SubScope("validate"),
+		validateResponses: config.ResponseValidation == validatev1.Config_WARN,
+	}, nil
+}
+
+type mid struct {
+	logger            *zap.Logger
+	scope             tally.Scope
+	validateResponses bool
+}
+
+type validator interface {
+	Validate() error
+}
+
+// The errors generated by protoc-gen-validate for each message.
+type validationError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// validateRequest returns an InvalidArgument error with the field violation if the request fails validation.
+func validateRequest(req interface{}) error {
+	v, ok := req.(validator)
+	if !ok {
+		return nil
+	}
+	err := v.Validate()
+	if err == nil {
+		return nil
+	}
+
+	s := status.New(codes.InvalidArgument, err.Error())
+	if msg, ok := req.(proto.Message); ok {
+		violation := &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{fieldViolation(msg, err)},
+		}
+		if withDetails, err := s.WithDetails(violation); err == nil {
+			s = withDetails
+		}
+	}
+	return s.Err()
 }
 
-type mid struct{}
+// fieldViolation converts a validation error for the message into a violation with the path of the field as it is named
+// in JSON, e.g. `tls.certFile` or `containers[0].name`.
+func fieldViolation(msg proto.Message, err error) *errdetails.BadRequest_FieldViolation {
+	desc := proto.MessageReflect(msg).Descriptor()
+	var path []string
+	for {
+		var verr validationError
+		if !errors.As(err, &verr) {
+			return &errdetails.BadRequest_FieldViolation{
+				Field:       strings.Join(path, "."),
+				Description: err.Error(),
+			}
+		}
+
+		name, suffix := verr.Field(), ""
+		if i := strings.Index(name, "["); i >= 0 {
+			name, suffix = name[:i], name[i:]
+		}
+		fd := fieldByGoName(desc, name)
+		path = append(path, jsonName(desc, fd, name)+suffix)
+
+		var cause validationError
+		if fd == nil || !errors.As(verr.Cause(), &cause) {
+			description := verr.Reason()
+			if c := verr.Cause(); c != nil {
+				description += ": " + c.Error()
+			}
+			return &errdetails.BadRequest_FieldViolation{
+				Field:       strings.Join(path, "."),
+				Description: description,
+			}
+		}
+
+		// Continue with the embedded message, which is the value of map fields.
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		if fd.Message() == nil {
+			return &errdetails.BadRequest_FieldViolation{Field: strings.Join(path, "."), Description: verr.Reason()}
+		}
+		desc, err = fd.Message(), verr.Cause()
+	}
+}
+
+// fieldByGoName returns the field whose generated Go name is the given name, which is how protoc-gen-validate refers to
+// fields.
+func fieldByGoName(desc protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if goNameMatches(string(fields.Get(i).Name()), name) {
+			return fields.Get(i)
+		}
+	}
+	return nil
+}
+
+func jsonName(desc protoreflect.MessageDescriptor, fd protoreflect.FieldDescriptor, name string) string {
+	if fd != nil {
+		return fd.JSONName()
+	}
+	// Required oneofs are referred to by the name of the oneof, which doesn't appear in JSON but is still the most useful
+	// path to return.
+	oneofs := desc.Oneofs()
+	for i := 0; i < oneofs.Len(); i++ {
+		if o := oneofs.Get(i); goNameMatches(string(o.Name()), name) {
+			return lowerCamelCase(string(o.Name()))
+		}
+	}
+	return lowerCamelCase(name)
+}
+
+// Go names are the camel-cased proto names, so they match ignoring underscores and case.
+func goNameMatches(protoName, goName string) bool {
+	return strings.EqualFold(strings.ReplaceAll(protoName, "_", ""), goName)
+}
+
+func lowerCamelCase(s string) string {
+	var b strings.Builder
+	upper := false
+	for i, r := range s {
+		switch {
+		case r == '_':
+			upper = true
+		case upper:
+			b.WriteString(strings.ToUpper(string(r)))
+			upper = false
+		case i == 0:
+			b.WriteString(strings.ToLower(string(r)))
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// checkResponse logs responses that fail validation. They are still returned, since the caller is not at fault.
+func (m *mid) checkResponse(ctx context.Context, fullMethod string, resp interface{}) {
+	v, ok := resp.(validator)
+	if !ok {
+		return
+	}
+	err := v.Validate()
+	if err == nil {
+		return
+	}
+
+	service, method, _ := middleware.SplitFullMethod(fullMethod)
+	m.scope.Tagged(map[string]string{
+		"grpc_service": service,
+		"grpc_method":  method,
+	}).Counter("invalid_response").Inc(1)
+
+	fields := []zap.Field{zap.String("fullMethod", fullMethod), zap.Error(err)}
+	if msg, ok := resp.(proto.Message); ok {
+		fields = append(fields, zap.String("field", fieldViolation(msg, err).Field))
+	}
+	requestid.Logger(ctx, m.logger).Warn("response failed validation", fields...)
+}
 
 func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
-	return validator.UnaryServerInterceptor()
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := validateRequest(req); err != nil {
+			return nil, err
+		}
+		resp, err := handler(ctx, req)
+		if err == nil && m.validateResponses {
+			m.checkResponse(ctx, info.FullMethod, resp)
+		}
+		return resp, err
+	}
+}
+
+// Messages received on streams are validated as the handler receives them, so for client and bidirectional streams an
+// invalid message is rejected by the handler's call to Recv.
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, mid: m, fullMethod: info.FullMethod})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	mid        *mid
+	fullMethod string
+}
+
+func (s *serverStream) RecvMsg(msg interface{}) error {
+	if err := s.ServerStream.RecvMsg(msg); err != nil {
+		return err
+	}
+	return validateRequest(msg)
+}
+
+func (s *serverStream) SendMsg(msg interface{}) error {
+	if s.mid.validateResponses {
+		s.mid.checkResponse(s.Context(), s.fullMethod, msg)
+	}
+	return s.ServerStream.SendMsg(msg)
 }
@@ -0,0 +1,100 @@
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	validatev1 "github.com/lyft/clutch/backend/api/config/middleware/validate/v1"
+	k8sv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+)
+
+func TestFieldViolation(t *testing.T) {
+	listener := &gatewayv1.Listener{Socket: &gatewayv1.Listener_Tcp{Tcp: &gatewayv1.TCPSocket{Address: "0.0.0.0", Port: 8080}}}
+
+	testCases := []struct {
+		name  string
+		msg   validator
+		field string
+	}{
+		{name: "field", msg: &k8sv1.DescribePodRequest{Name: "envoy"}, field: "clientset"},
+		{
+			name:  "nested oneof",
+			msg:   &gatewayv1.Config{Gateway: &gatewayv1.GatewayOptions{Listener: &gatewayv1.Listener{}}},
+			field: "gateway.listener.socket",
+		},
+		{
+			name: "repeated",
+			msg: &gatewayv1.Config{
+				Gateway:         &gatewayv1.GatewayOptions{Listener: listener, Logger: &gatewayv1.Logger{}, Stats: &gatewayv1.Stats{}},
+				SecretProviders: []*gatewayv1.SecretProvider{{}},
+			},
+			field: "secretProviders[0].name",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRequest(tt.msg)
+			s := status.Convert(err)
+			assert.Equal(t, codes.InvalidArgument, s.Code())
+			if assert.Len(t, s.Details(), 1) {
+				violations := s.Details()[0].(*errdetails.BadRequest).FieldViolations
+				if assert.Len(t, violations, 1) {
+					assert.Equal(t, tt.field, violations[0].Field)
+					assert.NotEmpty(t, violations[0].Description)
+				}
+			}
+		})
+	}
+
+	assert.NoError(t, validateRequest(&k8sv1.DescribePodRequest{Clientset: "core", Cluster: "core", Namespace: "envoy", Name: "envoy"}))
+}
+
+func TestResponseValidation(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &k8sv1.DescribePodRequest{}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/clutch.k8s.v1.K8sAPI/DescribePod"}
+
+	testCases := []struct {
+		name   string
+		config *validatev1.Config
+		logs   int
+	}{
+		{name: "default", config: &validatev1.Config{}},
+		{name: "warn", config: &validatev1.Config{ResponseValidation: validatev1.Config_WARN}, logs: 1},
+		{name: "disabled", config: &validatev1.Config{ResponseValidation: validatev1.Config_DISABLED}},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			core, logs := observer.New(zapcore.WarnLevel)
+			cfg, err := ptypes.MarshalAny(tt.config)
+			assert.NoError(t, err)
+			m, err := New(cfg, zap.New(core), tally.NoopScope)
+			assert.NoError(t, err)
+
+			resp, err := m.UnaryInterceptor()(context.Background(), nil, info, handler)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.logs, logs.Len())
+			if tt.logs > 0 {
+				assert.Equal(t, "clientset", logs.All()[0].ContextMap()["field"])
+			}
+		})
+	}
+}
@@ -18,13 +18,14 @@ import (
 	"github.com/lyft/clutch/backend/service/k8s"
 )
 
+// Mocks don't depend on other services, so they don't declare the dependencies of the services they replace.
 var MockServiceFactory = service.Factory{
-	aws.Name:             awsmock.NewAsService,
-	audit.Name:           auditmock.NewAsService,
-	envoyadmin.Name:      envoyadminmock.NewAsService,
-	experimentstore.Name: experimentstoremock.NewMock,
-	github.Name:          githubmock.NewAsService,
-	k8s.Name:             k8smock.NewAsService,
+	aws.Name:             {New: awsmock.NewAsService},
+	audit.Name:           {New: auditmock.NewAsService},
+	envoyadmin.Name:      {New: envoyadminmock.NewAsService},
+	experimentstore.Name: {New: experimentstoremock.NewMock},
+	github.Name:          {New: githubmock.NewAsService},
+	k8s.Name:             {New: k8smock.NewAsService},
 }
 
 func main() {
@@ -23,8 +23,10 @@ import (
 
 const Name = "clutch.module.audit"
 
+var Dependencies = service.Requires("clutch.service.audit")
+
 func New(*any.Any, *zap.Logger, tally.Scope) (module.Module, error) {
-	auditClient, ok := service.Registry["clutch.service.audit"]
+	auditClient, ok := service.Registry.Get("clutch.service.audit")
 	if !ok {
 		return nil, errors.New("could not find service")
 	}
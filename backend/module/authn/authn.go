@@ -1,7 +1,7 @@
 package authn
 
 // <!-- START clutchdoc -->
-// description: Registers login and callback endpoints for OAuth 2 flows.
+// description: Registers login, callback, refresh and logout endpoints for OAuth 2 flows, and endpoints to manage long-lived API tokens.
 // <!-- END clutchdoc -->
 
 import (
@@ -9,22 +9,28 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	authnv1 "github.com/lyft/clutch/backend/api/authn/v1"
 	"github.com/lyft/clutch/backend/module"
+	"github.com/lyft/clutch/backend/requestid"
 	"github.com/lyft/clutch/backend/service"
 	"github.com/lyft/clutch/backend/service/authn"
 )
 
 const Name = "clutch.module.authn"
 
-func New(*any.Any, *zap.Logger, tally.Scope) (module.Module, error) {
-	svc, ok := service.Registry["clutch.service.authn"]
+var Dependencies = service.Requires("clutch.service.authn")
+
+func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (module.Module, error) {
+	svc, ok := service.Registry.Get("clutch.service.authn")
 	if !ok {
 		return nil, errors.New("unable to get authn service")
 	}
@@ -34,8 +40,12 @@ func New(*any.Any, *zap.Logger, tally.Scope) (module.Module, error) {
 		return nil, errors.New("authn service was not the correct type")
 	}
 
+	// Providers that can't issue API tokens or renew sessions still handle logins.
+	tokens, _ := svc.(authn.TokenIssuer)
+	sessions, _ := svc.(authn.SessionProvider)
+
 	return &mod{
-		authnv1: &api{svc: p},
+		authnv1: &api{svc: p, tokens: tokens, sessions: sessions, logger: logger},
 	}, nil
 }
 
@@ -49,7 +59,10 @@ func (m *mod) Register(r module.Registrar) error {
 }
 
 type api struct {
-	svc authn.Provider
+	svc      authn.Provider
+	tokens   authn.TokenIssuer
+	sessions authn.SessionProvider
+	logger   *zap.Logger
 }
 
 func (a *api) Login(ctx context.Context, request *authnv1.LoginRequest) (*authnv1.LoginResponse, error) {
@@ -105,3 +118,94 @@ func (a *api) Callback(ctx context.Context, request *authnv1.CallbackRequest) (*
 		Token: token,
 	}, nil
 }
+
+func (a *api) Refresh(ctx context.Context, request *authnv1.RefreshRequest) (*authnv1.RefreshResponse, error) {
+	if a.sessions == nil {
+		return nil, status.Error(codes.Unimplemented, "authn provider does not support renewing sessions")
+	}
+
+	claims, err := authn.ClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	token, err := a.sessions.RefreshSession(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := grpc.SendHeader(ctx, metadata.Pairs("Set-Cookie-Token", token)); err != nil {
+		return nil, err
+	}
+	return &authnv1.RefreshResponse{Token: token}, nil
+}
+
+// Logout is allowed without a valid token, so that the cookie is cleared even if the session has already expired.
+func (a *api) Logout(ctx context.Context, request *authnv1.LogoutRequest) (*authnv1.LogoutResponse, error) {
+	claims, err := authn.ClaimsFromContext(ctx)
+	if err == nil && a.sessions != nil && claims.Subject != authn.AnonymousSubject {
+		if err := a.sessions.RevokeSession(ctx, claims); err != nil {
+			return nil, err
+		}
+		requestid.Logger(ctx, a.logger).Info("session ended", zap.String("sessionID", claims.Id), zap.String("subject", claims.Subject))
+	}
+
+	// An empty token clears the cookie.
+	if err := grpc.SendHeader(ctx, metadata.Pairs("Set-Cookie-Token", "")); err != nil {
+		return nil, err
+	}
+	return &authnv1.LogoutResponse{}, nil
+}
+
+func (a *api) CreateToken(ctx context.Context, request *authnv1.CreateTokenRequest) (*authnv1.CreateTokenResponse, error) {
+	if a.tokens == nil {
+		return nil, status.Error(codes.Unimplemented, "authn provider does not support API tokens")
+	}
+
+	ttl, err := ptypes.Duration(request.Ttl)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	token, accessToken, err := a.tokens.CreateToken(ctx, request.Name, request.Subject, request.Groups, request.Scopes, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	requestid.Logger(ctx, a.logger).Info("API token created",
+		zap.String("tokenID", token.Id),
+		zap.String("tokenName", token.Name),
+		zap.String("subject", token.Subject),
+		zap.String("createdBy", token.CreatedBy),
+	)
+	return &authnv1.CreateTokenResponse{Token: token, AccessToken: accessToken}, nil
+}
+
+func (a *api) ListTokens(ctx context.Context, request *authnv1.ListTokensRequest) (*authnv1.ListTokensResponse, error) {
+	if a.tokens == nil {
+		return nil, status.Error(codes.Unimplemented, "authn provider does not support API tokens")
+	}
+
+	tokens, err := a.tokens.ListTokens(ctx, request.Subject, request.IncludeRevoked)
+	if err != nil {
+		return nil, err
+	}
+	return &authnv1.ListTokensResponse{Tokens: tokens}, nil
+}
+
+func (a *api) RevokeToken(ctx context.Context, request *authnv1.RevokeTokenRequest) (*authnv1.RevokeTokenResponse, error) {
+	if a.tokens == nil {
+		return nil, status.Error(codes.Unimplemented, "authn provider does not support API tokens")
+	}
+
+	if err := a.tokens.RevokeToken(ctx, request.Id); err != nil {
+		return nil, err
+	}
+
+	revokedBy := "UNKNOWN"
+	if claims, err := authn.ClaimsFromContext(ctx); err == nil {
+		revokedBy = claims.Subject
+	}
+	requestid.Logger(ctx, a.logger).Info("API token revoked", zap.String("tokenID", request.Id), zap.String("revokedBy", revokedBy))
+	return &authnv1.RevokeTokenResponse{}, nil
+}
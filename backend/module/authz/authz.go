@@ -1,7 +1,7 @@
 package authz
 
 // <!-- START clutchdoc -->
-// description: Exposes an endpoint for RBAC policy checks on arbitrary resources and users.
+// description: Exposes an endpoint for RBAC policy checks on arbitrary resources and users, and endpoints to manage roles and role bindings stored in a database.
 // <!-- END clutchdoc -->
 
 import (
@@ -11,6 +11,8 @@ import (
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	authzv1 "github.com/lyft/clutch/backend/api/authz/v1"
 	"github.com/lyft/clutch/backend/module"
@@ -20,8 +22,10 @@ import (
 
 const Name = "clutch.module.authz"
 
+var Dependencies = service.Requires("clutch.service.authz")
+
 func New(*any.Any, *zap.Logger, tally.Scope) (module.Module, error) {
-	svc, ok := service.Registry["clutch.service.authz"]
+	svc, ok := service.Registry.Get("clutch.service.authz")
 	if !ok {
 		return nil, errors.New("unable to get authz service")
 	}
@@ -31,8 +35,11 @@ func New(*any.Any, *zap.Logger, tally.Scope) (module.Module, error) {
 		return nil, errors.New("authz service was not the correct type")
 	}
 
+	// Roles and role bindings can only be managed if they're stored in a database.
+	store, _ := svc.(authz.PolicyStore)
+
 	return &mod{
-		authzv1: &api{zclient: p},
+		authzv1: &api{zclient: p, store: store},
 	}, nil
 }
 
@@ -47,8 +54,120 @@ func (m *mod) Register(r module.Registrar) error {
 
 type api struct {
 	zclient authz.Client
+	// If nil, roles and role bindings are only read from the config.
+	store authz.PolicyStore
 }
 
+var errStoreDisabled = status.Error(codes.Unimplemented, "authz service does not store roles in a database")
+
 func (a *api) Check(ctx context.Context, request *authzv1.CheckRequest) (*authzv1.CheckResponse, error) {
 	return a.zclient.Check(ctx, request)
 }
+
+func (a *api) ListRoles(ctx context.Context, request *authzv1.ListRolesRequest) (*authzv1.ListRolesResponse, error) {
+	if a.store == nil {
+		return nil, errStoreDisabled
+	}
+	roles, err := a.store.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &authzv1.ListRolesResponse{Roles: roles}, nil
+}
+
+func (a *api) GetRole(ctx context.Context, request *authzv1.GetRoleRequest) (*authzv1.GetRoleResponse, error) {
+	if a.store == nil {
+		return nil, errStoreDisabled
+	}
+	role, err := a.store.GetRole(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &authzv1.GetRoleResponse{Role: role}, nil
+}
+
+func (a *api) CreateRole(ctx context.Context, request *authzv1.CreateRoleRequest) (*authzv1.CreateRoleResponse, error) {
+	if a.store == nil {
+		return nil, errStoreDisabled
+	}
+	role, err := a.store.CreateRole(ctx, request.Name, request.Policies)
+	if err != nil {
+		return nil, err
+	}
+	return &authzv1.CreateRoleResponse{Role: role}, nil
+}
+
+func (a *api) UpdateRole(ctx context.Context, request *authzv1.UpdateRoleRequest) (*authzv1.UpdateRoleResponse, error) {
+	if a.store == nil {
+		return nil, errStoreDisabled
+	}
+	role, err := a.store.UpdateRole(ctx, request.Name, request.Policies, request.Version)
+	if err != nil {
+		return nil, err
+	}
+	return &authzv1.UpdateRoleResponse{Role: role}, nil
+}
+
+func (a *api) DeleteRole(ctx context.Context, request *authzv1.DeleteRoleRequest) (*authzv1.DeleteRoleResponse, error) {
+	if a.store == nil {
+		return nil, errStoreDisabled
+	}
+	if err := a.store.DeleteRole(ctx, request.Name); err != nil {
+		return nil, err
+	}
+	return &authzv1.DeleteRoleResponse{}, nil
+}
+
+func (a *api) ListPolicyVersions(ctx context.Context, request *authzv1.ListPolicyVersionsRequest) (*authzv1.ListPolicyVersionsResponse, error) {
+	if a.store == nil {
+		return nil, errStoreDisabled
+	}
+	versions, err := a.store.ListPolicyVersions(ctx, request.RoleName)
+	if err != nil {
+		return nil, err
+	}
+	return &authzv1.ListPolicyVersionsResponse{Versions: versions}, nil
+}
+
+func (a *api) RollbackRole(ctx context.Context, request *authzv1.RollbackRoleRequest) (*authzv1.RollbackRoleResponse, error) {
+	if a.store == nil {
+		return nil, errStoreDisabled
+	}
+	role, err := a.store.RollbackRole(ctx, request.Name, request.Version)
+	if err != nil {
+		return nil, err
+	}
+	return &authzv1.RollbackRoleResponse{Role: role}, nil
+}
+
+func (a *api) ListRoleBindings(ctx context.Context, request *authzv1.ListRoleBindingsRequest) (*authzv1.ListRoleBindingsResponse, error) {
+	if a.store == nil {
+		return nil, errStoreDisabled
+	}
+	bindings, err := a.store.ListRoleBindings(ctx, request.RoleName)
+	if err != nil {
+		return nil, err
+	}
+	return &authzv1.ListRoleBindingsResponse{RoleBindings: bindings}, nil
+}
+
+func (a *api) CreateRoleBinding(ctx context.Context, request *authzv1.CreateRoleBindingRequest) (*authzv1.CreateRoleBindingResponse, error) {
+	if a.store == nil {
+		return nil, errStoreDisabled
+	}
+	binding, err := a.store.CreateRoleBinding(ctx, request.RoleName, request.Principal)
+	if err != nil {
+		return nil, err
+	}
+	return &authzv1.CreateRoleBindingResponse{RoleBinding: binding}, nil
+}
+
+func (a *api) DeleteRoleBinding(ctx context.Context, request *authzv1.DeleteRoleBindingRequest) (*authzv1.DeleteRoleBindingResponse, error) {
+	if a.store == nil {
+		return nil, errStoreDisabled
+	}
+	if err := a.store.DeleteRoleBinding(ctx, request.Id); err != nil {
+		return nil, err
+	}
+	return &authzv1.DeleteRoleBindingResponse{}, nil
+}
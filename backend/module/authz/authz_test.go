@@ -1,19 +1,24 @@
 package authz
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/uber-go/tally"
 	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	authzv1 "github.com/lyft/clutch/backend/api/authz/v1"
 	"github.com/lyft/clutch/backend/mock/service/authzmock"
 	"github.com/lyft/clutch/backend/module/moduletest"
 	"github.com/lyft/clutch/backend/service"
 )
 
 func TestModule(t *testing.T) {
-	service.Registry["clutch.service.authz"] = authzmock.New()
+	service.Registry.Reset()
+	assert.NoError(t, service.Registry.Register("clutch.service.authz", authzmock.New()))
 
 	log := zaptest.NewLogger(t)
 	scope := tally.NewTestScope("", nil)
@@ -26,3 +31,15 @@ func TestModule(t *testing.T) {
 	assert.NoError(t, r.HasAPI("clutch.authz.v1.AuthzAPI"))
 	assert.True(t, r.JSONRegistered())
 }
+
+func TestAPIWithoutPolicyStore(t *testing.T) {
+	a := &api{}
+	ctx := context.Background()
+
+	_, err := a.ListRoles(ctx, &authzv1.ListRolesRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	_, err = a.CreateRole(ctx, &authzv1.CreateRoleRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	_, err = a.CreateRoleBinding(ctx, &authzv1.CreateRoleBindingRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
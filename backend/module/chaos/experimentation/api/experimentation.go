@@ -23,6 +23,8 @@ const (
 	Name = "clutch.module.chaos.experimentation.api"
 )
 
+var Dependencies = service.Requires(experimentstore.Name)
+
 // Service contains all dependencies for the API service.
 type Service struct {
 	experimentStore             experimentstore.ExperimentStore
@@ -35,7 +37,7 @@ type Service struct {
 
 // New instantiates a Service object.
 func New(_ *any.Any, logger *zap.Logger, scope tally.Scope) (module.Module, error) {
-	store, ok := service.Registry[experimentstore.Name]
+	store, ok := service.Registry.Get(experimentstore.Name)
 	if !ok {
 		return nil, errors.New("could not find experiment store service")
 	}
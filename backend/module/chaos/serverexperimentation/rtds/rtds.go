@@ -28,6 +28,8 @@ import (
 
 const Name = "clutch.module.chaos.experimentation.rtds"
 
+var Dependencies = service.Requires(experimentstore.Name)
+
 // Server serves RTDS
 type Server struct {
 	ctx context.Context
@@ -51,6 +53,10 @@ type Server struct {
 	totalResourcesServed tally.Counter
 
 	logger *zap.SugaredLogger
+
+	// Used to stop the cache refresh loop.
+	cancel context.CancelFunc
+	done   chan struct{}
 }
 
 // ClusterHash implements NodeHash interface
@@ -76,7 +82,7 @@ func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (module.Module, er
 	}
 	rtdsLayerName := config.GetRtdsLayerName()
 
-	store, ok := service.Registry[experimentstore.Name]
+	store, ok := service.Registry.Get(experimentstore.Name)
 	if !ok {
 		return nil, errors.New("could not find experiment store service")
 	}
@@ -102,13 +108,37 @@ func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (module.Module, er
 }
 
 func (s *Server) Register(r module.Registrar) error {
-	PeriodicallyRefreshCache(s)
 	xdsServer := gcpServer.NewServer(s.ctx, s.snapshotCache, &callbacks{s.totalStreams,
 		s.totalResourcesServed, s.logger, 0})
 	gcpDiscovery.RegisterRuntimeDiscoveryServiceServer(r.GRPCServer(), xdsServer)
 	return nil
 }
 
+// Start refreshing the cache from the experiment store in the background.
+func (s *Server) Start(ctx context.Context) error {
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+	go func() {
+		defer close(s.done)
+		PeriodicallyRefreshCache(ctx, s)
+	}()
+	return nil
+}
+
+// Stop refreshing the cache and wait for an in-progress refresh to finish.
+func (s *Server) Stop(ctx context.Context) error {
+	if s.cancel == nil {
+		return nil
+	}
+	s.cancel()
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 type callbacks struct {
 	totalStreams         tally.Gauge
 	totalResourcesServed tally.Counter
@@ -31,14 +31,19 @@ const (
 	HTTPStatusWithDownstream           = `fault.http.%s.abort.http_status`
 )
 
-func PeriodicallyRefreshCache(s *Server) {
+// PeriodicallyRefreshCache blocks, refreshing the cache on the configured interval until the context is done.
+func PeriodicallyRefreshCache(ctx context.Context, s *Server) {
 	ticker := time.NewTicker(s.cacheRefreshInterval)
-	go func() {
-		for range ticker.C {
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
 			s.logger.Info("Refreshing RTDS cache")
-			refreshCache(s.ctx, s.experimentStore, s.snapshotCache, s.rtdsLayerName, s.logger)
+			refreshCache(ctx, s.experimentStore, s.snapshotCache, s.rtdsLayerName, s.logger)
 		}
-	}()
+	}
 }
 
 func refreshCache(ctx context.Context, store experimentstore.ExperimentStore, snapshotCache gcpCache.SnapshotCache, rtdsLayerName string,
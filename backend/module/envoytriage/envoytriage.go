@@ -22,8 +22,10 @@ const (
 	Name = "clutch.module.envoytriage"
 )
 
+var Dependencies = service.Requires("clutch.service.envoyadmin")
+
 func New(*any.Any, *zap.Logger, tally.Scope) (module.Module, error) {
-	client, ok := service.Registry["clutch.service.envoyadmin"]
+	client, ok := service.Registry.Get("clutch.service.envoyadmin")
 	if !ok {
 		return nil, errors.New("could not find service")
 	}
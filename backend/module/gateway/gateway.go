@@ -0,0 +1,227 @@
+package gateway
+
+// <!-- START clutchdoc -->
+// description: API describing the running gateway, i.e. its components, configuration, methods, and version, and adjusting the log levels of its components.
+// <!-- END clutchdoc -->
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gatewayconfigv1 "github.com/lyft/clutch/backend/api/config/module/gateway/v1"
+	gatewayv1 "github.com/lyft/clutch/backend/api/gateway/v1"
+	"github.com/lyft/clutch/backend/gateway/logging"
+	"github.com/lyft/clutch/backend/gateway/meta"
+	"github.com/lyft/clutch/backend/module"
+	"github.com/lyft/clutch/backend/requestid"
+	"github.com/lyft/clutch/backend/service/authn"
+	"github.com/lyft/clutch/backend/version"
+)
+
+const (
+	Name = "clutch.module.gateway"
+)
+
+func New(cfg *any.Any, logger *zap.Logger, _ tally.Scope) (module.Module, error) {
+	config := &gatewayconfigv1.Config{}
+	if cfg != nil {
+		if err := ptypes.UnmarshalAny(cfg, config); err != nil {
+			return nil, err
+		}
+	}
+
+	mod := &mod{
+		api: newAPI(config, logger),
+	}
+	return mod, nil
+}
+
+type mod struct {
+	api gatewayv1.GatewayAPIServer
+}
+
+func (m *mod) Register(r module.Registrar) error {
+	gatewayv1.RegisterGatewayAPIServer(r.GRPCServer(), m.api)
+	return r.RegisterJSONGateway(gatewayv1.RegisterGatewayAPIHandler)
+}
+
+func newAPI(config *gatewayconfigv1.Config, logger *zap.Logger) gatewayv1.GatewayAPIServer {
+	return &gatewayAPI{config: config, logger: logger}
+}
+
+type gatewayAPI struct {
+	config *gatewayconfigv1.Config
+	logger *zap.Logger
+}
+
+// The gateway sets its information once all modules are registered, so it is only missing if requests are served early.
+func gatewayInfo() (*meta.GatewayInfo, error) {
+	info := meta.GetGatewayInfo()
+	if info == nil {
+		return nil, status.Error(codes.Unavailable, "gateway information is not available yet")
+	}
+	return info, nil
+}
+
+func components(l []meta.Component) []*gatewayv1.Component {
+	ret := make([]*gatewayv1.Component, len(l))
+	for i, c := range l {
+		ret[i] = &gatewayv1.Component{Name: c.Name, TypeUrl: c.TypeURL}
+	}
+	return ret
+}
+
+func (a *gatewayAPI) GetComponents(context.Context, *gatewayv1.GetComponentsRequest) (*gatewayv1.GetComponentsResponse, error) {
+	info, err := gatewayInfo()
+	if err != nil {
+		return nil, err
+	}
+	return &gatewayv1.GetComponentsResponse{
+		Services:   components(info.Services),
+		Resolvers:  components(info.Resolvers),
+		Middleware: components(info.Middleware),
+		Modules:    components(info.Modules),
+	}, nil
+}
+
+func (a *gatewayAPI) GetConfig(context.Context, *gatewayv1.GetConfigRequest) (*gatewayv1.GetConfigResponse, error) {
+	if !a.config.EnableConfig {
+		return nil, status.Error(codes.PermissionDenied, "serving the configuration is disabled, see 'enable_config' in the module's configuration")
+	}
+
+	info, err := gatewayInfo()
+	if err != nil {
+		return nil, err
+	}
+	if info.Config == nil {
+		return nil, status.Error(codes.Unavailable, "configuration could not be converted, see the gateway logs for details")
+	}
+	return &gatewayv1.GetConfigResponse{Config: info.Config}, nil
+}
+
+func (a *gatewayAPI) GetMethods(context.Context, *gatewayv1.GetMethodsRequest) (*gatewayv1.GetMethodsResponse, error) {
+	names := meta.Methods()
+	methods := make([]*gatewayv1.Method, len(names))
+	for i, name := range names {
+		methods[i] = &gatewayv1.Method{Name: name, ActionType: meta.GetAction(name)}
+	}
+	return &gatewayv1.GetMethodsResponse{Methods: methods}, nil
+}
+
+func (a *gatewayAPI) GetVersion(context.Context, *gatewayv1.GetVersionRequest) (*gatewayv1.GetVersionResponse, error) {
+	return &gatewayv1.GetVersionResponse{
+		Version: &gatewayv1.Version{
+			Version:       version.Version,
+			ModuleVersion: version.ModuleVersion(),
+			GoVersion:     version.GoVersion(),
+		},
+	}, nil
+}
+
+var logLevels = map[zapcore.Level]gatewayv1.LogLevel_Level{
+	zapcore.DebugLevel: gatewayv1.LogLevel_DEBUG,
+	zapcore.InfoLevel:  gatewayv1.LogLevel_INFO,
+	zapcore.WarnLevel:  gatewayv1.LogLevel_WARN,
+	zapcore.ErrorLevel: gatewayv1.LogLevel_ERROR,
+}
+
+func levels() (*logging.Levels, error) {
+	l := logging.GetLevels()
+	if l == nil {
+		return nil, status.Error(codes.Unavailable, "log levels are not available yet")
+	}
+	return l, nil
+}
+
+func logLevel(l logging.Level) (*gatewayv1.LogLevel, error) {
+	ret := &gatewayv1.LogLevel{
+		Component:       l.Component,
+		Level:           logLevels[l.Level],
+		ConfiguredLevel: logLevels[l.ConfiguredLevel],
+	}
+	if !l.RevertTime.IsZero() {
+		revertTime, err := ptypes.TimestampProto(l.RevertTime)
+		if err != nil {
+			return nil, err
+		}
+		ret.RevertTime = revertTime
+	}
+	return ret, nil
+}
+
+func (a *gatewayAPI) GetLogLevels(context.Context, *gatewayv1.GetLogLevelsRequest) (*gatewayv1.GetLogLevelsResponse, error) {
+	l, err := levels()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gatewayv1.GetLogLevelsResponse{}
+	for _, level := range l.List() {
+		ll, err := logLevel(level)
+		if err != nil {
+			return nil, err
+		}
+		resp.LogLevels = append(resp.LogLevels, ll)
+	}
+	return resp, nil
+}
+
+func (a *gatewayAPI) UpdateLogLevel(ctx context.Context, req *gatewayv1.UpdateLogLevelRequest) (*gatewayv1.UpdateLogLevelResponse, error) {
+	if !a.config.EnableLogLevelUpdates {
+		return nil, status.Error(codes.PermissionDenied, "updating log levels is disabled, see 'enable_log_level_updates' in the module's configuration")
+	}
+
+	l, err := levels()
+	if err != nil {
+		return nil, err
+	}
+
+	var ttl time.Duration
+	if req.Ttl != nil {
+		if ttl, err = ptypes.Duration(req.Ttl); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var updated logging.Level
+	if req.Level == gatewayv1.LogLevel_UNSPECIFIED {
+		updated, err = l.Reset(req.Component)
+	} else {
+		var level zapcore.Level
+		if err := level.UnmarshalText([]byte(req.Level.String())); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		updated, err = l.Set(req.Component, level, ttl)
+	}
+	if errors.Is(err, logging.ErrUnknownComponent) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	username := "UNKNOWN"
+	if claims, err := authn.ClaimsFromContext(ctx); err == nil {
+		username = claims.Subject
+	}
+	requestid.Logger(ctx, a.logger).Info("log level updated",
+		zap.String("component", req.Component),
+		zap.Stringer("level", updated.Level),
+		zap.Duration("ttl", ttl),
+		zap.String("updatedBy", username),
+	)
+
+	ll, err := logLevel(updated)
+	if err != nil {
+		return nil, err
+	}
+	return &gatewayv1.UpdateLogLevelResponse{LogLevel: ll}, nil
+}
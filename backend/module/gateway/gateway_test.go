@@ -0,0 +1,118 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gatewayconfigv1 "github.com/lyft/clutch/backend/api/config/module/gateway/v1"
+	gatewayv1 "github.com/lyft/clutch/backend/api/gateway/v1"
+	"github.com/lyft/clutch/backend/gateway/logging"
+	"github.com/lyft/clutch/backend/gateway/meta"
+	"github.com/lyft/clutch/backend/module/moduletest"
+	"github.com/lyft/clutch/backend/version"
+)
+
+func TestModule(t *testing.T) {
+	log := zaptest.NewLogger(t)
+	scope := tally.NewTestScope("", nil)
+
+	m, err := New(nil, log, scope)
+	assert.NoError(t, err)
+
+	r := moduletest.NewRegisterChecker()
+	assert.NoError(t, m.Register(r))
+	assert.NoError(t, r.HasAPI("clutch.gateway.v1.GatewayAPI"))
+	assert.True(t, r.JSONRegistered())
+}
+
+func TestGetComponents(t *testing.T) {
+	api := newAPI(&gatewayconfigv1.Config{EnableConfig: true}, zaptest.NewLogger(t))
+
+	meta.SetGatewayInfo(&meta.GatewayInfo{
+		Services: []meta.Component{{Name: "clutch.service.github", TypeURL: "type.googleapis.com/clutch.config.service.github.v1.Config"}},
+		Middleware: []meta.Component{
+			{Name: "clutch.middleware.timeouts"},
+			{Name: "clutch.middleware.stats"},
+		},
+		Modules: []meta.Component{{Name: "clutch.module.gateway"}},
+	})
+
+	resp, err := api.GetComponents(context.Background(), &gatewayv1.GetComponentsRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Services, 1)
+	assert.Equal(t, "clutch.service.github", resp.Services[0].Name)
+	assert.Equal(t, "type.googleapis.com/clutch.config.service.github.v1.Config", resp.Services[0].TypeUrl)
+	assert.Empty(t, resp.Resolvers)
+	assert.Len(t, resp.Middleware, 2)
+	assert.Equal(t, "clutch.middleware.timeouts", resp.Middleware[0].Name)
+	assert.Equal(t, "clutch.middleware.stats", resp.Middleware[1].Name)
+	assert.Len(t, resp.Modules, 1)
+
+	// The configuration is unavailable if it could not be converted.
+	_, err = api.GetConfig(context.Background(), &gatewayv1.GetConfigRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// The configuration is only served if enabled.
+	api = newAPI(&gatewayconfigv1.Config{}, zaptest.NewLogger(t))
+	_, err = api.GetConfig(context.Background(), &gatewayv1.GetConfigRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGetVersion(t *testing.T) {
+	api := newAPI(&gatewayconfigv1.Config{}, zaptest.NewLogger(t))
+	resp, err := api.GetVersion(context.Background(), &gatewayv1.GetVersionRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, version.Version, resp.Version.Version)
+	assert.NotEmpty(t, resp.Version.GoVersion)
+}
+
+func TestLogLevels(t *testing.T) {
+	api := newAPI(&gatewayconfigv1.Config{EnableLogLevelUpdates: true}, zaptest.NewLogger(t))
+
+	levels := logging.NewLevels(zap.InfoLevel)
+	_ = levels.Logger(zap.NewNop(), logging.GatewayName)
+	_ = levels.Logger(zap.NewNop(), "clutch.service.k8s")
+	logging.SetLevels(levels)
+
+	updated, err := api.UpdateLogLevel(context.Background(), &gatewayv1.UpdateLogLevelRequest{
+		Component: "clutch.service.k8s",
+		Level:     gatewayv1.LogLevel_DEBUG,
+		Ttl:       ptypes.DurationProto(time.Hour),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "clutch.service.k8s", updated.LogLevel.Component)
+	assert.Equal(t, gatewayv1.LogLevel_DEBUG, updated.LogLevel.Level)
+	assert.Equal(t, gatewayv1.LogLevel_INFO, updated.LogLevel.ConfiguredLevel)
+	assert.NotNil(t, updated.LogLevel.RevertTime)
+
+	resp, err := api.GetLogLevels(context.Background(), &gatewayv1.GetLogLevelsRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.LogLevels, 2)
+	assert.Equal(t, "clutch.service.k8s", resp.LogLevels[0].Component)
+	assert.Equal(t, gatewayv1.LogLevel_DEBUG, resp.LogLevels[0].Level)
+	assert.Equal(t, logging.GatewayName, resp.LogLevels[1].Component)
+	assert.Equal(t, gatewayv1.LogLevel_INFO, resp.LogLevels[1].Level)
+
+	// Without a level, the logger returns to the configured level.
+	updated, err = api.UpdateLogLevel(context.Background(), &gatewayv1.UpdateLogLevelRequest{Component: "clutch.service.k8s"})
+	assert.NoError(t, err)
+	assert.Equal(t, gatewayv1.LogLevel_INFO, updated.LogLevel.Level)
+	assert.Nil(t, updated.LogLevel.RevertTime)
+
+	_, err = api.UpdateLogLevel(context.Background(), &gatewayv1.UpdateLogLevelRequest{Component: "clutch.service.foo", Level: gatewayv1.LogLevel_DEBUG})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Updates are only allowed if enabled.
+	api = newAPI(&gatewayconfigv1.Config{}, zaptest.NewLogger(t))
+	_, err = api.UpdateLogLevel(context.Background(), &gatewayv1.UpdateLogLevelRequest{Component: "clutch.service.k8s", Level: gatewayv1.LogLevel_DEBUG})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
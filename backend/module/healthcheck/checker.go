@@ -0,0 +1,124 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+
+	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
+	"github.com/lyft/clutch/backend/service"
+)
+
+// checker runs the checks of the services that implement service.HealthChecker and caches the results.
+type checker struct {
+	logger *zap.Logger
+	scope  tally.Scope
+
+	ttl     time.Duration
+	timeout time.Duration
+	// Checks that affect readiness, by service or check name. Other checks are only reported.
+	critical map[string]bool
+	services map[string]service.HealthChecker
+
+	mu        sync.Mutex
+	result    *healthcheckv1.ReadinessResponse
+	checkedAt time.Time
+}
+
+func (c *checker) isCritical(name string) bool {
+	svc := name
+	if i := strings.Index(name, "/"); i >= 0 {
+		svc = name[:i]
+	}
+	return c.critical[name] || c.critical[svc]
+}
+
+// check returns the cached results if they are recent enough, otherwise it checks the services again. Concurrent calls
+// wait for the same checks rather than starting their own.
+func (c *checker) check() *healthcheckv1.ReadinessResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.result != nil && time.Since(c.checkedAt) < c.ttl {
+		return c.result
+	}
+
+	// The checks aren't tied to a request, since the results are shared.
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	type result struct {
+		service string
+		errs    map[string]error
+	}
+	results := make(chan result, len(c.services))
+	for name, hc := range c.services {
+		go func(name string, hc service.HealthChecker) {
+			results <- result{service: name, errs: hc.HealthCheck(ctx)}
+		}(name, hc)
+	}
+
+	errs := make(map[string]error)
+	pending := make(map[string]bool, len(c.services))
+	for name := range c.services {
+		pending[name] = true
+	}
+	for len(pending) > 0 {
+		select {
+		case r := <-results:
+			delete(pending, r.service)
+			for check, err := range r.errs {
+				name := r.service
+				if check != "" {
+					name += "/" + check
+				}
+				errs[name] = err
+			}
+		case <-ctx.Done():
+			// Services that don't stop when the context is done are reported as failed.
+			for name := range pending {
+				errs[name] = errors.New("timed out")
+			}
+			pending = nil
+		}
+	}
+
+	now := time.Now()
+	checkTime, _ := ptypes.TimestampProto(now)
+	resp := &healthcheckv1.ReadinessResponse{Ready: true}
+	for name, err := range errs {
+		check := &healthcheckv1.Check{
+			Name:      name,
+			Status:    healthcheckv1.Check_HEALTHY,
+			Critical:  c.isCritical(name),
+			CheckTime: checkTime,
+		}
+		if err != nil {
+			check.Status = healthcheckv1.Check_UNHEALTHY
+			check.Message = err.Error()
+			c.scope.Tagged(map[string]string{"check": name}).Counter("check_failure").Inc(1)
+			c.logger.Warn("health check failed", zap.String("check", name), zap.Bool("critical", check.Critical), zap.Error(err))
+			if check.Critical {
+				resp.Ready = false
+			}
+		}
+		resp.Checks = append(resp.Checks, check)
+	}
+	sort.Slice(resp.Checks, func(i, j int) bool { return resp.Checks[i].Name < resp.Checks[j].Name })
+
+	ready := 0.0
+	if resp.Ready {
+		ready = 1
+	}
+	c.scope.Gauge("ready").Update(ready)
+
+	c.result, c.checkedAt = resp, now
+	return resp
+}
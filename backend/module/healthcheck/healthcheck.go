@@ -1,46 +1,186 @@
 package healthcheck
 
 // <!-- START clutchdoc -->
-// description: Simple healthcheck endpoint.
+// description: Liveness and readiness endpoints, including checks of the services the gateway depends on and the standard gRPC health service.
 // <!-- END clutchdoc -->
 
 import (
 	"context"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 
+	healthcheckconfigv1 "github.com/lyft/clutch/backend/api/config/module/healthcheck/v1"
 	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
 	"github.com/lyft/clutch/backend/module"
+	"github.com/lyft/clutch/backend/service"
 )
 
 const (
 	Name = "clutch.module.healthcheck"
 )
 
-func New(*any.Any, *zap.Logger, tally.Scope) (module.Module, error) {
+const (
+	defaultCacheTTL = 10 * time.Second
+	defaultTimeout  = 5 * time.Second
+)
+
+func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (module.Module, error) {
+	config := &healthcheckconfigv1.Config{}
+	if cfg != nil {
+		if err := ptypes.UnmarshalAny(cfg, config); err != nil {
+			return nil, err
+		}
+	}
+
+	c := &checker{
+		logger:   logger,
+		scope:    scope.SubScope("healthcheck"),
+		ttl:      defaultCacheTTL,
+		timeout:  defaultTimeout,
+		critical: make(map[string]bool, len(config.CriticalChecks)),
+		services: make(map[string]service.HealthChecker),
+	}
+	if config.CacheTtl != nil {
+		ttl, err := ptypes.Duration(config.CacheTtl)
+		if err != nil {
+			return nil, err
+		}
+		c.ttl = ttl
+	}
+	if config.Timeout != nil {
+		timeout, err := ptypes.Duration(config.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		c.timeout = timeout
+	}
+	for _, name := range config.CriticalChecks {
+		c.critical[name] = true
+	}
+
+	// Services are instantiated before modules, so all of them are registered.
+	for _, name := range service.Registry.Names() {
+		svc, _ := service.Registry.Get(name)
+		if hc, ok := svc.(service.HealthChecker); ok {
+			c.services[name] = hc
+		}
+	}
+
 	mod := &mod{
-		api: newAPI(),
+		api:    newAPI(c),
+		health: &healthServer{checker: c},
 	}
 	return mod, nil
 }
 
 type mod struct {
-	api healthcheckv1.HealthcheckAPIServer
+	api    healthcheckv1.HealthcheckAPIServer
+	health *healthServer
 }
 
 func (m *mod) Register(r module.Registrar) error {
 	healthcheckv1.RegisterHealthcheckAPIServer(r.GRPCServer(), m.api)
-	return r.RegisterJSONGateway(healthcheckv1.RegisterHealthcheckAPIHandler)
+	if err := r.RegisterJSONGateway(healthcheckv1.RegisterHealthcheckAPIHandler); err != nil {
+		return err
+	}
+
+	// The gRPC health service has no JSON gateway, so it is registered after the API's gateway.
+	healthv1.RegisterHealthServer(r.GRPCServer(), m.health)
+	m.health.servers = append(m.health.servers, r.GRPCServer())
+	return nil
 }
 
-func newAPI() healthcheckv1.HealthcheckAPIServer {
-	return &healthcheckAPI{}
+func newAPI(c *checker) healthcheckv1.HealthcheckAPIServer {
+	return &healthcheckAPI{checker: c}
 }
 
-type healthcheckAPI struct{}
+type healthcheckAPI struct {
+	checker *checker
+}
 
 func (a *healthcheckAPI) Healthcheck(context.Context, *healthcheckv1.HealthcheckRequest) (*healthcheckv1.HealthcheckResponse, error) {
 	return &healthcheckv1.HealthcheckResponse{}, nil
 }
+
+// Readiness fails if the gateway isn't ready, so that load balancers checking the HTTP status stop sending traffic. The
+// results of the checks are included in the error details.
+func (a *healthcheckAPI) Readiness(context.Context, *healthcheckv1.ReadinessRequest) (*healthcheckv1.ReadinessResponse, error) {
+	resp := a.checker.check()
+	if resp.Ready {
+		return resp, nil
+	}
+
+	s := status.New(codes.Unavailable, "not ready")
+	if withDetails, err := s.WithDetails(resp); err == nil {
+		s = withDetails
+	}
+	return nil, s.Err()
+}
+
+// healthServer implements the standard gRPC health service with the results of the readiness checks. The status of the
+// server as a whole is requested with an empty service name, and every gRPC service it serves has the same status.
+type healthServer struct {
+	checker *checker
+	// The servers the module is registered with, used to find which services are served.
+	servers []*grpc.Server
+}
+
+func (h *healthServer) status(name string) (healthv1.HealthCheckResponse_ServingStatus, error) {
+	if name != "" && !h.serves(name) {
+		return healthv1.HealthCheckResponse_SERVICE_UNKNOWN, status.Errorf(codes.NotFound, "unknown service '%s'", name)
+	}
+	if h.checker.check().Ready {
+		return healthv1.HealthCheckResponse_SERVING, nil
+	}
+	return healthv1.HealthCheckResponse_NOT_SERVING, nil
+}
+
+func (h *healthServer) serves(name string) bool {
+	for _, s := range h.servers {
+		if _, ok := s.GetServiceInfo()[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *healthServer) Check(ctx context.Context, req *healthv1.HealthCheckRequest) (*healthv1.HealthCheckResponse, error) {
+	s, err := h.status(req.Service)
+	if err != nil {
+		return nil, err
+	}
+	return &healthv1.HealthCheckResponse{Status: s}, nil
+}
+
+// Watch sends the status when it is called and whenever it changes, which is checked as often as the results of the
+// readiness checks expire.
+func (h *healthServer) Watch(req *healthv1.HealthCheckRequest, stream healthv1.Health_WatchServer) error {
+	ticker := time.NewTicker(h.checker.ttl)
+	defer ticker.Stop()
+
+	// Unknown services are reported rather than failing the stream, since they may be registered later.
+	last := healthv1.HealthCheckResponse_ServingStatus(-1)
+	for {
+		s, _ := h.status(req.Service)
+		if s != last {
+			if err := stream.Send(&healthv1.HealthCheckResponse{Status: s}); err != nil {
+				return err
+			}
+			last = s
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
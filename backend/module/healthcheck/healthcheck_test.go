@@ -2,16 +2,35 @@ package healthcheck
 
 import (
 	"context"
+	"errors"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/uber-go/tally"
 	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 
+	healthcheckconfigv1 "github.com/lyft/clutch/backend/api/config/module/healthcheck/v1"
 	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
 	"github.com/lyft/clutch/backend/module/moduletest"
+	"github.com/lyft/clutch/backend/service"
 )
 
+type checkerMock struct {
+	calls  int32
+	result map[string]error
+}
+
+func (c *checkerMock) HealthCheck(ctx context.Context) map[string]error {
+	atomic.AddInt32(&c.calls, 1)
+	return c.result
+}
+
 func TestModule(t *testing.T) {
 	log := zaptest.NewLogger(t)
 	scope := tally.NewTestScope("", nil)
@@ -22,12 +41,146 @@ func TestModule(t *testing.T) {
 	r := moduletest.NewRegisterChecker()
 	assert.NoError(t, m.Register(r))
 	assert.NoError(t, r.HasAPI("clutch.healthcheck.v1.HealthcheckAPI"))
+	assert.NoError(t, r.HasAPI("grpc.health.v1.Health"))
 	assert.True(t, r.JSONRegistered())
 }
 
 func TestAPI(t *testing.T) {
-	api := newAPI()
+	m, err := New(nil, zaptest.NewLogger(t), tally.NoopScope)
+	assert.NoError(t, err)
+	api := m.(*mod).api
+
 	resp, err := api.Healthcheck(context.Background(), &healthcheckv1.HealthcheckRequest{})
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
+
+	// Without any services to check, the gateway is ready.
+	ready, err := api.Readiness(context.Background(), &healthcheckv1.ReadinessRequest{})
+	assert.NoError(t, err)
+	assert.True(t, ready.Ready)
+	assert.Empty(t, ready.Checks)
+}
+
+func newWithServices(t *testing.T, config *healthcheckconfigv1.Config, services map[string]service.Service) *mod {
+	service.Registry.Reset()
+	for name, svc := range services {
+		assert.NoError(t, service.Registry.Register(name, svc))
+	}
+
+	cfg, _ := ptypes.MarshalAny(config)
+	m, err := New(cfg, zaptest.NewLogger(t), tally.NewTestScope("", nil))
+	assert.NoError(t, err)
+	return m.(*mod)
+}
+
+func TestReadiness(t *testing.T) {
+	defer service.Registry.Reset()
+
+	db := &checkerMock{result: map[string]error{"": nil}}
+	k8s := &checkerMock{result: map[string]error{"staging": nil, "production": errors.New("Unauthorized")}}
+	github := &checkerMock{result: map[string]error{"": errors.New("rate limit exhausted")}}
+	m := newWithServices(t, &healthcheckconfigv1.Config{CriticalChecks: []string{"clutch.service.db.postgres", "clutch.service.k8s"}}, map[string]service.Service{
+		"clutch.service.db.postgres": db,
+		"clutch.service.k8s":         k8s,
+		"clutch.service.github":      github,
+		"clutch.service.other":       "not a health checker",
+	})
+
+	_, err := m.api.Readiness(context.Background(), &healthcheckv1.ReadinessRequest{})
+	s := status.Convert(err)
+	assert.Equal(t, codes.Unavailable, s.Code())
+	assert.Len(t, s.Details(), 1)
+
+	resp := s.Details()[0].(*healthcheckv1.ReadinessResponse)
+	assert.False(t, resp.Ready)
+	assert.Len(t, resp.Checks, 4)
+	names := make([]string, len(resp.Checks))
+	for i, c := range resp.Checks {
+		names[i] = c.Name
+	}
+	assert.Equal(t, []string{
+		"clutch.service.db.postgres",
+		"clutch.service.github",
+		"clutch.service.k8s/production",
+		"clutch.service.k8s/staging",
+	}, names)
+
+	assert.Equal(t, healthcheckv1.Check_HEALTHY, resp.Checks[0].Status)
+	assert.True(t, resp.Checks[0].Critical)
+	assert.NotNil(t, resp.Checks[0].CheckTime)
+	assert.Equal(t, healthcheckv1.Check_UNHEALTHY, resp.Checks[1].Status)
+	assert.Equal(t, "rate limit exhausted", resp.Checks[1].Message)
+	assert.False(t, resp.Checks[1].Critical)
+	assert.Equal(t, healthcheckv1.Check_UNHEALTHY, resp.Checks[2].Status)
+	assert.Equal(t, "Unauthorized", resp.Checks[2].Message)
+	assert.True(t, resp.Checks[2].Critical)
+
+	// Results are cached.
+	_, _ = m.api.Readiness(context.Background(), &healthcheckv1.ReadinessRequest{})
+	assert.EqualValues(t, 1, atomic.LoadInt32(&k8s.calls))
+
+	// Failing non-critical checks don't affect readiness.
+	m = newWithServices(t, &healthcheckconfigv1.Config{CriticalChecks: []string{"clutch.service.k8s/staging"}}, map[string]service.Service{
+		"clutch.service.k8s":    k8s,
+		"clutch.service.github": github,
+	})
+	resp, err = m.api.Readiness(context.Background(), &healthcheckv1.ReadinessRequest{})
+	assert.NoError(t, err)
+	assert.True(t, resp.Ready)
+	assert.Len(t, resp.Checks, 3)
+
+	// Checks aren't critical by default.
+	m = newWithServices(t, &healthcheckconfigv1.Config{}, map[string]service.Service{
+		"clutch.service.github": github,
+	})
+	resp, err = m.api.Readiness(context.Background(), &healthcheckv1.ReadinessRequest{})
+	assert.NoError(t, err)
+	assert.True(t, resp.Ready)
+	assert.False(t, resp.Checks[0].Critical)
+}
+
+type slowChecker struct{}
+
+func (slowChecker) HealthCheck(ctx context.Context) map[string]error {
+	time.Sleep(time.Second)
+	return map[string]error{"": nil}
+}
+
+func TestReadinessTimeout(t *testing.T) {
+	defer service.Registry.Reset()
+
+	m := newWithServices(t, &healthcheckconfigv1.Config{Timeout: ptypes.DurationProto(10 * time.Millisecond), CriticalChecks: []string{"clutch.service.slow"}}, map[string]service.Service{
+		"clutch.service.slow": slowChecker{},
+	})
+
+	resp := m.health.checker.check()
+	assert.False(t, resp.Ready)
+	assert.Len(t, resp.Checks, 1)
+	assert.Equal(t, "timed out", resp.Checks[0].Message)
+}
+
+func TestHealthServer(t *testing.T) {
+	defer service.Registry.Reset()
+
+	db := &checkerMock{result: map[string]error{"": nil}}
+	m := newWithServices(t, &healthcheckconfigv1.Config{CacheTtl: ptypes.DurationProto(0), CriticalChecks: []string{"clutch.service.db.postgres"}}, map[string]service.Service{
+		"clutch.service.db.postgres": db,
+	})
+	assert.NoError(t, m.Register(moduletest.NewRegisterChecker()))
+
+	resp, err := m.health.Check(context.Background(), &healthv1.HealthCheckRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, healthv1.HealthCheckResponse_SERVING, resp.Status)
+
+	resp, err = m.health.Check(context.Background(), &healthv1.HealthCheckRequest{Service: "clutch.healthcheck.v1.HealthcheckAPI"})
+	assert.NoError(t, err)
+	assert.Equal(t, healthv1.HealthCheckResponse_SERVING, resp.Status)
+
+	_, err = m.health.Check(context.Background(), &healthv1.HealthCheckRequest{Service: "clutch.foo.v1.FooAPI"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	db.result = map[string]error{"": errors.New("connection refused")}
+	resp, err = m.health.Check(context.Background(), &healthv1.HealthCheckRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, healthv1.HealthCheckResponse_NOT_SERVING, resp.Status)
 }
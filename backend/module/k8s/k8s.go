@@ -21,8 +21,10 @@ const (
 	Name = "clutch.module.k8s"
 )
 
+var Dependencies = service.Requires("clutch.service.k8s")
+
 func New(*any.Any, *zap.Logger, tally.Scope) (module.Module, error) {
-	client, ok := service.Registry["clutch.service.k8s"]
+	client, ok := service.Registry.Get("clutch.service.k8s")
 	if !ok {
 		return nil, errors.New("could not find service")
 	}
@@ -15,7 +15,8 @@ import (
 )
 
 func TestModule(t *testing.T) {
-	service.Registry["clutch.service.k8s"] = k8smock.New()
+	service.Registry.Reset()
+	assert.NoError(t, service.Registry.Register("clutch.service.k8s", k8smock.New()))
 
 	log := zaptest.NewLogger(t)
 	scope := tally.NewTestScope("", nil)
@@ -17,8 +17,10 @@ const (
 	Name = "clutch.module.kinesis"
 )
 
+var Dependencies = service.Requires("clutch.service.aws")
+
 func New(*any.Any, *zap.Logger, tally.Scope) (module.Module, error) {
-	awsClient, ok := service.Registry["clutch.service.aws"]
+	awsClient, ok := service.Registry.Get("clutch.service.aws")
 	if !ok {
 		return nil, errors.New("could not find service")
 	}
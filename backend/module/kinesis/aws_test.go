@@ -15,7 +15,8 @@ import (
 )
 
 func TestModule(t *testing.T) {
-	service.Registry["clutch.service.aws"] = awsmock.New()
+	service.Registry.Reset()
+	assert.NoError(t, service.Registry.Register("clutch.service.aws", awsmock.New()))
 
 	log := zaptest.NewLogger(t)
 	scope := tally.NewTestScope("", nil)
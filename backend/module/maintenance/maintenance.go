@@ -0,0 +1,64 @@
+package maintenance
+
+// <!-- START clutchdoc -->
+// description: Exposes endpoints to view and toggle maintenance mode.
+// <!-- END clutchdoc -->
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+
+	maintenancev1 "github.com/lyft/clutch/backend/api/maintenance/v1"
+	"github.com/lyft/clutch/backend/module"
+	"github.com/lyft/clutch/backend/service"
+	maintenanceservice "github.com/lyft/clutch/backend/service/maintenance"
+)
+
+const Name = "clutch.module.maintenance"
+
+var Dependencies = service.Requires(maintenanceservice.Name)
+
+func New(*any.Any, *zap.Logger, tally.Scope) (module.Module, error) {
+	svc, ok := service.Registry.Get(maintenanceservice.Name)
+	if !ok {
+		return nil, errors.New("unable to get maintenance service")
+	}
+
+	s, ok := svc.(maintenanceservice.Service)
+	if !ok {
+		return nil, errors.New("maintenance service was not the correct type")
+	}
+
+	return &mod{
+		maintenancev1: &api{service: s},
+	}, nil
+}
+
+type mod struct {
+	maintenancev1 maintenancev1.MaintenanceAPIServer
+}
+
+func (m *mod) Register(r module.Registrar) error {
+	maintenancev1.RegisterMaintenanceAPIServer(r.GRPCServer(), m.maintenancev1)
+	return r.RegisterJSONGateway(maintenancev1.RegisterMaintenanceAPIHandler)
+}
+
+type api struct {
+	service maintenanceservice.Service
+}
+
+func (a *api) GetMaintenance(ctx context.Context, req *maintenancev1.GetMaintenanceRequest) (*maintenancev1.GetMaintenanceResponse, error) {
+	return &maintenancev1.GetMaintenanceResponse{Maintenance: a.service.Get()}, nil
+}
+
+func (a *api) UpdateMaintenance(ctx context.Context, req *maintenancev1.UpdateMaintenanceRequest) (*maintenancev1.UpdateMaintenanceResponse, error) {
+	state, err := a.service.Update(ctx, req.Enabled, req.Message)
+	if err != nil {
+		return nil, err
+	}
+	return &maintenancev1.UpdateMaintenanceResponse{Maintenance: state}, nil
+}
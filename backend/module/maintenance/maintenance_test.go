@@ -0,0 +1,54 @@
+package maintenance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap/zaptest"
+
+	maintenancev1 "github.com/lyft/clutch/backend/api/maintenance/v1"
+	"github.com/lyft/clutch/backend/module/moduletest"
+	"github.com/lyft/clutch/backend/service"
+	maintenanceservice "github.com/lyft/clutch/backend/service/maintenance"
+)
+
+func TestModule(t *testing.T) {
+	service.Registry.Reset()
+	defer service.Registry.Reset()
+
+	log := zaptest.NewLogger(t)
+	scope := tally.NewTestScope("", nil)
+
+	svc, err := maintenanceservice.New(nil, log, scope)
+	assert.NoError(t, err)
+	assert.NoError(t, service.Registry.Register(maintenanceservice.Name, svc))
+
+	m, err := New(nil, log, scope)
+	assert.NoError(t, err)
+
+	r := moduletest.NewRegisterChecker()
+	assert.NoError(t, m.Register(r))
+	assert.NoError(t, r.HasAPI("clutch.maintenance.v1.MaintenanceAPI"))
+	assert.True(t, r.JSONRegistered())
+}
+
+func TestAPI(t *testing.T) {
+	svc, err := maintenanceservice.New(nil, zaptest.NewLogger(t), tally.NoopScope)
+	assert.NoError(t, err)
+	a := &api{service: svc.(maintenanceservice.Service)}
+
+	resp, err := a.GetMaintenance(context.Background(), &maintenancev1.GetMaintenanceRequest{})
+	assert.NoError(t, err)
+	assert.False(t, resp.Maintenance.Enabled)
+
+	updated, err := a.UpdateMaintenance(context.Background(), &maintenancev1.UpdateMaintenanceRequest{Enabled: true, Message: "incident"})
+	assert.NoError(t, err)
+	assert.True(t, updated.Maintenance.Enabled)
+	assert.Equal(t, "incident", updated.Maintenance.Message)
+
+	resp, err = a.GetMaintenance(context.Background(), &maintenancev1.GetMaintenanceRequest{})
+	assert.NoError(t, err)
+	assert.True(t, resp.Maintenance.Enabled)
+}
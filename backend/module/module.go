@@ -8,6 +8,8 @@ import (
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+
+	"github.com/lyft/clutch/backend/service"
 )
 
 type GatewayRegisterAPIHandlerFunc func(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error
@@ -21,4 +23,13 @@ type Module interface {
 	Register(Registrar) error
 }
 
-type Factory map[string]func(*any.Any, *zap.Logger, tally.Scope) (Module, error)
+// Factory maps each module's name to its registration.
+type Factory map[string]Registration
+
+// Registration is how the gateway instantiates a module.
+type Registration struct {
+	New func(*any.Any, *zap.Logger, tally.Scope) (Module, error)
+
+	// Dependencies declares the services the module requires. It can be omitted if it doesn't require any.
+	Dependencies service.DependencyFunc
+}
@@ -52,7 +52,7 @@ func (r *resolverAPI) Resolve(ctx context.Context, req *resolverv1.ResolveReques
 	}
 
 	var searchedSchemas []string
-	for _, res := range resolver.Registry {
+	for _, res := range resolver.Registry.List() {
 		// TODO: fan-out, fan-in for speeeed.
 		// TODO: dedupe results, as technically multiple resolvers could
 		//  resolve the same input schema (not yet though), and return the same object.
@@ -119,7 +119,7 @@ func (r *resolverAPI) Search(ctx context.Context, req *resolverv1.SearchRequest)
 	}
 
 	var searchedSchemas []string
-	for _, res := range resolver.Registry {
+	for _, res := range resolver.Registry.List() {
 		resSchemas := res.Schemas()
 		if schemas, ok := resSchemas[req.Want]; ok {
 			for _, ss := range schemas {
@@ -176,7 +176,7 @@ func (r *resolverAPI) GetObjectSchemas(ctx context.Context, req *resolverv1.GetO
 	var schemas []*resolverv1.Schema
 
 	// Find schemas that match the requested type.
-	for _, res := range resolver.Registry {
+	for _, res := range resolver.Registry.List() {
 		resSchemas := res.Schemas()
 		if typeSchemas, ok := resSchemas[req.TypeUrl]; ok {
 			for _, typeSchema := range typeSchemas {
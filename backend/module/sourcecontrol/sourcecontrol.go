@@ -20,8 +20,10 @@ import (
 
 const Name = "clutch.module.sourcecontrol"
 
+var Dependencies = service.Requires("clutch.service.github")
+
 func New(*any.Any, *zap.Logger, tally.Scope) (module.Module, error) {
-	svc, ok := service.Registry["clutch.service.github"]
+	svc, ok := service.Registry.Get("clutch.service.github")
 	if !ok {
 		return nil, errors.New("could not find service")
 	}
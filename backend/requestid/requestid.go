@@ -0,0 +1,77 @@
+// Package requestid correlates a request with its logs, audit event, and upstream calls.
+package requestid
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// Header is the HTTP header that carries the request ID, in requests to the gateway and in upstream calls.
+	Header = "X-Request-Id"
+	// MetadataKey is the gRPC metadata key that carries the request ID.
+	MetadataKey = "x-request-id"
+)
+
+// IDs supplied by callers are only accepted if they are reasonably short and can't inject anything into logs or headers.
+var validID = regexp.MustCompile(`^[A-Za-z0-9._:\-]{1,128}$`)
+
+type contextKey struct{}
+
+// New generates a request ID.
+func New() string {
+	return uuid.New().String()
+}
+
+// Valid returns whether an ID supplied by a caller can be used as the request ID.
+func Valid(id string) bool {
+	return validID.MatchString(id)
+}
+
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the ID of the request, or an empty string if there is none.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// Logger returns the logger with the ID of the request as a field, so that log lines from handling the request can be
+// correlated. The logger is returned unmodified if there is no ID in the context.
+func Logger(ctx context.Context, logger *zap.Logger) *zap.Logger {
+	if id := FromContext(ctx); id != "" {
+		return logger.With(zap.String("requestId", id))
+	}
+	return logger
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+// NewTransport wraps an HTTP transport so that the ID of the request in the context is forwarded in the X-Request-Id
+// header. If base is nil, http.DefaultTransport is used.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	id := FromContext(req.Context())
+	if id == "" || req.Header.Get(Header) != "" {
+		return t.base.RoundTrip(req)
+	}
+
+	// Round trippers must not modify the original request.
+	req = req.Clone(req.Context())
+	req.Header.Set(Header, id)
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,74 @@
+package requestid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestValid(t *testing.T) {
+	assert.True(t, Valid(New()))
+	assert.True(t, Valid("req-1234_abc.def:5"))
+	assert.False(t, Valid(""))
+	assert.False(t, Valid("foo\nbar"))
+	assert.False(t, Valid("foo bar"))
+	assert.False(t, Valid(strings.Repeat("a", 129)))
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Empty(t, FromContext(ctx))
+
+	ctx = NewContext(ctx, "abc")
+	assert.Equal(t, "abc", FromContext(ctx))
+}
+
+func TestLogger(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	logger := zap.New(core)
+
+	Logger(context.Background(), logger).Info("without id")
+	Logger(NewContext(context.Background(), "abc"), logger).Info("with id")
+
+	entries := logs.AllUntimed()
+	assert.Len(t, entries, 2)
+	assert.Empty(t, entries[0].ContextMap())
+	assert.Equal(t, map[string]interface{}{"requestId": "abc"}, entries[1].ContextMap())
+}
+
+func TestTransport(t *testing.T) {
+	var received []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get(Header))
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: NewTransport(nil)}
+	do := func(ctx context.Context, header string) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+		assert.NoError(t, err)
+		if header != "" {
+			req.Header.Set(Header, header)
+		}
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		// The original request is not modified.
+		assert.Equal(t, header, req.Header.Get(Header))
+	}
+
+	ctx := NewContext(context.Background(), "abc")
+	do(context.Background(), "")
+	do(ctx, "")
+	// A header set by the caller is not replaced.
+	do(ctx, "def")
+
+	assert.Equal(t, []string{"", "abc", "def"}, received)
+}
@@ -28,6 +28,8 @@ import (
 
 const Name = "clutch.resolver.aws"
 
+var Dependencies = service.Requires("clutch.service.aws")
+
 // Output types (want).
 var typeURLInstance = resolver.TypeURL((*ec2v1api.Instance)(nil))
 var typeURLAutoscalingGroup = resolver.TypeURL((*ec2v1api.AutoscalingGroup)(nil))
@@ -56,7 +58,7 @@ func makeRegionOptions(regions []string) []*resolverv1.Option {
 }
 
 func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (resolver.Resolver, error) {
-	awsClient, ok := service.Registry["clutch.service.aws"]
+	awsClient, ok := service.Registry.Get("clutch.service.aws")
 	if !ok {
 		return nil, errors.New("could not find service")
 	}
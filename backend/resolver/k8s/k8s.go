@@ -29,6 +29,8 @@ import (
 
 const Name = "clutch.resolver.k8s"
 
+var Dependencies = service.Requires("clutch.service.k8s")
+
 var typeURLPod = resolver.TypeURL((*k8sv1api.Pod)(nil))
 var typeURLHPA = resolver.TypeURL((*k8sv1api.HPA)(nil))
 
@@ -58,7 +60,7 @@ func makeClientsetOptions(clientsets []string) []*resolverv1.Option {
 }
 
 func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (resolver.Resolver, error) {
-	k8sRegistered, ok := service.Registry["clutch.service.k8s"]
+	k8sRegistered, ok := service.Registry.Get("clutch.service.k8s")
 	if !ok {
 		return nil, errors.New("could not find service")
 	}
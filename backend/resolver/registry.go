@@ -0,0 +1,73 @@
+package resolver
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	ErrDuplicate = errors.New("resolver already registered")
+	ErrLocked    = errors.New("resolver registry is locked")
+)
+
+var Registry = &registry{resolvers: map[string]Resolver{}}
+
+// registry holds instantiated resolvers by name. It rejects duplicates and becomes read-only once locked by the gateway.
+type registry struct {
+	mu        sync.RWMutex
+	locked    bool
+	names     []string
+	resolvers map[string]Resolver
+}
+
+func (r *registry) Register(name string, res Resolver) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.locked {
+		return fmt.Errorf("could not register '%s': %w", name, ErrLocked)
+	}
+	if _, ok := r.resolvers[name]; ok {
+		return fmt.Errorf("could not register '%s': %w", name, ErrDuplicate)
+	}
+	r.names = append(r.names, name)
+	r.resolvers[name] = res
+	return nil
+}
+
+func (r *registry) Get(name string) (Resolver, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res, ok := r.resolvers[name]
+	return res, ok
+}
+
+// List returns all resolvers in the order they were registered.
+func (r *registry) List() []Resolver {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ret := make([]Resolver, len(r.names))
+	for i, name := range r.names {
+		ret[i] = r.resolvers[name]
+	}
+	return ret
+}
+
+// Lock prevents any further resolvers from being registered.
+func (r *registry) Lock() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.locked = true
+}
+
+// Reset removes all resolvers and unlocks the registry. It is intended for use in tests.
+func (r *registry) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.locked = false
+	r.names = nil
+	r.resolvers = map[string]Resolver{}
+}
@@ -0,0 +1,43 @@
+package resolver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockResolver struct {
+	Resolver
+
+	id string
+}
+
+func TestRegistry(t *testing.T) {
+	r := &registry{resolvers: map[string]Resolver{}}
+
+	first, second := &mockResolver{id: "first"}, &mockResolver{id: "second"}
+	assert.NoError(t, r.Register("b", first))
+	assert.NoError(t, r.Register("a", second))
+
+	err := r.Register("b", second)
+	assert.True(t, errors.Is(err, ErrDuplicate))
+
+	res, ok := r.Get("b")
+	assert.True(t, ok)
+	assert.Same(t, first, res)
+
+	_, ok = r.Get("c")
+	assert.False(t, ok)
+
+	// Resolvers are listed in registration order.
+	assert.Equal(t, []Resolver{first, second}, r.List())
+
+	r.Lock()
+	err = r.Register("c", second)
+	assert.True(t, errors.Is(err, ErrLocked))
+
+	r.Reset()
+	assert.Empty(t, r.List())
+	assert.NoError(t, r.Register("c", second))
+}
@@ -14,15 +14,23 @@ import (
 	"google.golang.org/grpc/status"
 
 	resolverv1 "github.com/lyft/clutch/backend/api/resolver/v1"
+	"github.com/lyft/clutch/backend/service"
 )
 
 const OptionAll = "__ALL__"
 
 type TypeURLToSchemasMap map[string][]*resolverv1.Schema
 
-type Factory map[string]func(*any.Any, *zap.Logger, tally.Scope) (Resolver, error)
+// Factory maps each resolver's name to its registration.
+type Factory map[string]Registration
 
-var Registry = map[string]Resolver{}
+// Registration is how the gateway instantiates a resolver.
+type Registration struct {
+	New func(*any.Any, *zap.Logger, tally.Scope) (Resolver, error)
+
+	// Dependencies declares the services the resolver requires. It can be omitted if it doesn't require any.
+	Dependencies service.DependencyFunc
+}
 
 type Results struct {
 	Messages        []proto.Message
@@ -7,7 +7,9 @@ package audit
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/golang/protobuf/jsonpb"
@@ -25,13 +27,22 @@ import (
 
 const Name = "clutch.service.audit"
 
+// Dependencies returns the database provider and sinks listed in the configuration.
+func Dependencies(cfg *any.Any) ([]string, error) {
+	config := &auditconfigv1.Config{}
+	if err := ptypes.UnmarshalAny(cfg, config); err != nil {
+		return nil, err
+	}
+	return append([]string{config.DbProvider}, config.Sinks...), nil
+}
+
 func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service, error) {
 	config := &auditconfigv1.Config{}
 	if err := ptypes.UnmarshalAny(cfg, config); err != nil {
 		return nil, err
 	}
 
-	db, ok := service.Registry[config.DbProvider]
+	db, ok := service.Registry.Get(config.DbProvider)
 	if !ok {
 		return nil, fmt.Errorf("no database registered for saving audit events")
 	}
@@ -53,7 +64,11 @@ func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service,
 		scope:  scope,
 
 		filter: filter,
-		db:     sqlDB.DB(),
+
+		dbProvider: config.DbProvider,
+		sinkNames:  config.Sinks,
+
+		db: sqlDB.DB(),
 		marshaler: &jsonpb.Marshaler{
 			// Use the names from the .proto.
 			OrigName: true,
@@ -63,7 +78,7 @@ func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service,
 	}
 
 	for _, sinkName := range config.Sinks {
-		sinkService, ok := service.Registry[sinkName]
+		sinkService, ok := service.Registry.Get(sinkName)
 		if !ok {
 			return nil, fmt.Errorf(
 				"listed sink '%s' is unregistered",
@@ -82,9 +97,6 @@ func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service,
 		c.sinks = append(c.sinks, sink)
 	}
 
-	// Start polling loop against database.
-	go c.poll(time.Second * 10)
-
 	return c, nil
 }
 
@@ -92,17 +104,51 @@ type client struct {
 	logger *zap.Logger
 	scope  tally.Scope
 
-	filter    *auditconfigv1.Filter
+	// Guards the filter, which is replaced when the service is reconfigured.
+	mu     sync.RWMutex
+	filter *auditconfigv1.Filter
+
+	dbProvider string
+	sinkNames  []string
+
 	db        *sql.DB
 	marshaler *jsonpb.Marshaler
 
 	sinks []auditsink.Sink
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Start the polling loop against the database.
+func (c *client) Start(ctx context.Context) error {
+	ctx, c.cancel = context.WithCancel(ctx)
+	c.done = make(chan struct{})
+	go func() {
+		defer close(c.done)
+		c.poll(ctx, time.Second*10)
+	}()
+	return nil
+}
+
+// Stop the polling loop and wait for any in-progress fanout to sinks to finish.
+func (c *client) Stop(ctx context.Context) error {
+	if c.cancel == nil {
+		return nil
+	}
+	c.cancel()
+	select {
+	case <-c.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
-// This should be called via `go` in order to avoid blocking main exectuion.
-func (c *client) poll(interval time.Duration) {
+// This should be called via `go` in order to avoid blocking main exectuion. Polling stops when the context is done.
+func (c *client) poll(ctx context.Context, interval time.Duration) {
 	readAndFanout := func() {
-		ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(1*time.Second))
+		ctx, cancel := context.WithDeadline(ctx, time.Now().Add(1*time.Second))
 		defer cancel()
 
 		// TODO(maybe): Backpressure on continued failure.
@@ -124,13 +170,55 @@ func (c *client) poll(interval time.Duration) {
 	}
 
 	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 	for {
-		<-ticker.C
-		readAndFanout()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			readAndFanout()
+		}
+	}
+}
+
+// Reconfigure replaces the event filter. The database provider and sinks can only be changed with a restart.
+func (c *client) Reconfigure(cfg *any.Any) (func(), error) {
+	config := &auditconfigv1.Config{}
+	if err := ptypes.UnmarshalAny(cfg, config); err != nil {
+		return nil, err
+	}
+
+	if config.DbProvider != c.dbProvider || !equalStrings(config.Sinks, c.sinkNames) {
+		return nil, errors.New("changes to the database provider or sinks require a restart")
+	}
+
+	filter := config.Filter
+	if filter == nil {
+		filter = &auditconfigv1.Filter{}
+	}
+
+	return func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.filter = filter
+	}, nil
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
 	}
+	return true
 }
 
 func (c *client) Filter(event *auditv1.Event) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return auditsink.Filter(c.filter, event)
 }
 
@@ -1,8 +1,10 @@
 package audit
 
 import (
+	"context"
 	"testing"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
 
 	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
@@ -60,3 +62,57 @@ func TestFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestStartStop(t *testing.T) {
+	c := &client{}
+
+	// Stopping a client that was never started is a no-op.
+	assert.NoError(t, c.Stop(context.Background()))
+
+	assert.NoError(t, c.Start(context.Background()))
+	assert.NoError(t, c.Stop(context.Background()))
+
+	select {
+	case <-c.done:
+	default:
+		t.Error("poller did not exit")
+	}
+}
+
+func TestReconfigure(t *testing.T) {
+	c := &client{dbProvider: "clutch.service.db.postgres"}
+	event := &auditv1.Event{
+		EventType: &auditv1.Event_Event{
+			Event: &auditv1.RequestEvent{
+				MethodName: "Healthcheck",
+			}}}
+	assert.True(t, c.Filter(event))
+
+	cfg, err := ptypes.MarshalAny(&auditconfigv1.Config{
+		DbProvider: "clutch.service.db.postgres",
+		Filter: &auditconfigv1.Filter{
+			Denylist: true,
+			Rules: []*auditconfigv1.EventFilter{
+				{
+					Field: auditconfigv1.EventFilter_METHOD,
+					Value: &auditconfigv1.EventFilter_Text{Text: "Healthcheck"},
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	apply, err := c.Reconfigure(cfg)
+	assert.NoError(t, err)
+	apply()
+	assert.False(t, c.Filter(event))
+
+	// Changing the sinks requires a restart.
+	cfg, err = ptypes.MarshalAny(&auditconfigv1.Config{
+		DbProvider: "clutch.service.db.postgres",
+		Sinks:      []string{"clutch.service.auditsink.slack"},
+	})
+	assert.NoError(t, err)
+	_, err = c.Reconfigure(cfg)
+	assert.Error(t, err)
+	assert.False(t, c.Filter(event))
+}
@@ -29,6 +29,8 @@ func (c *client) WriteRequestEvent(ctx context.Context, event *auditv1.RequestEv
 		Method:           event.MethodName,
 		ActionType:       event.Type.String(),
 		RequestResources: convertResources(event.Resources),
+		RequestID:        event.RequestId,
+		TokenID:          event.TokenId,
 	}
 	blob, err := json.Marshal(dbEvent)
 	if err != nil {
@@ -167,6 +169,8 @@ type eventDetails struct {
 	Status            status      `json:"status,omitempty"`
 	RequestResources  []*resource `json:"request_resources,omitempty"`
 	ResponseResources []*resource `json:"response_resources,omitempty"`
+	RequestID         string      `json:"request_id,omitempty"`
+	TokenID           string      `json:"token_id,omitempty"`
 }
 
 func (e *eventDetails) ResourcesProto() []*auditv1.Resource {
@@ -205,6 +209,8 @@ func (e *event) RequestEventProto() *auditv1.RequestEvent {
 		Type:        apiv1.ActionType(apiv1.ActionType_value[e.Details.ActionType]),
 		Status:      e.Details.Status.Status(),
 		Resources:   e.Details.ResourcesProto(),
+		RequestId:   e.Details.RequestID,
+		TokenId:     e.Details.TokenID,
 	}
 }
 
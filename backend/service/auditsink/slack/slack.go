@@ -6,6 +6,7 @@ package slack
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
@@ -42,28 +43,52 @@ type svc struct {
 	logger *zap.Logger
 	scope  tally.Scope
 
+	// Guards the fields below, which are replaced when the service is reconfigured.
+	mu sync.RWMutex
+
 	filter *auditconfigv1.Filter
 
 	slack   *slack.Client
 	channel string
 }
 
+// Reconfigure replaces the filter, token, and channel used for posting events.
+func (s *svc) Reconfigure(cfg *any.Any) (func(), error) {
+	config := &configv1.SlackConfig{}
+	if err := ptypes.UnmarshalAny(cfg, config); err != nil {
+		return nil, err
+	}
+
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.filter = config.Filter
+		s.slack = slack.New(config.Token)
+		s.channel = config.Channel
+	}, nil
+}
+
 func (s *svc) Write(event *auditv1.Event) error {
-	if !auditsink.Filter(s.filter, event) {
+	// The lock isn't held while posting so that reconfiguring doesn't wait on Slack.
+	s.mu.RLock()
+	filter, client, channel := s.filter, s.slack, s.channel
+	s.mu.RUnlock()
+
+	if !auditsink.Filter(filter, event) {
 		return nil
 	}
 
 	switch event.GetEventType().(type) {
 	case *auditv1.Event_Event:
-		return s.writeRequestEvent(event.GetEvent())
+		return s.writeRequestEvent(client, channel, event.GetEvent())
 	default:
 		return nil
 	}
 }
 
-func (s *svc) writeRequestEvent(event *auditv1.RequestEvent) error {
+func (s *svc) writeRequestEvent(client *slack.Client, channel string, event *auditv1.RequestEvent) error {
 	// Get user ID for pretty message printing.
-	user, err := s.slack.GetUserByEmail(event.Username)
+	user, err := client.GetUserByEmail(event.Username)
 
 	var username string
 	if err != nil {
@@ -80,7 +105,7 @@ func (s *svc) writeRequestEvent(event *auditv1.RequestEvent) error {
 	messageText := formatText(username, event)
 
 	// Post
-	if _, _, err := s.slack.PostMessage(s.channel, slack.MsgOptionText(messageText, false)); err != nil {
+	if _, _, err := client.PostMessage(channel, slack.MsgOptionText(messageText, false)); err != nil {
 		return err
 	}
 	return nil
@@ -51,3 +51,21 @@ func TestFormat(t *testing.T) {
 	actual := formatText(username, event)
 	assert.Equal(t, expected, actual)
 }
+
+func TestReconfigure(t *testing.T) {
+	t.Parallel()
+
+	log := zaptest.NewLogger(t)
+	scope := tally.NewTestScope("", nil)
+
+	cfg, _ := ptypes.MarshalAny(&configv1.SlackConfig{Channel: "first"})
+	s, err := New(cfg, log, scope)
+	assert.NoError(t, err)
+
+	cfg, _ = ptypes.MarshalAny(&configv1.SlackConfig{Channel: "second"})
+	apply, err := s.(*svc).Reconfigure(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, "first", s.(*svc).channel)
+	apply()
+	assert.Equal(t, "second", s.(*svc).channel)
+}
@@ -1,7 +1,7 @@
 package authn
 
 // <!-- START clutchdoc -->
-// description: Produces tokens for the configured OIDC provider.
+// description: Authenticates users with OIDC, trusted headers, or client certificates, and produces tokens for them.
 // <!-- END clutchdoc -->
 
 import (
@@ -26,16 +26,39 @@ import (
 	"github.com/lyft/clutch/backend/service"
 )
 
-var scopes = []string{oidc.ScopeOpenID, "email"} // TODO(maybe): make scopes part of config?
+// Scopes that are always requested, in addition to those in the config.
+var defaultScopes = []string{oidc.ScopeOpenID, "email"}
 
 const Name = "clutch.service.authn"
 
+// Dependencies returns the database providers used to store API tokens and sessions, if they're enabled.
+func Dependencies(cfg *any.Any) ([]string, error) {
+	config := &authnv1.Config{}
+	if cfg != nil {
+		if err := ptypes.UnmarshalAny(cfg, config); err != nil {
+			return nil, err
+		}
+	}
+
+	var deps []string
+	if config.ApiTokens != nil {
+		deps = append(deps, config.ApiTokens.DbProvider)
+	}
+	if config.Sessions != nil && config.Sessions.DbProvider != config.ApiTokens.GetDbProvider() {
+		deps = append(deps, config.Sessions.DbProvider)
+	}
+	return deps, nil
+}
+
 func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service, error) {
 	config := &authnv1.Config{}
 	if err := ptypes.UnmarshalAny(cfg, config); err != nil {
 		return nil, err
 	}
-	return NewProvider(config)
+	if _, ok := config.Type.(*authnv1.Config_Oidc); ok {
+		return NewProvider(config)
+	}
+	return NewChain(config)
 }
 
 // Standardized representation of a user's claims.
@@ -44,6 +67,9 @@ type Claims struct {
 
 	// Groups could be derived from the token or an external mapping.
 	Groups []string `json:"grp,omitempty"`
+
+	// Scopes limit an API token to the methods matching any of the patterns. Session tokens aren't scoped.
+	Scopes []string `json:"scp,omitempty"`
 }
 
 type Provider interface {
@@ -64,6 +90,11 @@ type OIDCProvider struct {
 
 	sessionSecret string
 
+	// If nil, API tokens are not enabled.
+	tokens *tokenStore
+	// If nil, sessions can't be renewed or ended.
+	sessions *sessionStore
+
 	claimsFromOIDCToken ClaimsFromOIDCTokenFunc
 }
 
@@ -129,28 +160,57 @@ func (p *OIDCProvider) Exchange(ctx context.Context, code string) (string, error
 		return "", err
 	}
 
+	// Each login starts a new session, which is identified by the ID of its tokens.
+	claims, signed, err := p.issue(ctx, token, uuid.New().String())
+	if err != nil {
+		return "", err
+	}
+
+	// The refresh token is kept to renew the session, and is otherwise discarded.
+	if p.sessions != nil {
+		if err := p.sessions.create(ctx, claims.Id, claims.Subject, token.RefreshToken); err != nil {
+			return "", err
+		}
+	}
+	return signed, nil
+}
+
+// refresh gets a new token from the provider with a refresh token.
+func (p *OIDCProvider) refresh(ctx context.Context, refreshToken string) (*oauth2.Token, error) {
+	ctx = oidc.ClientContext(ctx, p.httpClient)
+	// Without an access token, the token source always uses the refresh token.
+	return p.oauth2.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken}).Token()
+}
+
+// issue verifies the ID token in the provider's token and returns Clutch's claims for it, along with the signed token.
+func (p *OIDCProvider) issue(ctx context.Context, token *oauth2.Token, sessionID string) (*Claims, string, error) {
 	rawIDToken, ok := token.Extra("id_token").(string)
 	if !ok {
-		return "", errors.New("'id_token' was not present in oauth token")
+		return nil, "", errors.New("'id_token' was not present in oauth token")
 	}
 
 	// Verify.
+	ctx = oidc.ClientContext(ctx, p.httpClient)
 	idToken, err := p.verifier.Verify(ctx, rawIDToken)
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
 
 	// Issue token with claims.
 	claims, err := p.claimsFromOIDCToken(ctx, idToken)
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
-	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(p.sessionSecret))
-}
+	if claims.StandardClaims == nil {
+		claims.StandardClaims = &jwt.StandardClaims{}
+	}
+	claims.Id = sessionID
 
-// Intermediate claims object for the ID token. Based on what scopes were requested.
-type idClaims struct {
-	Email string `json:"email"`
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(p.sessionSecret))
+	if err != nil {
+		return nil, "", err
+	}
+	return claims, signed, nil
 }
 
 func oidcTokenToStandardClaims(t *oidc.IDToken) *jwt.StandardClaims {
@@ -164,23 +224,10 @@ func oidcTokenToStandardClaims(t *oidc.IDToken) *jwt.StandardClaims {
 
 type ClaimsFromOIDCTokenFunc func(ctx context.Context, t *oidc.IDToken) (*Claims, error)
 
-// Extract claims from an OIDC token and return Clutch's standard claims object. This could be configurable at a later
-// date to support subjects with IDs other than email (e.g. GitHub ID).
+// DefaultClaimsFromOIDCToken returns Clutch's standard claims object with the email in the token as the subject, and
+// no groups. The claims used for the subject and groups are configurable with the OIDC config.
 func DefaultClaimsFromOIDCToken(ctx context.Context, t *oidc.IDToken) (*Claims, error) {
-	idc := &idClaims{}
-	if err := t.Claims(idc); err != nil {
-		return nil, err
-	}
-	if idc.Email == "" {
-		return nil, errors.New("claims did not deserialize with desired fields")
-	}
-
-	sc := oidcTokenToStandardClaims(t)
-	sc.Subject = idc.Email
-	return &Claims{
-		StandardClaims: sc,
-		Groups:         []string{"12345"},
-	}, nil
+	return (&claimMapping{subjectClaim: defaultSubjectClaim}).claimsFromOIDCToken(ctx, t)
 }
 
 func (p *OIDCProvider) Verify(ctx context.Context, rawToken string) (*Claims, error) {
@@ -196,6 +243,19 @@ func (p *OIDCProvider) Verify(ctx context.Context, rawToken string) (*Claims, er
 		return nil, err
 	}
 
+	if claims.IsAPIToken() {
+		if p.tokens == nil {
+			return nil, errors.New("API tokens are not enabled")
+		}
+		if err := p.tokens.verify(ctx, claims.Id); err != nil {
+			return nil, err
+		}
+	} else if p.sessions != nil && claims.Id != "" {
+		if err := p.sessions.verify(ctx, claims.Id); err != nil {
+			return nil, err
+		}
+	}
+
 	return claims, nil
 }
 
@@ -216,6 +276,18 @@ func (pc *oidcProviderClaims) Check(grantType string) error {
 func NewProvider(config *authnv1.Config) (Provider, error) {
 	c := config.GetOidc()
 
+	mapping, err := newClaimMapping(c)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := append([]string{}, defaultScopes...)
+	for _, scope := range c.Scopes {
+		if !containsString(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
 	httpClient := &http.Client{}
 	ctx := oidc.ClientContext(context.Background(), httpClient)
 	provider, err := oidc.NewProvider(ctx, c.Issuer)
@@ -244,12 +316,32 @@ func NewProvider(config *authnv1.Config) (Provider, error) {
 		return nil, err
 	}
 
+	tokens, err := newTokenStore(config.ApiTokens)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := newSessionStore(config.Sessions, config.SessionSecret)
+	if err != nil {
+		return nil, err
+	}
+
 	return &OIDCProvider{
 		provider:            provider,
 		verifier:            verifier,
 		oauth2:              oc,
 		httpClient:          httpClient,
 		sessionSecret:       config.SessionSecret,
-		claimsFromOIDCToken: DefaultClaimsFromOIDCToken,
+		tokens:              tokens,
+		sessions:            sessions,
+		claimsFromOIDCToken: mapping.claimsFromOIDCToken,
 	}, nil
 }
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
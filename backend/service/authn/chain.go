@@ -0,0 +1,185 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authnv1 "github.com/lyft/clutch/backend/api/authn/v1"
+	authnconfigv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
+	"github.com/lyft/clutch/backend/clientinfo"
+)
+
+var errLoginDisabled = status.Error(codes.Unimplemented, "none of the configured authn providers support logging in")
+
+// Chain tries each of its providers in turn to authenticate a request, until one finds credentials on it. Logging in,
+// API tokens, and sessions are handled by its OIDC provider, if it has one.
+type Chain struct {
+	authenticators []RequestAuthenticator
+
+	// If nil, users can't log in.
+	provider Provider
+}
+
+// NewChain creates the providers in the config, which is either a single provider other than OIDC or a chain of them.
+func NewChain(config *authnconfigv1.Config) (*Chain, error) {
+	var providers []*authnconfigv1.Provider
+	switch t := config.Type.(type) {
+	case *authnconfigv1.Config_TrustedHeader:
+		providers = append(providers, &authnconfigv1.Provider{Type: &authnconfigv1.Provider_TrustedHeader{TrustedHeader: t.TrustedHeader}})
+	case *authnconfigv1.Config_ClientCertificate:
+		providers = append(providers, &authnconfigv1.Provider{Type: &authnconfigv1.Provider_ClientCertificate{ClientCertificate: t.ClientCertificate}})
+	case *authnconfigv1.Config_Chain:
+		providers = t.Chain.Providers
+	}
+	if len(providers) == 0 {
+		return nil, errors.New("no authn provider configured")
+	}
+
+	c := &Chain{}
+	for _, p := range providers {
+		switch t := p.Type.(type) {
+		case *authnconfigv1.Provider_Oidc:
+			if c.provider != nil {
+				return nil, errors.New("a chain can only have one oidc provider")
+			}
+			provider, err := NewProvider(&authnconfigv1.Config{
+				SessionSecret: config.SessionSecret,
+				Type:          &authnconfigv1.Config_Oidc{Oidc: t.Oidc},
+				ApiTokens:     config.ApiTokens,
+				Sessions:      config.Sessions,
+			})
+			if err != nil {
+				return nil, err
+			}
+			c.provider = provider
+			c.authenticators = append(c.authenticators, tokenAuthenticator{provider: provider})
+		case *authnconfigv1.Provider_TrustedHeader:
+			provider, err := NewTrustedHeaderProvider(t.TrustedHeader)
+			if err != nil {
+				return nil, err
+			}
+			c.authenticators = append(c.authenticators, provider)
+		case *authnconfigv1.Provider_ClientCertificate:
+			c.authenticators = append(c.authenticators, NewClientCertificateProvider(t.ClientCertificate))
+		default:
+			return nil, errors.New("authn provider has no type")
+		}
+	}
+
+	if c.provider == nil && (config.ApiTokens != nil || config.Sessions != nil) {
+		return nil, errors.New("api tokens and sessions require an oidc provider")
+	}
+	return c, nil
+}
+
+// ClientInfo returns the headers read by the chain's trusted header providers, and whether any of its providers identify
+// clients with the information the JSON gateway forwards.
+func (c *Chain) ClientInfo() ([]string, bool) {
+	var headers []string
+	var ret bool
+	for _, a := range c.authenticators {
+		if consumer, ok := a.(clientinfo.Consumer); ok {
+			h, ok := consumer.ClientInfo()
+			headers = append(headers, h...)
+			ret = ret || ok
+		}
+	}
+	return headers, ret
+}
+
+// AuthenticateRequest returns the claims from the first provider that finds credentials on the request. If they're
+// invalid, the request isn't authenticated, even if a later provider would accept it.
+func (c *Chain) AuthenticateRequest(ctx context.Context) (*Claims, error) {
+	noCredentials := ErrNoCredentials
+	for i, a := range c.authenticators {
+		claims, err := a.AuthenticateRequest(ctx)
+		if errors.Is(err, ErrNoCredentials) {
+			// The error from the first provider is returned, which is usually the one users log in with.
+			if i == 0 {
+				noCredentials = err
+			}
+			continue
+		}
+		return claims, err
+	}
+	return nil, noCredentials
+}
+
+func (c *Chain) GetStateNonce(redirectURL string) (string, error) {
+	if c.provider == nil {
+		return "", errLoginDisabled
+	}
+	return c.provider.GetStateNonce(redirectURL)
+}
+
+func (c *Chain) ValidateStateNonce(state string) (string, error) {
+	if c.provider == nil {
+		return "", errLoginDisabled
+	}
+	return c.provider.ValidateStateNonce(state)
+}
+
+func (c *Chain) Verify(ctx context.Context, rawIDToken string) (*Claims, error) {
+	if c.provider == nil {
+		return nil, errLoginDisabled
+	}
+	return c.provider.Verify(ctx, rawIDToken)
+}
+
+func (c *Chain) GetAuthCodeURL(ctx context.Context, state string) (string, error) {
+	if c.provider == nil {
+		return "", errLoginDisabled
+	}
+	return c.provider.GetAuthCodeURL(ctx, state)
+}
+
+func (c *Chain) Exchange(ctx context.Context, code string) (string, error) {
+	if c.provider == nil {
+		return "", errLoginDisabled
+	}
+	return c.provider.Exchange(ctx, code)
+}
+
+func (c *Chain) CreateToken(ctx context.Context, name, subject string, groups, scopes []string, ttl time.Duration) (*authnv1.Token, string, error) {
+	tokens, ok := c.provider.(TokenIssuer)
+	if !ok {
+		return nil, "", errTokensDisabled
+	}
+	return tokens.CreateToken(ctx, name, subject, groups, scopes, ttl)
+}
+
+func (c *Chain) ListTokens(ctx context.Context, subject string, includeRevoked bool) ([]*authnv1.Token, error) {
+	tokens, ok := c.provider.(TokenIssuer)
+	if !ok {
+		return nil, errTokensDisabled
+	}
+	return tokens.ListTokens(ctx, subject, includeRevoked)
+}
+
+func (c *Chain) RevokeToken(ctx context.Context, id string) error {
+	tokens, ok := c.provider.(TokenIssuer)
+	if !ok {
+		return errTokensDisabled
+	}
+	return tokens.RevokeToken(ctx, id)
+}
+
+func (c *Chain) RefreshSession(ctx context.Context, claims *Claims) (string, error) {
+	sessions, ok := c.provider.(SessionProvider)
+	if !ok {
+		return "", errSessionsDisabled
+	}
+	return sessions.RefreshSession(ctx, claims)
+}
+
+func (c *Chain) RevokeSession(ctx context.Context, claims *Claims) error {
+	sessions, ok := c.provider.(SessionProvider)
+	if !ok {
+		return nil
+	}
+	return sessions.RevokeSession(ctx, claims)
+}
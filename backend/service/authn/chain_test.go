@@ -0,0 +1,116 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	authnconfigv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
+)
+
+func TestNewChain(t *testing.T) {
+	trustedHeader := &authnconfigv1.TrustedHeader{SubjectHeader: "X-Forwarded-Email", AllowedCidrs: []string{"10.0.0.0/8"}}
+
+	c, err := NewChain(&authnconfigv1.Config{Type: &authnconfigv1.Config_TrustedHeader{TrustedHeader: trustedHeader}})
+	assert.NoError(t, err)
+	assert.Len(t, c.authenticators, 1)
+	assert.Nil(t, c.provider)
+
+	c, err = NewChain(&authnconfigv1.Config{Type: &authnconfigv1.Config_Chain{Chain: &authnconfigv1.Chain{
+		Providers: []*authnconfigv1.Provider{
+			{Type: &authnconfigv1.Provider_ClientCertificate{ClientCertificate: &authnconfigv1.ClientCertificate{}}},
+			{Type: &authnconfigv1.Provider_TrustedHeader{TrustedHeader: trustedHeader}},
+		},
+	}}})
+	assert.NoError(t, err)
+	assert.Len(t, c.authenticators, 2)
+
+	// The gateway forwards the headers that the chain's providers read.
+	headers, ok := c.ClientInfo()
+	assert.True(t, ok)
+	assert.Equal(t, []string{"X-Forwarded-Email"}, headers)
+
+	headers, ok = (&Chain{authenticators: []RequestAuthenticator{tokenAuthenticator{provider: tokenProvider{}}}}).ClientInfo()
+	assert.False(t, ok)
+	assert.Empty(t, headers)
+
+	_, err = NewChain(&authnconfigv1.Config{})
+	assert.EqualError(t, err, "no authn provider configured")
+
+	_, err = NewChain(&authnconfigv1.Config{
+		Type:     &authnconfigv1.Config_TrustedHeader{TrustedHeader: trustedHeader},
+		Sessions: &authnconfigv1.Sessions{DbProvider: "clutch.service.db.postgres"},
+	})
+	assert.EqualError(t, err, "api tokens and sessions require an oidc provider")
+}
+
+// authenticatorMock returns the claims or error it was created with.
+type authenticatorMock struct {
+	claims *Claims
+	err    error
+}
+
+func (a authenticatorMock) AuthenticateRequest(context.Context) (*Claims, error) {
+	return a.claims, a.err
+}
+
+func TestChainAuthenticateRequest(t *testing.T) {
+	first := authenticatorMock{err: ErrNoCredentials}
+	invalid := authenticatorMock{err: errors.New("invalid credentials")}
+	valid := authenticatorMock{claims: &Claims{}}
+
+	tests := []struct {
+		name           string
+		authenticators []RequestAuthenticator
+		err            error
+	}{
+		{name: "first with credentials is used", authenticators: []RequestAuthenticator{first, valid, invalid}},
+		{name: "invalid credentials are rejected", authenticators: []RequestAuthenticator{first, invalid, valid}, err: invalid.err},
+		{name: "no credentials", authenticators: []RequestAuthenticator{first, first}, err: ErrNoCredentials},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Chain{authenticators: tt.authenticators}
+			claims, err := c.AuthenticateRequest(context.Background())
+			assert.Equal(t, tt.err, err)
+			if tt.err == nil {
+				assert.Equal(t, valid.claims, claims)
+			}
+		})
+	}
+
+	// The error of the first provider without credentials is returned, e.g. asking for a token.
+	c := &Chain{authenticators: []RequestAuthenticator{tokenAuthenticator{provider: tokenProvider{}}, first}}
+	_, err := c.AuthenticateRequest(metadata.NewIncomingContext(context.Background(), metadata.MD{}))
+	assert.EqualError(t, err, "no credentials: token not present in authorization header or cookies")
+
+	c = &Chain{authenticators: []RequestAuthenticator{first, tokenAuthenticator{provider: tokenProvider{}}}}
+	claims, err := c.AuthenticateRequest(metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Token valid")))
+	assert.NoError(t, err)
+	assert.Equal(t, "user@example.com", claims.Subject)
+}
+
+func TestChainWithoutOIDC(t *testing.T) {
+	c := &Chain{authenticators: []RequestAuthenticator{authenticatorMock{err: ErrNoCredentials}}}
+	ctx := context.Background()
+
+	_, err := c.GetStateNonce("/")
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	_, err = c.GetAuthCodeURL(ctx, "state")
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	_, err = c.Verify(ctx, "token")
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	_, _, err = c.CreateToken(ctx, "name", "subject", nil, []string{"*"}, 0)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = c.RefreshSession(ctx, &Claims{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, c.RevokeSession(ctx, &Claims{}))
+}
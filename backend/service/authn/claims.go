@@ -0,0 +1,128 @@
+package authn
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/coreos/go-oidc"
+
+	authnv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
+)
+
+const defaultSubjectClaim = "email"
+
+// claimMapping derives Clutch's claims from the claims in an ID token.
+type claimMapping struct {
+	subjectClaim string
+	// If empty, users have no groups.
+	groupsClaim string
+	rewrites    []groupRewrite
+}
+
+type groupRewrite struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+func newClaimMapping(config *authnv1.OIDC) (*claimMapping, error) {
+	m := &claimMapping{
+		subjectClaim: config.SubjectClaim,
+		groupsClaim:  config.GroupsClaim,
+	}
+	if m.subjectClaim == "" {
+		m.subjectClaim = defaultSubjectClaim
+	}
+
+	for _, r := range config.GroupRewrites {
+		pattern, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group rewrite pattern '%s': %w", r.Pattern, err)
+		}
+		m.rewrites = append(m.rewrites, groupRewrite{pattern: pattern, replacement: r.Replacement})
+	}
+	return m, nil
+}
+
+func (m *claimMapping) claimsFromOIDCToken(ctx context.Context, t *oidc.IDToken) (*Claims, error) {
+	raw := make(map[string]interface{})
+	if err := t.Claims(&raw); err != nil {
+		return nil, err
+	}
+
+	subject, _ := lookupClaim(raw, m.subjectClaim).(string)
+	if subject == "" {
+		return nil, fmt.Errorf("claim '%s' was not present in ID token", m.subjectClaim)
+	}
+
+	var groups []string
+	if m.groupsClaim != "" {
+		groups = m.rewriteGroups(groupsFromClaim(lookupClaim(raw, m.groupsClaim)))
+	}
+
+	sc := oidcTokenToStandardClaims(t)
+	sc.Subject = subject
+	return &Claims{
+		StandardClaims: sc,
+		Groups:         groups,
+	}, nil
+}
+
+// lookupClaim returns the value of a claim, which can be nested in other claims by separating their names with dots.
+// Since claim names can contain dots themselves, e.g. "https://example.com/groups", the longest name that matches is
+// preferred.
+func lookupClaim(claims map[string]interface{}, path string) interface{} {
+	if v, ok := claims[path]; ok {
+		return v
+	}
+	for i := strings.LastIndex(path, "."); i > 0; i = strings.LastIndex(path[:i], ".") {
+		if nested, ok := claims[path[:i]].(map[string]interface{}); ok {
+			if v := lookupClaim(nested, path[i+1:]); v != nil {
+				return v
+			}
+		}
+	}
+	return nil
+}
+
+// groupsFromClaim accepts a list of groups or a single group. Other values are ignored.
+func groupsFromClaim(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		groups := make([]string, 0, len(v))
+		for _, g := range v {
+			if s, ok := g.(string); ok {
+				groups = append(groups, s)
+			}
+		}
+		return groups
+	default:
+		return nil
+	}
+}
+
+// rewriteGroups applies the first matching rewrite to each group, dropping groups rewritten to an empty string. The
+// result is sorted and has no duplicates.
+func (m *claimMapping) rewriteGroups(groups []string) []string {
+	seen := make(map[string]bool, len(groups))
+	ret := make([]string, 0, len(groups))
+	for _, g := range groups {
+		for _, r := range m.rewrites {
+			if r.pattern.MatchString(g) {
+				g = r.pattern.ReplaceAllString(g, r.replacement)
+				break
+			}
+		}
+		if g == "" || seen[g] {
+			continue
+		}
+		seen[g] = true
+		ret = append(ret, g)
+	}
+	sort.Strings(ret)
+	return ret
+}
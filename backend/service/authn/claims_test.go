@@ -0,0 +1,119 @@
+package authn
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/coreos/go-oidc"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+
+	authnv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
+)
+
+func newIDToken(t *testing.T, claims jwt.MapClaims) *oidc.IDToken {
+	claims["iss"] = testIssuer
+	claims["exp"] = time.Now().Add(time.Hour).Unix()
+	raw, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("provider-secret"))
+	assert.NoError(t, err)
+
+	verifier := oidc.NewVerifier(testIssuer, keySet{}, &oidc.Config{SkipClientIDCheck: true, SupportedSigningAlgs: []string{"HS256"}})
+	token, err := verifier.Verify(context.Background(), raw)
+	assert.NoError(t, err)
+	return token
+}
+
+func TestDefaultClaimsFromOIDCToken(t *testing.T) {
+	claims, err := DefaultClaimsFromOIDCToken(context.Background(), newIDToken(t, jwt.MapClaims{
+		"sub":    "1234",
+		"email":  "user@example.com",
+		"groups": []string{"sre"},
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, "user@example.com", claims.Subject)
+	assert.Equal(t, testIssuer, claims.Issuer)
+	assert.Empty(t, claims.Groups)
+
+	_, err = DefaultClaimsFromOIDCToken(context.Background(), newIDToken(t, jwt.MapClaims{"sub": "1234"}))
+	assert.EqualError(t, err, "claim 'email' was not present in ID token")
+}
+
+func TestClaimMapping(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *authnv1.OIDC
+		claims  jwt.MapClaims
+		subject string
+		groups  []string
+	}{
+		{
+			name:    "okta",
+			config:  &authnv1.OIDC{GroupsClaim: "groups"},
+			claims:  jwt.MapClaims{"email": "user@example.com", "groups": []string{"sre", "everyone"}},
+			subject: "user@example.com",
+			groups:  []string{"everyone", "sre"},
+		},
+		{
+			name: "keycloak",
+			config: &authnv1.OIDC{
+				SubjectClaim: "preferred_username",
+				GroupsClaim:  "realm_access.roles",
+				GroupRewrites: []*authnv1.GroupRewrite{
+					{Pattern: "^(offline_access|uma_authorization|default-roles-.*)$"},
+					{Pattern: "^clutch-(.*)$", Replacement: "$1"},
+				},
+			},
+			claims: jwt.MapClaims{
+				"preferred_username": "user",
+				"realm_access": map[string]interface{}{
+					"roles": []string{"offline_access", "uma_authorization", "default-roles-master", "clutch-admin", "sre"},
+				},
+			},
+			subject: "user",
+			groups:  []string{"admin", "sre"},
+		},
+		{
+			name: "dex",
+			config: &authnv1.OIDC{
+				GroupsClaim:   "groups",
+				GroupRewrites: []*authnv1.GroupRewrite{{Pattern: "^example-org:(.*)$", Replacement: "github:$1"}},
+			},
+			claims:  jwt.MapClaims{"email": "user@example.com", "groups": []string{"example-org:sre", "example-org:sre"}},
+			subject: "user@example.com",
+			groups:  []string{"github:sre"},
+		},
+		{
+			name:    "namespaced claim with a single group",
+			config:  &authnv1.OIDC{GroupsClaim: "https://example.com/group"},
+			claims:  jwt.MapClaims{"email": "user@example.com", "https://example.com/group": "sre"},
+			subject: "user@example.com",
+			groups:  []string{"sre"},
+		},
+		{
+			name:    "missing groups",
+			config:  &authnv1.OIDC{GroupsClaim: "groups"},
+			claims:  jwt.MapClaims{"email": "user@example.com"},
+			subject: "user@example.com",
+			groups:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := newClaimMapping(tt.config)
+			assert.NoError(t, err)
+
+			claims, err := m.claimsFromOIDCToken(context.Background(), newIDToken(t, tt.claims))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.subject, claims.Subject)
+			assert.Equal(t, tt.groups, claims.Groups)
+		})
+	}
+}
+
+func TestClaimMappingInvalidPattern(t *testing.T) {
+	_, err := newClaimMapping(&authnv1.OIDC{GroupRewrites: []*authnv1.GroupRewrite{{Pattern: "("}}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,61 @@
+package authn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dgrijalva/jwt-go"
+
+	authnv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
+	"github.com/lyft/clutch/backend/clientinfo"
+)
+
+// ClientCertificateProvider authenticates requests with the client certificate verified by the gateway's TLS config,
+// using one of its subject alternative names as the subject.
+type ClientCertificateProvider struct {
+	subjectAltName authnv1.ClientCertificate_SubjectAltName
+	groups         []string
+}
+
+func NewClientCertificateProvider(config *authnv1.ClientCertificate) *ClientCertificateProvider {
+	p := &ClientCertificateProvider{
+		subjectAltName: config.SubjectAltName,
+		groups:         (&claimMapping{}).rewriteGroups(config.Groups),
+	}
+	if p.subjectAltName == authnv1.ClientCertificate_UNSPECIFIED {
+		p.subjectAltName = authnv1.ClientCertificate_URI
+	}
+	return p
+}
+
+func (p *ClientCertificateProvider) ClientInfo() ([]string, bool) { return nil, true }
+
+func (p *ClientCertificateProvider) AuthenticateRequest(ctx context.Context) (*Claims, error) {
+	cert := clientinfo.Certificate(ctx)
+	if cert == nil {
+		return nil, fmt.Errorf("%w: no verified client certificate", ErrNoCredentials)
+	}
+
+	var names []string
+	switch p.subjectAltName {
+	case authnv1.ClientCertificate_DNS:
+		names = cert.DNSNames
+	case authnv1.ClientCertificate_EMAIL:
+		names = cert.EmailAddresses
+	default:
+		for _, u := range cert.URIs {
+			names = append(names, u.String())
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("client certificate has no %s subject alternative name", p.subjectAltName)
+	}
+
+	return &Claims{
+		StandardClaims: &jwt.StandardClaims{
+			Subject:   names[0],
+			ExpiresAt: cert.NotAfter.Unix(),
+		},
+		Groups: p.groups,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package authn
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	authnv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
+	"github.com/lyft/clutch/backend/clientinfo"
+)
+
+func newClientCertificate(t *testing.T) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	spiffeID, _ := url.Parse("spiffe://example.com/ns/ci/sa/deploy")
+	template := &x509.Certificate{
+		SerialNumber:   big.NewInt(1),
+		Subject:        pkix.Name{CommonName: "deploy"},
+		NotBefore:      time.Now().Add(-time.Hour),
+		NotAfter:       time.Now().Add(time.Hour),
+		DNSNames:       []string{"deploy.example.com"},
+		EmailAddresses: []string{"deploy@example.com"},
+		URIs:           []*url.URL{spiffeID},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	return cert
+}
+
+func TestClientCertificateProvider(t *testing.T) {
+	cert := newClientCertificate(t)
+	info := credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}}
+	direct := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: info})
+	gateway := gatewayContext(metadata.Pairs(clientinfo.CertificateMetadataKey, string(cert.Raw)))
+
+	tests := []struct {
+		san     authnv1.ClientCertificate_SubjectAltName
+		subject string
+	}{
+		{san: authnv1.ClientCertificate_UNSPECIFIED, subject: "spiffe://example.com/ns/ci/sa/deploy"},
+		{san: authnv1.ClientCertificate_URI, subject: "spiffe://example.com/ns/ci/sa/deploy"},
+		{san: authnv1.ClientCertificate_DNS, subject: "deploy.example.com"},
+		{san: authnv1.ClientCertificate_EMAIL, subject: "deploy@example.com"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.san.String(), func(t *testing.T) {
+			p := NewClientCertificateProvider(&authnv1.ClientCertificate{SubjectAltName: tt.san, Groups: []string{"automation"}})
+			for _, ctx := range []context.Context{direct, gateway} {
+				claims, err := p.AuthenticateRequest(ctx)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.subject, claims.Subject)
+				assert.Equal(t, []string{"automation"}, claims.Groups)
+				assert.Equal(t, cert.NotAfter.Unix(), claims.ExpiresAt)
+			}
+		})
+	}
+}
+
+func TestClientCertificateProviderErrors(t *testing.T) {
+	p := NewClientCertificateProvider(&authnv1.ClientCertificate{})
+
+	_, err := p.AuthenticateRequest(context.Background())
+	assert.True(t, errors.Is(err, ErrNoCredentials))
+
+	// Certificates in metadata that didn't come from the gateway are ignored.
+	cert := newClientCertificate(t)
+	_, err = p.AuthenticateRequest(metadata.NewIncomingContext(context.Background(), metadata.Pairs(clientinfo.CertificateMetadataKey, string(cert.Raw))))
+	assert.True(t, errors.Is(err, ErrNoCredentials))
+
+	cert.URIs = nil
+	info := credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}}
+	_, err = p.AuthenticateRequest(peer.NewContext(context.Background(), &peer.Peer{AuthInfo: info}))
+	assert.EqualError(t, err, "client certificate has no URI subject alternative name")
+}
@@ -0,0 +1,89 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// ErrNoCredentials is wrapped by errors from AuthenticateRequest when the request has none of the credentials that a
+// provider accepts, as opposed to credentials that are invalid.
+var ErrNoCredentials = errors.New("no credentials")
+
+// RequestAuthenticator is implemented by providers that authenticate requests with something other than the tokens they
+// issue, e.g. headers set by a proxy or the client certificate.
+type RequestAuthenticator interface {
+	AuthenticateRequest(ctx context.Context) (*Claims, error)
+}
+
+// AuthenticateRequest returns the claims of the caller. If the provider doesn't implement RequestAuthenticator, the
+// token in the request is verified with the provider.
+func AuthenticateRequest(ctx context.Context, p Provider) (*Claims, error) {
+	if ra, ok := p.(RequestAuthenticator); ok {
+		return ra.AuthenticateRequest(ctx)
+	}
+	return tokenAuthenticator{provider: p}.AuthenticateRequest(ctx)
+}
+
+// tokenAuthenticator authenticates requests with a token verified by the provider.
+type tokenAuthenticator struct {
+	provider Provider
+}
+
+func (a tokenAuthenticator) AuthenticateRequest(ctx context.Context) (*Claims, error) {
+	token, err := TokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return a.provider.Verify(ctx, token)
+}
+
+// TokenFromContext looks for the token in the authorization header or cookies of the request.
+func TokenFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", fmt.Errorf("%w: no headers present on request", ErrNoCredentials)
+	}
+	return getToken(md)
+}
+
+// getCookieValue is the easiest way to parse a cookie string in a non-HTTP request context.
+func getCookieValue(raw, key string) (string, error) {
+	request := http.Request{Header: http.Header{}}
+	request.Header.Add("Cookie", raw)
+	c, err := request.Cookie(key)
+	if err != nil {
+		return "", err
+	}
+	return c.Value, nil
+}
+
+// getToken looks for the token in the authorization header or cookies.
+func getToken(md metadata.MD) (string, error) {
+	if tokens := md.Get("authorization"); len(tokens) > 0 {
+		splitToken := strings.Split(tokens[0], "Token")
+		if len(splitToken) != 2 {
+			return "", errors.New("bad token format, expected Authorization: Token <token>")
+		}
+		return strings.TrimSpace(splitToken[1]), nil
+	}
+
+	// Cookies are forwarded by the JSON gateway with a prefix, and as is from gRPC-Web requests.
+	v := md.Get("grpcgateway-cookie")
+	if len(v) == 0 {
+		v = md.Get("cookie")
+	}
+	if len(v) == 0 {
+		return "", fmt.Errorf("%w: token not present in authorization header or cookies", ErrNoCredentials)
+	}
+
+	value, err := getCookieValue(v[0], "token")
+	if errors.Is(err, http.ErrNoCookie) {
+		return "", fmt.Errorf("%w: token not present in authorization header or cookies", ErrNoCredentials)
+	}
+	return value, err
+}
@@ -0,0 +1,84 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestGetCookieValue(t *testing.T) {
+	cookie := "foo=bar;baz=bang;foo=qux"
+	res, err := getCookieValue(cookie, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", res)
+
+	res, err = getCookieValue(cookie, "baz")
+	assert.NoError(t, err)
+	assert.Equal(t, "bang", res)
+}
+
+func TestGetToken(t *testing.T) {
+	tokenVal := "quux"
+
+	tests := []struct {
+		md            metadata.MD
+		err           bool
+		noCredentials bool
+	}{
+		{md: metadata.Pairs("authorization", "Token "+tokenVal)},
+		{md: metadata.Pairs("Authorization", "Token "+tokenVal)},
+		{md: metadata.Pairs("grpcgateway-cookie", "foo=bar;token="+tokenVal)},
+		{md: metadata.Pairs("cookie", "foo=bar;token="+tokenVal)},
+		{md: metadata.Pairs("GRPCGateway-Cookie", "foo=bar;token="+tokenVal)},
+		{md: metadata.Pairs("Authorization", tokenVal), err: true},
+		{md: metadata.Pairs("cookie", "foo=bar"), err: true, noCredentials: true},
+		{md: metadata.Pairs(), err: true, noCredentials: true},
+	}
+
+	for idx, tt := range tests {
+		tt := tt
+		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
+			t.Parallel()
+
+			result, err := getToken(tt.md)
+			if tt.err {
+				assert.Error(t, err)
+				assert.Equal(t, tt.noCredentials, errors.Is(err, ErrNoCredentials))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tokenVal, result)
+			}
+		})
+	}
+}
+
+// tokenProvider accepts the token "valid" from user@example.com.
+type tokenProvider struct {
+	Provider
+}
+
+func (tokenProvider) Verify(ctx context.Context, rawToken string) (*Claims, error) {
+	if rawToken != "valid" {
+		return nil, errors.New("invalid token")
+	}
+	return &Claims{StandardClaims: &jwt.StandardClaims{Subject: "user@example.com"}}, nil
+}
+
+func TestAuthenticateRequest(t *testing.T) {
+	_, err := AuthenticateRequest(context.Background(), tokenProvider{})
+	assert.True(t, errors.Is(err, ErrNoCredentials))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Token valid"))
+	claims, err := AuthenticateRequest(ctx, tokenProvider{})
+	assert.NoError(t, err)
+	assert.Equal(t, "user@example.com", claims.Subject)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Token invalid"))
+	_, err = AuthenticateRequest(ctx, tokenProvider{})
+	assert.EqualError(t, err, "invalid token")
+}
@@ -0,0 +1,241 @@
+package authn
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authnconfigv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/db/postgres"
+)
+
+// SessionProvider is implemented by providers whose sessions can be renewed and ended before their tokens expire.
+type SessionProvider interface {
+	// RefreshSession returns a new token for the session the claims belong to.
+	RefreshSession(ctx context.Context, claims *Claims) (string, error)
+	// RevokeSession ends the session the claims belong to, after which its tokens are rejected by Verify. It does nothing
+	// if sessions aren't stored, in which case tokens remain valid until they expire.
+	RevokeSession(ctx context.Context, claims *Claims) error
+}
+
+// sessionStore keeps the refresh tokens of sessions, and which sessions have ended.
+type sessionStore struct {
+	db *sql.DB
+	// Refresh tokens are encrypted with a key derived from the session secret.
+	aead cipher.AEAD
+
+	verified *verifyCache
+}
+
+func newSessionStore(config *authnconfigv1.Sessions, sessionSecret string) (*sessionStore, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	db, ok := service.Registry.Get(config.DbProvider)
+	if !ok {
+		return nil, fmt.Errorf("unable to get database provider '%s'", config.DbProvider)
+	}
+	sqlDB, ok := db.(postgres.Client)
+	if !ok {
+		return nil, errors.New("database in registry does not implement required interface")
+	}
+
+	aead, err := newAEAD(sessionSecret)
+	if err != nil {
+		return nil, err
+	}
+	return &sessionStore{db: sqlDB.DB(), aead: aead, verified: newVerifyCache()}, nil
+}
+
+func newAEAD(secret string) (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// The nonce is stored before the ciphertext.
+func (s *sessionStore) encrypt(plaintext string) ([]byte, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return s.aead.Seal(nonce, nonce, []byte(plaintext), nil), nil
+}
+
+func (s *sessionStore) decrypt(ciphertext []byte) (string, error) {
+	n := s.aead.NonceSize()
+	if len(ciphertext) < n {
+		return "", errors.New("refresh token is too short")
+	}
+	plaintext, err := s.aead.Open(nil, ciphertext[:n], ciphertext[n:], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+var errSessionsDisabled = status.Error(codes.FailedPrecondition, "sessions are not enabled")
+
+func (p *OIDCProvider) RefreshSession(ctx context.Context, claims *Claims) (string, error) {
+	if p.sessions == nil {
+		return "", errSessionsDisabled
+	}
+	if claims.IsAPIToken() || claims.Id == "" {
+		return "", status.Error(codes.InvalidArgument, "only session tokens can be refreshed")
+	}
+
+	refreshToken, err := p.sessions.refreshToken(ctx, claims.Id)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := p.refresh(ctx, refreshToken)
+	if err != nil {
+		return "", status.Errorf(codes.Unauthenticated, "unable to renew session: %s", err)
+	}
+	// Providers can rotate refresh tokens, in which case the old one stops working.
+	if token.RefreshToken != "" {
+		refreshToken = token.RefreshToken
+	}
+
+	var signed string
+	if _, ok := token.Extra("id_token").(string); ok {
+		_, signed, err = p.issue(ctx, token, claims.Id)
+	} else {
+		signed, err = p.renew(claims, token.Expiry)
+	}
+	if err != nil {
+		return "", err
+	}
+	if err := p.sessions.update(ctx, claims.Id, refreshToken); err != nil {
+		return "", err
+	}
+	return signed, nil
+}
+
+// renew signs the existing claims again with a new expiry. Providers don't have to return an ID token when refreshing,
+// in which case the subject and groups can't be updated, so the session continues with the claims it had. It expires
+// with the provider's access token, or lasts as long as before if the provider doesn't say when that expires.
+func (p *OIDCProvider) renew(claims *Claims, expiry time.Time) (string, error) {
+	now := time.Now()
+	if expiry.IsZero() {
+		expiry = now.Add(time.Duration(claims.ExpiresAt-claims.IssuedAt) * time.Second)
+	}
+
+	renewed := *claims
+	sc := *claims.StandardClaims
+	sc.IssuedAt = now.Unix()
+	sc.ExpiresAt = expiry.Unix()
+	renewed.StandardClaims = &sc
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, &renewed).SignedString([]byte(p.sessionSecret))
+}
+
+func (p *OIDCProvider) RevokeSession(ctx context.Context, claims *Claims) error {
+	if p.sessions == nil || claims.IsAPIToken() || claims.Id == "" {
+		return nil
+	}
+	return p.sessions.revoke(ctx, claims.Id, claims.Subject)
+}
+
+const createSessionStatement = `INSERT INTO authn_sessions (id, subject, refresh_token) VALUES ($1, $2, $3)`
+
+func (s *sessionStore) create(ctx context.Context, id, subject, refreshToken string) error {
+	// Sessions without a refresh token are still stored so that they can be ended.
+	var encrypted []byte
+	if refreshToken != "" {
+		var err error
+		if encrypted, err = s.encrypt(refreshToken); err != nil {
+			return err
+		}
+	}
+	_, err := s.db.ExecContext(ctx, createSessionStatement, id, subject, encrypted)
+	return err
+}
+
+const refreshTokenStatement = `SELECT refresh_token, revoked_at FROM authn_sessions WHERE id = $1`
+
+func (s *sessionStore) refreshToken(ctx context.Context, id string) (string, error) {
+	var encrypted []byte
+	var revokedAt sql.NullTime
+	err := s.db.QueryRowContext(ctx, refreshTokenStatement, id).Scan(&encrypted, &revokedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", status.Error(codes.FailedPrecondition, "session can't be renewed, log in again")
+	}
+	if err != nil {
+		return "", err
+	}
+	if revokedAt.Valid {
+		return "", status.Error(codes.Unauthenticated, "session has ended")
+	}
+	if len(encrypted) == 0 {
+		return "", status.Error(codes.FailedPrecondition, "session can't be renewed, log in again")
+	}
+	return s.decrypt(encrypted)
+}
+
+const updateSessionStatement = `UPDATE authn_sessions SET refresh_token = $2, refreshed_at = NOW() WHERE id = $1`
+
+func (s *sessionStore) update(ctx context.Context, id, refreshToken string) error {
+	encrypted, err := s.encrypt(refreshToken)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, updateSessionStatement, id, encrypted)
+	return err
+}
+
+// Sessions that weren't stored, e.g. because they started before sessions were enabled, are stored as they end. The
+// refresh token is dropped since it's no longer needed.
+const revokeSessionStatement = `
+INSERT INTO authn_sessions (id, subject, revoked_at) VALUES ($1, $2, NOW())
+ON CONFLICT (id) DO UPDATE SET refresh_token = NULL, revoked_at = COALESCE(authn_sessions.revoked_at, EXCLUDED.revoked_at)
+`
+
+func (s *sessionStore) revoke(ctx context.Context, id, subject string) error {
+	if _, err := s.db.ExecContext(ctx, revokeSessionStatement, id, subject); err != nil {
+		return err
+	}
+	s.verified.delete(id)
+	return nil
+}
+
+const verifySessionStatement = `SELECT revoked_at FROM authn_sessions WHERE id = $1`
+
+// verify returns an error if the session has ended. Tokens of sessions that weren't stored are accepted until they
+// expire. As with API tokens, the result is cached for verifyCacheTTL so that the database isn't queried on every
+// request, and so that sessions in use aren't rejected while the database is briefly unavailable.
+func (s *sessionStore) verify(ctx context.Context, id string) error {
+	if err, ok := s.verified.get(id); ok {
+		return err
+	}
+
+	var revokedAt sql.NullTime
+	err := s.db.QueryRowContext(ctx, verifySessionStatement, id).Scan(&revokedAt)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		err = nil
+	case err != nil:
+		return fmt.Errorf("unable to verify session: %w", err)
+	case revokedAt.Valid:
+		err = errors.New("session has ended")
+	}
+
+	s.verified.put(id, err)
+	return err
+}
@@ -0,0 +1,272 @@
+package authn
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/coreos/go-oidc"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testIssuer = "https://issuer.example.com"
+
+// keySet verifies ID tokens signed by the test provider with HS256.
+type keySet struct{}
+
+func (keySet) VerifySignature(ctx context.Context, rawToken string) ([]byte, error) {
+	_, err := jwt.Parse(rawToken, func(*jwt.Token) (interface{}, error) { return []byte("provider-secret"), nil })
+	if err != nil {
+		return nil, err
+	}
+	return base64.RawURLEncoding.DecodeString(strings.Split(rawToken, ".")[1])
+}
+
+// newTestProvider returns a provider whose token endpoint issues refresh tokens "rt-1", "rt-2", etc. ID tokens are only
+// returned for refresh grants if idTokenOnRefresh is set.
+func newTestProvider(t *testing.T, idTokenOnRefresh bool) (*OIDCProvider, sqlmock.Sqlmock, *[]string, func()) {
+	var grants []string
+	refreshes := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		grants = append(grants, r.Form.Get("grant_type")+":"+r.Form.Get("refresh_token"))
+		refreshes++
+
+		idToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"iss":   testIssuer,
+			"aud":   "clutch",
+			"sub":   "1234",
+			"email": "user@example.com",
+			"iat":   time.Now().Unix(),
+			"exp":   time.Now().Add(time.Hour).Unix(),
+		}).SignedString([]byte("provider-secret"))
+		assert.NoError(t, err)
+
+		resp := map[string]interface{}{
+			"access_token":  "at",
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+			"refresh_token": "rt-" + string(rune('0'+refreshes)),
+		}
+		if idTokenOnRefresh || r.Form.Get("grant_type") != "refresh_token" {
+			resp["id_token"] = idToken
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	aead, err := newAEAD("this-is-my-secret")
+	assert.NoError(t, err)
+
+	p := &OIDCProvider{
+		verifier: oidc.NewVerifier(testIssuer, keySet{}, &oidc.Config{ClientID: "clutch", SupportedSigningAlgs: []string{"HS256"}}),
+		oauth2: &oauth2.Config{
+			ClientID: "clutch",
+			Endpoint: oauth2.Endpoint{TokenURL: srv.URL, AuthStyle: oauth2.AuthStyleInParams},
+		},
+		httpClient:          srv.Client(),
+		sessionSecret:       "this-is-my-secret",
+		sessions:            &sessionStore{db: db, aead: aead, verified: newVerifyCache()},
+		claimsFromOIDCToken: DefaultClaimsFromOIDCToken,
+	}
+	return p, mock, &grants, srv.Close
+}
+
+// capture matches any argument and keeps its value.
+type capture struct {
+	value driver.Value
+}
+
+func (c *capture) Match(v driver.Value) bool {
+	c.value = v
+	return true
+}
+
+func TestEncryptRefreshToken(t *testing.T) {
+	aead, err := newAEAD("this-is-my-secret")
+	assert.NoError(t, err)
+	s := &sessionStore{aead: aead}
+
+	encrypted, err := s.encrypt("refresh-token")
+	assert.NoError(t, err)
+	assert.NotContains(t, string(encrypted), "refresh-token")
+
+	decrypted, err := s.decrypt(encrypted)
+	assert.NoError(t, err)
+	assert.Equal(t, "refresh-token", decrypted)
+
+	// Tokens encrypted with a different secret can't be decrypted.
+	other, _ := newAEAD("this-is-a-different-secret")
+	_, err = (&sessionStore{aead: other}).decrypt(encrypted)
+	assert.Error(t, err)
+}
+
+func TestRefreshSession(t *testing.T) {
+	p, mock, grants, closeServer := newTestProvider(t, true)
+	defer closeServer()
+	ctx := context.Background()
+
+	// Logging in stores the session with its refresh token.
+	id, refreshToken := &capture{}, &capture{}
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO authn_sessions")).
+		WithArgs(id, "user@example.com", refreshToken).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	signed, err := p.Exchange(ctx, "code")
+	assert.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT revoked_at FROM authn_sessions")).
+		WithArgs(id.value).
+		WillReturnRows(sqlmock.NewRows([]string{"revoked_at"}))
+	claims, err := p.Verify(ctx, signed)
+	assert.NoError(t, err)
+	assert.Equal(t, id.value, claims.Id)
+	assert.Equal(t, "user@example.com", claims.Subject)
+
+	// Refreshing uses the stored refresh token, and keeps the one it's rotated to.
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT refresh_token, revoked_at FROM authn_sessions")).
+		WithArgs(claims.Id).
+		WillReturnRows(sqlmock.NewRows([]string{"refresh_token", "revoked_at"}).AddRow(refreshToken.value, nil))
+	rotated := &capture{}
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE authn_sessions SET refresh_token")).
+		WithArgs(claims.Id, rotated).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	refreshed, err := p.RefreshSession(ctx, claims)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"authorization_code:", "refresh_token:rt-1"}, *grants)
+	decrypted, err := p.sessions.decrypt(rotated.value.([]byte))
+	assert.NoError(t, err)
+	assert.Equal(t, "rt-2", decrypted)
+
+	// The session keeps its ID. It was checked moments ago, so the database isn't queried again.
+	refreshedClaims, err := p.Verify(ctx, refreshed)
+	assert.NoError(t, err)
+	assert.Equal(t, claims.Id, refreshedClaims.Id)
+
+	// Once the session ends, its tokens are rejected. Ending it clears the cached result.
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO authn_sessions (id, subject, revoked_at)")).
+		WithArgs(claims.Id, "user@example.com").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, p.RevokeSession(ctx, claims))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT revoked_at FROM authn_sessions")).
+		WithArgs(claims.Id).
+		WillReturnRows(sqlmock.NewRows([]string{"revoked_at"}).AddRow(time.Now()))
+	_, err = p.Verify(ctx, refreshed)
+	assert.EqualError(t, err, "session has ended")
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT refresh_token, revoked_at FROM authn_sessions")).
+		WithArgs(claims.Id).
+		WillReturnRows(sqlmock.NewRows([]string{"refresh_token", "revoked_at"}).AddRow(nil, time.Now()))
+	_, err = p.RefreshSession(ctx, claims)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshSessionWithoutIDToken(t *testing.T) {
+	p, mock, _, closeServer := newTestProvider(t, false)
+	defer closeServer()
+	ctx := context.Background()
+
+	issuedAt := time.Now().Add(-30 * time.Minute)
+	claims := &Claims{
+		StandardClaims: &jwt.StandardClaims{
+			Id:        "b1a8b52f-6ea1-4c2e-b5cb-6c1b2bd4a0b1",
+			Subject:   "user@example.com",
+			IssuedAt:  issuedAt.Unix(),
+			ExpiresAt: issuedAt.Add(time.Hour).Unix(),
+		},
+		Groups: []string{"sre"},
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT refresh_token, revoked_at FROM authn_sessions")).
+		WithArgs(claims.Id).
+		WillReturnRows(sqlmock.NewRows([]string{"refresh_token", "revoked_at"}).AddRow(mustEncrypt(t, p, "rt-0"), nil))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE authn_sessions SET refresh_token")).
+		WithArgs(claims.Id, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	refreshed, err := p.RefreshSession(ctx, claims)
+	assert.NoError(t, err)
+
+	// The existing claims are kept, and expire with the new access token.
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT revoked_at FROM authn_sessions")).
+		WithArgs(claims.Id).
+		WillReturnRows(sqlmock.NewRows([]string{"revoked_at"}))
+	refreshedClaims, err := p.Verify(ctx, refreshed)
+	assert.NoError(t, err)
+	assert.Equal(t, claims.Id, refreshedClaims.Id)
+	assert.Equal(t, "user@example.com", refreshedClaims.Subject)
+	assert.Equal(t, []string{"sre"}, refreshedClaims.Groups)
+	assert.InDelta(t, time.Now().Add(time.Hour).Unix(), refreshedClaims.ExpiresAt, 5)
+	assert.Equal(t, issuedAt.Add(time.Hour).Unix(), claims.ExpiresAt)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestVerifySessionCache(t *testing.T) {
+	p, mock, _, closeServer := newTestProvider(t, true)
+	defer closeServer()
+	ctx := context.Background()
+	id := "b1a8b52f-6ea1-4c2e-b5cb-6c1b2bd4a0b1"
+
+	// Errors reading the database aren't cached.
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT revoked_at FROM authn_sessions")).WithArgs(id).WillReturnError(errors.New("connection refused"))
+	assert.Error(t, p.sessions.verify(ctx, id))
+
+	// Once checked, the session is accepted without querying the database until the result expires.
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT revoked_at FROM authn_sessions")).WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"revoked_at"}))
+	assert.NoError(t, p.sessions.verify(ctx, id))
+	assert.NoError(t, p.sessions.verify(ctx, id))
+
+	p.sessions.verified.results[id] = verifyResult{expiresAt: time.Now().Add(-time.Second)}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT revoked_at FROM authn_sessions")).WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"revoked_at"}).AddRow(time.Now()))
+	assert.EqualError(t, p.sessions.verify(ctx, id), "session has ended")
+	assert.EqualError(t, p.sessions.verify(ctx, id), "session has ended")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func mustEncrypt(t *testing.T, p *OIDCProvider, refreshToken string) []byte {
+	encrypted, err := p.sessions.encrypt(refreshToken)
+	assert.NoError(t, err)
+	return encrypted
+}
+
+func TestRefreshSessionErrors(t *testing.T) {
+	p, mock, _, closeServer := newTestProvider(t, true)
+	defer closeServer()
+
+	apiToken := &Claims{StandardClaims: &jwt.StandardClaims{Id: "b1a8b52f-6ea1-4c2e-b5cb-6c1b2bd4a0b1", Issuer: APITokenIssuer}}
+	_, err := p.RefreshSession(context.Background(), apiToken)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.NoError(t, p.RevokeSession(context.Background(), apiToken))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT refresh_token, revoked_at FROM authn_sessions")).
+		WillReturnRows(sqlmock.NewRows([]string{"refresh_token", "revoked_at"}))
+	_, err = p.RefreshSession(context.Background(), &Claims{StandardClaims: &jwt.StandardClaims{Id: "b1a8b52f-6ea1-4c2e-b5cb-6c1b2bd4a0b1"}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	p.sessions = nil
+	_, err = p.RefreshSession(context.Background(), &Claims{StandardClaims: &jwt.StandardClaims{Id: "b1a8b52f-6ea1-4c2e-b5cb-6c1b2bd4a0b1"}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, p.RevokeSession(context.Background(), &Claims{StandardClaims: &jwt.StandardClaims{Id: "b1a8b52f-6ea1-4c2e-b5cb-6c1b2bd4a0b1"}}))
+}
@@ -0,0 +1,412 @@
+package authn
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authnv1 "github.com/lyft/clutch/backend/api/authn/v1"
+	authnconfigv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
+	"github.com/lyft/clutch/backend/middleware"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/db/postgres"
+)
+
+// APITokenIssuer is the issuer of API tokens, which distinguishes them from the session tokens issued after logging in.
+const APITokenIssuer = "clutch"
+
+const defaultMaxTokenTTL = 365 * 24 * time.Hour
+
+// How long the result of checking a token or session against the database is reused. Revoking a token or ending a
+// session clears the result on the gateway instance that did so, but other instances keep accepting it until their
+// result expires.
+const verifyCacheTTL = 10 * time.Second
+
+// TokenIssuer is implemented by providers that can issue long-lived API tokens, e.g. for service accounts and CLI
+// automation.
+type TokenIssuer interface {
+	// CreateToken issues a token for the subject on behalf of the user in the context, who must be an admin unless the
+	// subject is empty or the user themselves. It returns the description of the token along with the signed token,
+	// which is not stored.
+	CreateToken(ctx context.Context, name, subject string, groups, scopes []string, ttl time.Duration) (*authnv1.Token, string, error)
+	// ListTokens lists the tokens of the subject, or of every subject if it is empty and the user in the context is an
+	// admin. Other users can only list their own tokens.
+	ListTokens(ctx context.Context, subject string, includeRevoked bool) ([]*authnv1.Token, error)
+	// RevokeToken revokes a token that the user in the context created or that authenticates as them, or any token if
+	// they're an admin.
+	RevokeToken(ctx context.Context, id string) error
+}
+
+// tokenStore keeps track of the issued API tokens so that they can be listed and revoked.
+type tokenStore struct {
+	db          *sql.DB
+	maxTTL      time.Duration
+	adminGroups []string
+
+	verified *verifyCache
+}
+
+func newTokenStore(config *authnconfigv1.APITokens) (*tokenStore, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	db, ok := service.Registry.Get(config.DbProvider)
+	if !ok {
+		return nil, fmt.Errorf("unable to get database provider '%s'", config.DbProvider)
+	}
+	sqlDB, ok := db.(postgres.Client)
+	if !ok {
+		return nil, errors.New("database in registry does not implement required interface")
+	}
+
+	s := &tokenStore{
+		db:          sqlDB.DB(),
+		maxTTL:      defaultMaxTokenTTL,
+		adminGroups: config.AdminGroups,
+		verified:    newVerifyCache(),
+	}
+	if config.MaxTtl != nil {
+		maxTTL, err := ptypes.Duration(config.MaxTtl)
+		if err != nil {
+			return nil, err
+		}
+		s.maxTTL = maxTTL
+	}
+	return s, nil
+}
+
+// IsAPIToken returns true if the claims are from an API token rather than a session.
+func (c *Claims) IsAPIToken() bool {
+	return c.StandardClaims != nil && c.Issuer == APITokenIssuer
+}
+
+var errTokensDisabled = status.Error(codes.FailedPrecondition, "API tokens are not enabled")
+
+// caller returns the claims of the user managing tokens. Tokens can't be managed anonymously, since they're owned by
+// the caller.
+func caller(ctx context.Context) (*Claims, error) {
+	claims, err := ClaimsFromContext(ctx)
+	if err != nil || claims.Subject == AnonymousSubject {
+		return nil, status.Error(codes.Unauthenticated, "must be logged in to manage API tokens")
+	}
+	return claims, nil
+}
+
+func (s *tokenStore) isAdmin(claims *Claims) bool {
+	for _, admin := range s.adminGroups {
+		for _, group := range claims.Groups {
+			if group == admin {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// limitScopes returns the requested scopes that are within the scopes of the caller's token. Session tokens aren't
+// scoped, so the requested scopes are returned as is.
+func limitScopes(requested, allowed []string) []string {
+	if len(allowed) == 0 {
+		return requested
+	}
+
+	var ret []string
+	for _, r := range requested {
+		for _, a := range allowed {
+			switch {
+			case middleware.MatchMethodOrResource(a, r):
+				// The requested scope is narrower, e.g. a method within an allowed service.
+				ret = appendUnique(ret, r)
+			case middleware.MatchMethodOrResource(r, a):
+				// The requested scope is broader, so it's narrowed to the allowed scope.
+				ret = appendUnique(ret, a)
+			}
+		}
+	}
+	return ret
+}
+
+func appendUnique(l []string, s string) []string {
+	for _, v := range l {
+		if v == s {
+			return l
+		}
+	}
+	return append(l, s)
+}
+
+func (p *OIDCProvider) CreateToken(ctx context.Context, name, subject string, groups, scopes []string, ttl time.Duration) (*authnv1.Token, string, error) {
+	if p.tokens == nil {
+		return nil, "", errTokensDisabled
+	}
+	if ttl > p.tokens.maxTTL {
+		return nil, "", status.Errorf(codes.InvalidArgument, "ttl exceeds the maximum of %s", p.tokens.maxTTL)
+	}
+
+	c, err := caller(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	admin := p.tokens.isAdmin(c)
+
+	if subject == "" {
+		subject = c.Subject
+	}
+	if subject != c.Subject && !admin {
+		return nil, "", status.Error(codes.PermissionDenied, "only admins can create tokens for other subjects")
+	}
+	if len(groups) == 0 && subject == c.Subject {
+		groups = c.Groups
+	}
+	if !admin {
+		for _, g := range groups {
+			if !containsString(c.Groups, g) {
+				return nil, "", status.Errorf(codes.PermissionDenied, "only admins can create tokens with group '%s'", g)
+			}
+		}
+	}
+
+	// A scoped token can't be used to create a token that can call more methods.
+	scopes = limitScopes(scopes, c.Scopes)
+	if len(scopes) == 0 {
+		return nil, "", status.Error(codes.PermissionDenied, "none of the requested scopes are within the scopes of the caller's token")
+	}
+
+	// Postgres stores times with microsecond precision.
+	now := time.Now().Truncate(time.Microsecond)
+	expiresAt := now.Add(ttl)
+	claims := &Claims{
+		StandardClaims: &jwt.StandardClaims{
+			Id:        uuid.New().String(),
+			Issuer:    APITokenIssuer,
+			Subject:   subject,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: expiresAt.Unix(),
+		},
+		Groups: groups,
+		Scopes: scopes,
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(p.sessionSecret))
+	if err != nil {
+		return nil, "", err
+	}
+
+	token := &authnv1.Token{
+		Id:        claims.Id,
+		Name:      name,
+		Subject:   subject,
+		Groups:    groups,
+		Scopes:    scopes,
+		CreatedBy: c.Subject,
+	}
+	if token.CreatedAt, err = ptypes.TimestampProto(now); err != nil {
+		return nil, "", err
+	}
+	if token.ExpiresAt, err = ptypes.TimestampProto(expiresAt); err != nil {
+		return nil, "", err
+	}
+
+	if err := p.tokens.insert(ctx, token, now, expiresAt); err != nil {
+		return nil, "", err
+	}
+	return token, signed, nil
+}
+
+func (p *OIDCProvider) ListTokens(ctx context.Context, subject string, includeRevoked bool) ([]*authnv1.Token, error) {
+	if p.tokens == nil {
+		return nil, errTokensDisabled
+	}
+
+	c, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !p.tokens.isAdmin(c) {
+		if subject != "" && subject != c.Subject {
+			return nil, status.Error(codes.PermissionDenied, "only admins can list the tokens of other subjects")
+		}
+		subject = c.Subject
+	}
+	return p.tokens.list(ctx, subject, includeRevoked)
+}
+
+func (p *OIDCProvider) RevokeToken(ctx context.Context, id string) error {
+	if p.tokens == nil {
+		return errTokensDisabled
+	}
+
+	c, err := caller(ctx)
+	if err != nil {
+		return err
+	}
+	owner := c.Subject
+	if p.tokens.isAdmin(c) {
+		owner = ""
+	}
+	return p.tokens.revoke(ctx, id, owner)
+}
+
+const insertTokenStatement = `
+INSERT INTO authn_tokens (id, name, subject, groups, scopes, created_by, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+func (s *tokenStore) insert(ctx context.Context, t *authnv1.Token, createdAt, expiresAt time.Time) error {
+	_, err := s.db.ExecContext(ctx, insertTokenStatement,
+		t.Id, t.Name, t.Subject, pq.Array(t.Groups), pq.Array(t.Scopes), t.CreatedBy, createdAt, expiresAt)
+	return err
+}
+
+const listTokensStatement = `
+SELECT id, name, subject, groups, scopes, created_by, created_at, expires_at, revoked_at FROM authn_tokens
+WHERE ($1 = '' OR subject = $1) AND ($2 OR revoked_at IS NULL)
+ORDER BY created_at
+`
+
+func (s *tokenStore) list(ctx context.Context, subject string, includeRevoked bool) ([]*authnv1.Token, error) {
+	rows, err := s.db.QueryContext(ctx, listTokensStatement, subject, includeRevoked)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tokens []*authnv1.Token
+	for rows.Next() {
+		t := &authnv1.Token{}
+		var createdAt, expiresAt time.Time
+		var revokedAt sql.NullTime
+		if err := rows.Scan(&t.Id, &t.Name, &t.Subject, pq.Array(&t.Groups), pq.Array(&t.Scopes), &t.CreatedBy,
+			&createdAt, &expiresAt, &revokedAt); err != nil {
+			return nil, err
+		}
+
+		if t.CreatedAt, err = ptypes.TimestampProto(createdAt); err != nil {
+			return nil, err
+		}
+		if t.ExpiresAt, err = ptypes.TimestampProto(expiresAt); err != nil {
+			return nil, err
+		}
+		if revokedAt.Valid {
+			if t.RevokedAt, err = ptypes.TimestampProto(revokedAt.Time); err != nil {
+				return nil, err
+			}
+		}
+		tokens = append(tokens, t)
+	}
+	return tokens, rows.Err()
+}
+
+// Revoking a token twice keeps the time it was first revoked. Unless the owner is empty, only tokens that authenticate
+// as the owner or that the owner created are revoked.
+const revokeTokenStatement = `
+UPDATE authn_tokens SET revoked_at = COALESCE(revoked_at, NOW())
+WHERE id = $1 AND ($2 = '' OR subject = $2 OR created_by = $2)
+`
+
+// revoke revokes a token on behalf of the owner. Tokens of other users are reported as not found so that their IDs
+// aren't revealed.
+func (s *tokenStore) revoke(ctx context.Context, id, owner string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return status.Errorf(codes.NotFound, "token '%s' not found", id)
+	}
+
+	result, err := s.db.ExecContext(ctx, revokeTokenStatement, id, owner)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return status.Errorf(codes.NotFound, "token '%s' not found", id)
+	}
+
+	s.verified.delete(id)
+	return nil
+}
+
+const verifyTokenStatement = `SELECT revoked_at FROM authn_tokens WHERE id = $1`
+
+// verify checks that a token was issued by the gateway and hasn't been revoked. Its signature and expiry are checked
+// along with those of session tokens. The result is cached for verifyCacheTTL so that the database isn't queried on
+// every request.
+func (s *tokenStore) verify(ctx context.Context, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return errors.New("token has an invalid ID")
+	}
+
+	if err, ok := s.verified.get(id); ok {
+		return err
+	}
+
+	var revokedAt sql.NullTime
+	err := s.db.QueryRowContext(ctx, verifyTokenStatement, id).Scan(&revokedAt)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		err = errors.New("token was not issued by this gateway")
+	case err != nil:
+		return fmt.Errorf("unable to verify token: %w", err)
+	case revokedAt.Valid:
+		err = errors.New("token has been revoked")
+	}
+
+	s.verified.put(id, err)
+	return err
+}
+
+// verifyCache holds the outcome of checking tokens and sessions against the database for verifyCacheTTL. Database
+// errors aren't cached.
+type verifyCache struct {
+	mu      sync.Mutex
+	results map[string]verifyResult
+}
+
+type verifyResult struct {
+	err       error
+	expiresAt time.Time
+}
+
+func newVerifyCache() *verifyCache {
+	return &verifyCache{results: make(map[string]verifyResult)}
+}
+
+func (c *verifyCache) get(id string) (error, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	r, ok := c.results[id]
+	if !ok || !time.Now().Before(r.expiresAt) {
+		return nil, false
+	}
+	return r.err, true
+}
+
+func (c *verifyCache) put(id string, err error) {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Expired results are dropped as new ones are added, so that the cache only holds IDs in recent use.
+	for k, v := range c.results {
+		if !now.Before(v.expiresAt) {
+			delete(c.results, k)
+		}
+	}
+	c.results[id] = verifyResult{err: err, expiresAt: now.Add(verifyCacheTTL)}
+}
+
+func (c *verifyCache) delete(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.results, id)
+}
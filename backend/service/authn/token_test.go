@@ -0,0 +1,240 @@
+package authn
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authnconfigv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
+)
+
+func newTokenProvider(t *testing.T) (*OIDCProvider, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	p := &OIDCProvider{
+		sessionSecret: "this-is-my-secret",
+		tokens: &tokenStore{
+			db:          db,
+			maxTTL:      defaultMaxTokenTTL,
+			adminGroups: []string{"admins"},
+			verified:    newVerifyCache(),
+		},
+	}
+	return p, mock
+}
+
+func contextWithCaller(subject string, groups, scopes []string) context.Context {
+	return ContextWithClaims(context.Background(), &Claims{
+		StandardClaims: &jwt.StandardClaims{Subject: subject},
+		Groups:         groups,
+		Scopes:         scopes,
+	})
+}
+
+func TestDependencies(t *testing.T) {
+	deps, err := Dependencies(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, deps)
+
+	cfg, _ := ptypes.MarshalAny(&authnconfigv1.Config{
+		ApiTokens: &authnconfigv1.APITokens{DbProvider: "clutch.service.db.postgres"},
+	})
+	deps, err = Dependencies(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"clutch.service.db.postgres"}, deps)
+}
+
+func TestCreateAndVerifyToken(t *testing.T) {
+	p, mock := newTokenProvider(t)
+	ctx := contextWithCaller("admin@example.com", []string{"admins"}, nil)
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO authn_tokens")).
+		WithArgs(sqlmock.AnyArg(), "deploys", "svc-deploy", sqlmock.AnyArg(), sqlmock.AnyArg(), "admin@example.com", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	token, signed, err := p.CreateToken(ctx, "deploys", "svc-deploy", []string{"deployers"}, []string{"/clutch.k8s.v1.K8sAPI/*"}, time.Hour)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, token.Id)
+	assert.Equal(t, "admin@example.com", token.CreatedBy)
+	assert.Equal(t, int64(3600), token.ExpiresAt.Seconds-token.CreatedAt.Seconds)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT revoked_at FROM authn_tokens")).
+		WithArgs(token.Id).
+		WillReturnRows(sqlmock.NewRows([]string{"revoked_at"}).AddRow(nil))
+
+	claims, err := p.Verify(context.Background(), signed)
+	assert.NoError(t, err)
+	assert.True(t, claims.IsAPIToken())
+	assert.Equal(t, token.Id, claims.Id)
+	assert.Equal(t, "svc-deploy", claims.Subject)
+	assert.Equal(t, []string{"deployers"}, claims.Groups)
+	assert.Equal(t, []string{"/clutch.k8s.v1.K8sAPI/*"}, claims.Scopes)
+
+	// The result is cached.
+	_, err = p.Verify(context.Background(), signed)
+	assert.NoError(t, err)
+
+	// Once revoked, the token is rejected.
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE authn_tokens SET revoked_at")).
+		WithArgs(token.Id, "").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, p.RevokeToken(ctx, token.Id))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT revoked_at FROM authn_tokens")).
+		WithArgs(token.Id).
+		WillReturnRows(sqlmock.NewRows([]string{"revoked_at"}).AddRow(time.Now()))
+
+	_, err = p.Verify(context.Background(), signed)
+	assert.EqualError(t, err, "token has been revoked")
+	_, err = p.Verify(context.Background(), signed)
+	assert.EqualError(t, err, "token has been revoked")
+
+	// Tokens are rejected if they're no longer enabled.
+	p.tokens = nil
+	_, err = p.Verify(context.Background(), signed)
+	assert.Error(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestVerifyTokenDatabaseError(t *testing.T) {
+	p, mock := newTokenProvider(t)
+	id := "b1a8b52f-6ea1-4c2e-b5cb-6c1b2bd4a0b1"
+
+	// Errors reading the database aren't cached.
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT revoked_at FROM authn_tokens")).WithArgs(id).WillReturnError(errors.New("connection refused"))
+	assert.Error(t, p.tokens.verify(context.Background(), id))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT revoked_at FROM authn_tokens")).WithArgs(id).WillReturnError(sql.ErrNoRows)
+	assert.EqualError(t, p.tokens.verify(context.Background(), id), "token was not issued by this gateway")
+	assert.EqualError(t, p.tokens.verify(context.Background(), id), "token was not issued by this gateway")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateTokenMaxTTL(t *testing.T) {
+	p, mock := newTokenProvider(t)
+	_, _, err := p.CreateToken(contextWithCaller("admin@example.com", []string{"admins"}, nil), "deploys", "svc-deploy", nil, []string{"*"}, 2*defaultMaxTokenTTL)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateTokenPermissions(t *testing.T) {
+	p, mock := newTokenProvider(t)
+	user := contextWithCaller("user@example.com", []string{"eng"}, nil)
+
+	_, _, err := p.CreateToken(context.Background(), "cli", "", nil, []string{"*"}, time.Hour)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, _, err = p.CreateToken(ContextWithAnonymousClaims(context.Background()), "cli", "", nil, []string{"*"}, time.Hour)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, _, err = p.CreateToken(user, "cli", "svc-deploy", nil, []string{"*"}, time.Hour)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, _, err = p.CreateToken(user, "cli", "", []string{"admins"}, []string{"*"}, time.Hour)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Without a subject, the token authenticates as the caller with the caller's groups.
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO authn_tokens")).
+		WithArgs(sqlmock.AnyArg(), "cli", "user@example.com", sqlmock.AnyArg(), sqlmock.AnyArg(), "user@example.com", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	token, _, err := p.CreateToken(user, "cli", "", nil, []string{"*"}, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, "user@example.com", token.Subject)
+	assert.Equal(t, []string{"eng"}, token.Groups)
+
+	// A token created with a scoped token can't call more methods.
+	scoped := contextWithCaller("user@example.com", []string{"eng"}, []string{"/clutch.k8s.v1.K8sAPI/*"})
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO authn_tokens")).WillReturnResult(sqlmock.NewResult(0, 1))
+	token, _, err = p.CreateToken(scoped, "cli", "", nil, []string{"*"}, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/clutch.k8s.v1.K8sAPI/*"}, token.Scopes)
+
+	_, _, err = p.CreateToken(scoped, "cli", "", nil, []string{"/clutch.aws.ec2.v1.EC2API/*"}, time.Hour)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLimitScopes(t *testing.T) {
+	testCases := []struct {
+		requested []string
+		allowed   []string
+		expected  []string
+	}{
+		{requested: []string{"*"}, expected: []string{"*"}},
+		{requested: []string{"*"}, allowed: []string{"/clutch.k8s.v1.K8sAPI/*"}, expected: []string{"/clutch.k8s.v1.K8sAPI/*"}},
+		{
+			requested: []string{"/clutch.k8s.v1.K8sAPI/DescribePod", "/clutch.aws.ec2.v1.EC2API/*"},
+			allowed:   []string{"/clutch.k8s.v1.K8sAPI/*"},
+			expected:  []string{"/clutch.k8s.v1.K8sAPI/DescribePod"},
+		},
+		{requested: []string{"/clutch.aws.ec2.v1.EC2API/*"}, allowed: []string{"/clutch.k8s.v1.K8sAPI/*"}},
+	}
+
+	for _, tt := range testCases {
+		assert.Equal(t, tt.expected, limitScopes(tt.requested, tt.allowed))
+	}
+}
+
+func TestListTokens(t *testing.T) {
+	p, mock := newTokenProvider(t)
+
+	now := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "name", "subject", "groups", "scopes", "created_by", "created_at", "expires_at", "revoked_at"}).
+		AddRow("b1a8b52f-6ea1-4c2e-b5cb-6c1b2bd4a0b1", "deploys", "svc-deploy", "{deployers}", "{*}", "admin@example.com", now, now.Add(time.Hour), nil).
+		AddRow("0f0c2c54-1f7b-4a43-9a0c-7f0a2d7c6a11", "cli", "svc-deploy", "{}", "{*}", "admin@example.com", now, now.Add(time.Hour), now)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, subject")).WithArgs("svc-deploy", true).WillReturnRows(rows)
+
+	admin := contextWithCaller("admin@example.com", []string{"admins"}, nil)
+	tokens, err := p.ListTokens(admin, "svc-deploy", true)
+	assert.NoError(t, err)
+	assert.Len(t, tokens, 2)
+	assert.Equal(t, "deploys", tokens[0].Name)
+	assert.Equal(t, []string{"deployers"}, tokens[0].Groups)
+	assert.Nil(t, tokens[0].RevokedAt)
+	assert.NotNil(t, tokens[1].RevokedAt)
+
+	// Other users can only list their own tokens.
+	user := contextWithCaller("user@example.com", []string{"eng"}, nil)
+	_, err = p.ListTokens(user, "svc-deploy", false)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, subject")).WithArgs("user@example.com", false).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "subject", "groups", "scopes", "created_by", "created_at", "expires_at", "revoked_at"}))
+	tokens, err = p.ListTokens(user, "", false)
+	assert.NoError(t, err)
+	assert.Empty(t, tokens)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRevokeToken(t *testing.T) {
+	p, mock := newTokenProvider(t)
+
+	admin := contextWithCaller("admin@example.com", []string{"admins"}, nil)
+	user := contextWithCaller("user@example.com", []string{"eng"}, nil)
+
+	id := "b1a8b52f-6ea1-4c2e-b5cb-6c1b2bd4a0b1"
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE authn_tokens SET revoked_at")).WithArgs(id, "").WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, p.RevokeToken(admin, id))
+
+	// Users can only revoke their own tokens, and other tokens aren't found.
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE authn_tokens SET revoked_at")).WithArgs(id, "user@example.com").WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.Equal(t, codes.NotFound, status.Code(p.RevokeToken(user, id)))
+
+	assert.Equal(t, codes.NotFound, status.Code(p.RevokeToken(admin, "not-a-uuid")))
+	assert.Equal(t, codes.Unauthenticated, status.Code(p.RevokeToken(context.Background(), id)))
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	p.tokens = nil
+	assert.Equal(t, codes.FailedPrecondition, status.Code(p.RevokeToken(admin, id)))
+}
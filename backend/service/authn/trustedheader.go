@@ -0,0 +1,90 @@
+package authn
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/dgrijalva/jwt-go"
+
+	authnv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
+	"github.com/lyft/clutch/backend/clientinfo"
+)
+
+const defaultGroupsSeparator = ","
+
+// TrustedHeaderProvider authenticates requests with the identity in headers set by a proxy, which are only accepted
+// from the proxy's address.
+type TrustedHeaderProvider struct {
+	subjectHeader   string
+	groupsHeader    string
+	groupsSeparator string
+	allowed         []*net.IPNet
+}
+
+func NewTrustedHeaderProvider(config *authnv1.TrustedHeader) (*TrustedHeaderProvider, error) {
+	p := &TrustedHeaderProvider{
+		subjectHeader:   config.SubjectHeader,
+		groupsHeader:    config.GroupsHeader,
+		groupsSeparator: config.GroupsSeparator,
+	}
+	if p.groupsSeparator == "" {
+		p.groupsSeparator = defaultGroupsSeparator
+	}
+
+	for _, cidr := range config.AllowedCidrs {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR '%s': %w", cidr, err)
+		}
+		p.allowed = append(p.allowed, n)
+	}
+	return p, nil
+}
+
+func (p *TrustedHeaderProvider) ClientInfo() ([]string, bool) {
+	headers := []string{p.subjectHeader}
+	if p.groupsHeader != "" {
+		headers = append(headers, p.groupsHeader)
+	}
+	return headers, true
+}
+
+func (p *TrustedHeaderProvider) AuthenticateRequest(ctx context.Context) (*Claims, error) {
+	subject := strings.TrimSpace(clientinfo.Header(ctx, p.subjectHeader))
+	if subject == "" {
+		return nil, fmt.Errorf("%w: header '%s' not present", ErrNoCredentials, p.subjectHeader)
+	}
+
+	addr := clientinfo.Address(ctx)
+	if !p.isAllowed(addr) {
+		return nil, fmt.Errorf("header '%s' is not accepted from address '%s'", p.subjectHeader, addr)
+	}
+
+	var groups []string
+	if p.groupsHeader != "" {
+		for _, g := range strings.Split(clientinfo.Header(ctx, p.groupsHeader), p.groupsSeparator) {
+			groups = append(groups, strings.TrimSpace(g))
+		}
+		// Drops empty groups and duplicates.
+		groups = (&claimMapping{}).rewriteGroups(groups)
+	}
+
+	return &Claims{
+		StandardClaims: &jwt.StandardClaims{Subject: subject},
+		Groups:         groups,
+	}, nil
+}
+
+func (p *TrustedHeaderProvider) isAllowed(addr net.IP) bool {
+	if addr == nil {
+		return false
+	}
+	for _, n := range p.allowed {
+		if n.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	authnv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
+	"github.com/lyft/clutch/backend/clientinfo"
+)
+
+// gatewayContext returns the context of a request that came through the JSON gateway with the metadata.
+func gatewayContext(md metadata.MD) context.Context {
+	var ctx context.Context
+	handler := clientinfo.LoopbackHandler(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) { ctx = r.Context() }))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil))
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+func TestTrustedHeaderProvider(t *testing.T) {
+	p, err := NewTrustedHeaderProvider(&authnv1.TrustedHeader{
+		SubjectHeader: "X-Forwarded-Email",
+		GroupsHeader:  "X-Forwarded-Groups",
+		AllowedCidrs:  []string{"10.0.0.0/8", "fd00::/8"},
+	})
+	assert.NoError(t, err)
+
+	// Through the JSON gateway.
+	claims, err := p.AuthenticateRequest(gatewayContext(metadata.Pairs(
+		"x-forwarded-for", "10.1.2.3",
+		"grpcgateway-x-forwarded-email", "user@example.com",
+		"grpcgateway-x-forwarded-groups", "sre, admin,,sre",
+	)))
+	assert.NoError(t, err)
+	assert.Equal(t, "user@example.com", claims.Subject)
+	assert.Equal(t, []string{"admin", "sre"}, claims.Groups)
+	assert.False(t, claims.IsAPIToken())
+
+	// Directly over gRPC.
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("fd00::1"), Port: 1234}})
+	claims, err = p.AuthenticateRequest(metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-email", "user@example.com")))
+	assert.NoError(t, err)
+	assert.Equal(t, "user@example.com", claims.Subject)
+	assert.Empty(t, claims.Groups)
+
+	// Without the subject header, other providers can be tried.
+	_, err = p.AuthenticateRequest(gatewayContext(metadata.Pairs("x-forwarded-for", "10.1.2.3")))
+	assert.True(t, errors.Is(err, ErrNoCredentials))
+
+	// The headers are rejected from other addresses.
+	_, err = p.AuthenticateRequest(gatewayContext(metadata.Pairs(
+		"x-forwarded-for", "10.1.2.3, 192.0.2.1",
+		"grpcgateway-x-forwarded-email", "user@example.com",
+	)))
+	assert.EqualError(t, err, "header 'X-Forwarded-Email' is not accepted from address '192.0.2.1'")
+	assert.False(t, errors.Is(err, ErrNoCredentials))
+
+	// Headers that weren't forwarded by the gateway are ignored.
+	_, err = p.AuthenticateRequest(gatewayContext(metadata.Pairs("x-forwarded-for", "10.1.2.3", "x-forwarded-email", "user@example.com")))
+	assert.True(t, errors.Is(err, ErrNoCredentials))
+}
+
+func TestTrustedHeaderProviderSeparator(t *testing.T) {
+	p, err := NewTrustedHeaderProvider(&authnv1.TrustedHeader{
+		SubjectHeader:   "X-Forwarded-User",
+		GroupsHeader:    "X-Forwarded-Groups",
+		GroupsSeparator: ";",
+		AllowedCidrs:    []string{"10.0.0.0/8"},
+	})
+	assert.NoError(t, err)
+
+	claims, err := p.AuthenticateRequest(gatewayContext(metadata.Pairs(
+		"x-forwarded-for", "10.1.2.3",
+		"grpcgateway-x-forwarded-user", "user",
+		"grpcgateway-x-forwarded-groups", "cn=sre,dc=example;cn=admin,dc=example",
+	)))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cn=admin,dc=example", "cn=sre,dc=example"}, claims.Groups)
+}
+
+func TestTrustedHeaderProviderInvalidCIDR(t *testing.T) {
+	_, err := NewTrustedHeaderProvider(&authnv1.TrustedHeader{SubjectHeader: "X-Forwarded-Email", AllowedCidrs: []string{"10.0.0.0"}})
+	assert.Error(t, err)
+}
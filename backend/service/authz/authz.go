@@ -6,7 +6,9 @@ package authz
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
@@ -25,11 +27,29 @@ type Client interface {
 	Check(ctx context.Context, request *authzv1.CheckRequest) (*authzv1.CheckResponse, error)
 }
 
+// Dependencies returns the database provider if roles and role bindings are stored in a database.
+func Dependencies(cfg *any.Any) ([]string, error) {
+	config := &authzcfgv1.Config{}
+	if cfg != nil {
+		if err := ptypes.UnmarshalAny(cfg, config); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.Database != nil {
+		return []string{config.Database.DbProvider}, nil
+	}
+	return nil, nil
+}
+
 func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service, error) {
 	config := &authzcfgv1.Config{}
 	if err := ptypes.UnmarshalAny(cfg, config); err != nil {
 		return nil, err
 	}
+	if config.Database != nil {
+		return newDatabaseImpl(logger, scope, config)
+	}
 	return newStaticImpl(logger, config)
 }
 
@@ -52,6 +72,9 @@ type principalToRoleMap map[principalKey][]string
 type roleToPolicyMap map[string]*authzcfgv1.Role
 
 type staticImpl struct {
+	// Guards the maps below, which are replaced when the service is reconfigured.
+	mu sync.RWMutex
+
 	// Map of policy role names to the policy object.
 	roleToPolicy roleToPolicyMap
 
@@ -118,7 +141,38 @@ func configToPrincipalRoleMap(config *authzcfgv1.Config) principalToRoleMap {
 	return principalToRole
 }
 
-func newStaticImpl(logger *zap.Logger, config *authzcfgv1.Config) (Client, error) {
+func newStaticImpl(logger *zap.Logger, config *authzcfgv1.Config) (*staticImpl, error) {
+	s := &staticImpl{}
+	if err := s.update(config); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Reconfigure replaces the roles and role bindings. The previous policy remains in effect if the new one is invalid.
+func (s *staticImpl) Reconfigure(cfg *any.Any) (func(), error) {
+	config := &authzcfgv1.Config{}
+	if err := ptypes.UnmarshalAny(cfg, config); err != nil {
+		return nil, err
+	}
+	if config.Database != nil {
+		return nil, errors.New("storing roles in a database requires a restart")
+	}
+	return s.prepare(config)
+}
+
+// update replaces the roles and role bindings with those in the config, unless it is invalid.
+func (s *staticImpl) update(config *authzcfgv1.Config) error {
+	apply, err := s.prepare(config)
+	if err != nil {
+		return err
+	}
+	apply()
+	return nil
+}
+
+// prepare computes the policy for the config and returns a function that puts it in effect.
+func (s *staticImpl) prepare(config *authzcfgv1.Config) (func(), error) {
 	// Compute map of role to policy.
 	roleToPolicy, err := configToRolePolicyMap(config)
 	if err != nil {
@@ -128,13 +182,21 @@ func newStaticImpl(logger *zap.Logger, config *authzcfgv1.Config) (Client, error
 	// Compute map of principal (user or group) to list of roles.
 	principalToRole := configToPrincipalRoleMap(config)
 
-	// Save on the struct for lookup at runtime.
-	return &staticImpl{
-		principalToRole: principalToRole,
-		roleToPolicy:    roleToPolicy,
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.roleToPolicy = roleToPolicy
+		s.principalToRole = principalToRole
 	}, nil
 }
 
+func (s *staticImpl) hasRole(name string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.roleToPolicy[name]
+	return ok
+}
+
 func assertPolicy(pol *authzcfgv1.Policy, req *authzv1.CheckRequest) bool {
 	// ActionTypes: if none specified or request action is in the policy's list, OK.
 	if len(pol.ActionTypes) != 0 {
@@ -197,6 +259,9 @@ func (s *staticImpl) evaluate(roles []string, req *authzv1.CheckRequest) *authzv
 }
 
 func (s *staticImpl) Check(ctx context.Context, req *authzv1.CheckRequest) (*authzv1.CheckResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	// Gather all of the roles for the user and/or groups.
 	var roles []string
 	if req.Subject.User != "" {
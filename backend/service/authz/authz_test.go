@@ -1,9 +1,11 @@
 package authz
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
 
 	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
@@ -117,3 +119,48 @@ func TestConfigToRolePolicyMap(t *testing.T) {
 	assert.NoError(t, err)
 	assert.EqualValues(t, roleToPolicyMap{"role-a": &authzcfgv1.Role{RoleName: "role-a", Policies: pol}}, result)
 }
+
+func TestReconfigure(t *testing.T) {
+	config := &authzcfgv1.Config{
+		Roles: []*authzcfgv1.Role{
+			{RoleName: "role-a", Policies: []*authzcfgv1.Policy{{Method: "/foo/Bar"}}},
+		},
+		RoleBindings: []*authzcfgv1.RoleBinding{
+			{To: []string{"role-a"}, Principals: []*authzcfgv1.Principal{newUserPrincipal("foo@example.com")}},
+		},
+	}
+	cfg, err := ptypes.MarshalAny(config)
+	assert.NoError(t, err)
+
+	svc, err := New(cfg, nil, nil)
+	assert.NoError(t, err)
+	c := svc.(*staticImpl)
+
+	req := &authzv1.CheckRequest{Subject: &authzv1.Subject{User: "foo@example.com"}, Method: "/foo/Bar"}
+	resp, err := c.Check(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, authzv1.Decision_ALLOW, resp.Decision)
+
+	// Binding to a role that doesn't exist is rejected and the previous policy is kept.
+	config.RoleBindings[0].To = []string{"role-b"}
+	cfg, err = ptypes.MarshalAny(config)
+	assert.NoError(t, err)
+	_, err = c.Reconfigure(cfg)
+	assert.Error(t, err)
+
+	resp, err = c.Check(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, authzv1.Decision_ALLOW, resp.Decision)
+
+	// Removing the binding takes effect.
+	config.RoleBindings = nil
+	cfg, err = ptypes.MarshalAny(config)
+	assert.NoError(t, err)
+	apply, err := c.Reconfigure(cfg)
+	assert.NoError(t, err)
+	apply()
+
+	resp, err = c.Check(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, authzv1.Decision_DENY, resp.Decision)
+}
@@ -0,0 +1,732 @@
+package authz
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authzv1 "github.com/lyft/clutch/backend/api/authz/v1"
+	authzcfgv1 "github.com/lyft/clutch/backend/api/config/service/authz/v1"
+	"github.com/lyft/clutch/backend/requestid"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/authn"
+	"github.com/lyft/clutch/backend/service/db/postgres"
+)
+
+const defaultRefreshInterval = 10 * time.Second
+
+// PolicyStore is implemented by clients whose roles and role bindings can be changed at runtime. Each change to the
+// policies of a role creates a new version, and the previous versions are kept so that the role can be rolled back.
+type PolicyStore interface {
+	ListRoles(ctx context.Context) ([]*authzv1.Role, error)
+	GetRole(ctx context.Context, name string) (*authzv1.Role, error)
+	CreateRole(ctx context.Context, name string, policies []*authzv1.Policy) (*authzv1.Role, error)
+	// UpdateRole replaces the policies of the role. If version is not zero, the role must be at that version.
+	UpdateRole(ctx context.Context, name string, policies []*authzv1.Policy, version int64) (*authzv1.Role, error)
+	// DeleteRole deletes a role that isn't bound to any principals. The versions of its policies are kept, and creating a
+	// role with the same name continues from the last version.
+	DeleteRole(ctx context.Context, name string) error
+
+	// ListPolicyVersions lists the versions of the role's policies, newest first, including those of deleted roles.
+	ListPolicyVersions(ctx context.Context, roleName string) ([]*authzv1.PolicyVersion, error)
+	// RollbackRole restores the policies of a previous version as a new version.
+	RollbackRole(ctx context.Context, name string, version int64) (*authzv1.Role, error)
+
+	// ListRoleBindings lists the bindings to the role, or to every role if it is empty.
+	ListRoleBindings(ctx context.Context, roleName string) ([]*authzv1.RoleBinding, error)
+	CreateRoleBinding(ctx context.Context, roleName string, principal *authzv1.Principal) (*authzv1.RoleBinding, error)
+	DeleteRoleBinding(ctx context.Context, id string) error
+}
+
+// databaseImpl evaluates the roles and role bindings stored in the database, as well as those in the config.
+type databaseImpl struct {
+	logger *zap.Logger
+	scope  tally.Scope
+
+	db              *sql.DB
+	database        *authzcfgv1.Database
+	refreshInterval time.Duration
+
+	// The roles and role bindings in the config, which can't be changed through the API, e.g. to grant access to the
+	// users who manage the others.
+	static *staticImpl
+	// The roles and role bindings in the database, which are reloaded after each change, and every refreshInterval to
+	// pick up changes made by other gateway instances. Until then, those instances keep allowing access that was revoked,
+	// and they keep the last roles they loaded for as long as the database can't be read.
+	cache *staticImpl
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newDatabaseImpl(logger *zap.Logger, scope tally.Scope, config *authzcfgv1.Config) (*databaseImpl, error) {
+	static, err := newStaticImpl(logger, config)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &databaseImpl{
+		logger:          logger,
+		scope:           scope.SubScope("authz"),
+		database:        config.Database,
+		refreshInterval: defaultRefreshInterval,
+		static:          static,
+		cache:           &staticImpl{},
+	}
+
+	if config.Database.RefreshInterval != nil {
+		interval, err := ptypes.Duration(config.Database.RefreshInterval)
+		if err != nil {
+			return nil, err
+		}
+		d.refreshInterval = interval
+	}
+
+	db, ok := service.Registry.Get(config.Database.DbProvider)
+	if !ok {
+		return nil, fmt.Errorf("unable to get database provider '%s'", config.Database.DbProvider)
+	}
+	sqlDB, ok := db.(postgres.Client)
+	if !ok {
+		return nil, errors.New("database in registry does not implement required interface")
+	}
+	d.db = sqlDB.DB()
+
+	return d, nil
+}
+
+// Reconfigure replaces the roles and role bindings from the config. Those in the database are unaffected.
+func (d *databaseImpl) Reconfigure(cfg *any.Any) (func(), error) {
+	config := &authzcfgv1.Config{}
+	if err := ptypes.UnmarshalAny(cfg, config); err != nil {
+		return nil, err
+	}
+	if !proto.Equal(config.Database, d.database) {
+		return nil, errors.New("changes to the database config require a restart")
+	}
+	return d.static.prepare(config)
+}
+
+// Start loads the roles and role bindings from the database and keeps refreshing them.
+func (d *databaseImpl) Start(ctx context.Context) error {
+	// A database outage shouldn't prevent the gateway from starting, so only the roles in the config are in effect until
+	// the database can be read.
+	if err := d.refresh(ctx); err != nil {
+		d.logger.Error("error loading roles", zap.Error(err))
+	}
+
+	ctx, d.cancel = context.WithCancel(ctx)
+	d.done = make(chan struct{})
+	go func() {
+		defer close(d.done)
+		d.poll(ctx)
+	}()
+	return nil
+}
+
+// Stop the refresh loop.
+func (d *databaseImpl) Stop(ctx context.Context) error {
+	if d.cancel == nil {
+		return nil
+	}
+	d.cancel()
+	select {
+	case <-d.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (d *databaseImpl) poll(ctx context.Context) {
+	ticker := time.NewTicker(d.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// The last known roles are kept if the database can't be read.
+			if err := d.refresh(ctx); err != nil {
+				d.scope.Counter("refresh_error").Inc(1)
+				d.logger.Warn("error refreshing roles", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (d *databaseImpl) refresh(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	config, err := d.load(ctx)
+	if err != nil {
+		return err
+	}
+	if err := d.cache.update(config); err != nil {
+		return err
+	}
+	d.scope.Gauge("roles").Update(float64(len(config.Roles)))
+	return nil
+}
+
+// refreshAfterChange updates the cache after a change to the database. Failures are logged rather than returned, since
+// the change has already been made, and is picked up by the next refresh.
+func (d *databaseImpl) refreshAfterChange(ctx context.Context) {
+	if err := d.refresh(ctx); err != nil {
+		d.scope.Counter("refresh_error").Inc(1)
+		requestid.Logger(ctx, d.logger).Warn("error refreshing roles", zap.Error(err))
+	}
+}
+
+const (
+	loadRolesStatement = `
+SELECT p.definition FROM authz_roles r JOIN authz_policies p ON p.role_name = r.name AND p.version = r.version
+WHERE r.deleted_at IS NULL
+`
+	loadRoleBindingsStatement = `SELECT role_name, principal_type, principal FROM authz_role_bindings`
+)
+
+// load reads the current version of each role, and the role bindings, in the format of the config.
+func (d *databaseImpl) load(ctx context.Context) (*authzcfgv1.Config, error) {
+	// The roles and role bindings are read from the same snapshot so that every bound role is present.
+	tx, err := d.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	config := &authzcfgv1.Config{}
+	rows, err := tx.QueryContext(ctx, loadRolesStatement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var definition string
+		if err := rows.Scan(&definition); err != nil {
+			return nil, err
+		}
+		role, err := unmarshalDefinition(definition)
+		if err != nil {
+			return nil, err
+		}
+		config.Roles = append(config.Roles, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	bindings, err := tx.QueryContext(ctx, loadRoleBindingsStatement)
+	if err != nil {
+		return nil, err
+	}
+	defer bindings.Close()
+	for bindings.Next() {
+		var roleName, principalType, principal string
+		if err := bindings.Scan(&roleName, &principalType, &principal); err != nil {
+			return nil, err
+		}
+		p, err := principalFromColumns(principalType, principal)
+		if err != nil {
+			return nil, err
+		}
+		config.RoleBindings = append(config.RoleBindings, &authzcfgv1.RoleBinding{
+			To:         []string{roleName},
+			Principals: []*authzcfgv1.Principal{principalToConfig(p)},
+		})
+	}
+	if err := bindings.Err(); err != nil {
+		return nil, err
+	}
+
+	return config, tx.Commit()
+}
+
+// The roles in the config are checked first, since they don't depend on the database.
+func (d *databaseImpl) Check(ctx context.Context, req *authzv1.CheckRequest) (*authzv1.CheckResponse, error) {
+	resp, err := d.static.Check(ctx, req)
+	if err != nil || resp.Decision == authzv1.Decision_ALLOW {
+		return resp, err
+	}
+	return d.cache.Check(ctx, req)
+}
+
+const listRolesStatement = `
+SELECT r.name, r.version, r.updated_by, r.updated_at, p.definition FROM authz_roles r
+JOIN authz_policies p ON p.role_name = r.name AND p.version = r.version
+WHERE r.deleted_at IS NULL AND ($1 = '' OR r.name = $1)
+ORDER BY r.name
+`
+
+func (d *databaseImpl) ListRoles(ctx context.Context) ([]*authzv1.Role, error) {
+	return d.listRoles(ctx, "")
+}
+
+func (d *databaseImpl) GetRole(ctx context.Context, name string) (*authzv1.Role, error) {
+	roles, err := d.listRoles(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		return nil, status.Errorf(codes.NotFound, "role '%s' not found", name)
+	}
+	return roles[0], nil
+}
+
+func (d *databaseImpl) listRoles(ctx context.Context, name string) ([]*authzv1.Role, error) {
+	rows, err := d.db.QueryContext(ctx, listRolesStatement, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var roles []*authzv1.Role
+	for rows.Next() {
+		r := &authzv1.Role{}
+		var updatedAt time.Time
+		var definition string
+		if err := rows.Scan(&r.Name, &r.Version, &r.UpdatedBy, &updatedAt, &definition); err != nil {
+			return nil, err
+		}
+
+		role, err := unmarshalDefinition(definition)
+		if err != nil {
+			return nil, err
+		}
+		r.Policies = policiesFromConfig(role.Policies)
+		if r.UpdateTime, err = ptypes.TimestampProto(updatedAt); err != nil {
+			return nil, err
+		}
+		roles = append(roles, r)
+	}
+	return roles, rows.Err()
+}
+
+const (
+	// A role that was deleted is restored with a new version, so that its previous versions are kept.
+	createRoleStatement = `
+INSERT INTO authz_roles (name, version, updated_by) VALUES ($1, 1, $2)
+ON CONFLICT (name) DO UPDATE
+SET version = authz_roles.version + 1, updated_by = EXCLUDED.updated_by, created_at = NOW(), updated_at = NOW(), deleted_at = NULL
+WHERE authz_roles.deleted_at IS NOT NULL
+RETURNING version
+`
+	createPolicyStatement = `INSERT INTO authz_policies (role_name, version, definition, created_by) VALUES ($1, $2, $3, $4)`
+)
+
+func (d *databaseImpl) CreateRole(ctx context.Context, name string, policies []*authzv1.Policy) (*authzv1.Role, error) {
+	if d.static.hasRole(name) {
+		return nil, status.Errorf(codes.AlreadyExists, "role '%s' is defined in the config", name)
+	}
+	definition, err := marshalDefinition(name, policies)
+	if err != nil {
+		return nil, err
+	}
+	username := usernameFromContext(ctx)
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var version int64
+	err = tx.QueryRowContext(ctx, createRoleStatement, name, username).Scan(&version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.AlreadyExists, "role '%s' already exists", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, createPolicyStatement, name, version, definition, username); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	requestid.Logger(ctx, d.logger).Info("role created", zap.String("role", name), zap.String("updatedBy", username))
+	d.refreshAfterChange(ctx)
+	return d.GetRole(ctx, name)
+}
+
+func (d *databaseImpl) UpdateRole(ctx context.Context, name string, policies []*authzv1.Policy, version int64) (*authzv1.Role, error) {
+	newVersion, err := d.setPolicies(ctx, name, policies, version)
+	if err != nil {
+		return nil, err
+	}
+
+	requestid.Logger(ctx, d.logger).Info("role updated",
+		zap.String("role", name),
+		zap.Int64("version", newVersion),
+		zap.String("updatedBy", usernameFromContext(ctx)),
+	)
+	d.refreshAfterChange(ctx)
+	return d.GetRole(ctx, name)
+}
+
+const getPolicyStatement = `SELECT definition FROM authz_policies WHERE role_name = $1 AND version = $2`
+
+func (d *databaseImpl) RollbackRole(ctx context.Context, name string, version int64) (*authzv1.Role, error) {
+	var definition string
+	err := d.db.QueryRowContext(ctx, getPolicyStatement, name, version).Scan(&definition)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "version %d of role '%s' not found", version, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	role, err := unmarshalDefinition(definition)
+	if err != nil {
+		return nil, err
+	}
+
+	newVersion, err := d.setPolicies(ctx, name, policiesFromConfig(role.Policies), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	requestid.Logger(ctx, d.logger).Info("role rolled back",
+		zap.String("role", name),
+		zap.Int64("fromVersion", version),
+		zap.Int64("version", newVersion),
+		zap.String("updatedBy", usernameFromContext(ctx)),
+	)
+	d.refreshAfterChange(ctx)
+	return d.GetRole(ctx, name)
+}
+
+const (
+	lockRoleStatement   = `SELECT version FROM authz_roles WHERE name = $1 AND deleted_at IS NULL FOR UPDATE`
+	updateRoleStatement = `UPDATE authz_roles SET version = $2, updated_by = $3, updated_at = NOW() WHERE name = $1`
+)
+
+// setPolicies stores the policies as a new version of the role and returns the version. If expectedVersion is not zero,
+// the role must be at that version.
+func (d *databaseImpl) setPolicies(ctx context.Context, name string, policies []*authzv1.Policy, expectedVersion int64) (int64, error) {
+	definition, err := marshalDefinition(name, policies)
+	if err != nil {
+		return 0, err
+	}
+	username := usernameFromContext(ctx)
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var version int64
+	err = tx.QueryRowContext(ctx, lockRoleStatement, name).Scan(&version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, status.Errorf(codes.NotFound, "role '%s' not found", name)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if expectedVersion != 0 && expectedVersion != version {
+		return 0, status.Errorf(codes.Aborted, "role '%s' is at version %d, not %d", name, version, expectedVersion)
+	}
+
+	version++
+	if _, err := tx.ExecContext(ctx, updateRoleStatement, name, version, username); err != nil {
+		return 0, err
+	}
+	if _, err := tx.ExecContext(ctx, createPolicyStatement, name, version, definition, username); err != nil {
+		return 0, err
+	}
+	return version, tx.Commit()
+}
+
+const (
+	roleBoundStatement  = `SELECT EXISTS (SELECT 1 FROM authz_role_bindings WHERE role_name = $1)`
+	deleteRoleStatement = `UPDATE authz_roles SET deleted_at = NOW(), updated_by = $2, updated_at = NOW() WHERE name = $1`
+)
+
+// DeleteRole marks the role as deleted rather than removing it, so that its previous versions can still be listed.
+func (d *databaseImpl) DeleteRole(ctx context.Context, name string) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The lock keeps the role from being bound until it is deleted.
+	var version int64
+	err = tx.QueryRowContext(ctx, lockRoleStatement, name).Scan(&version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Errorf(codes.NotFound, "role '%s' not found", name)
+	}
+	if err != nil {
+		return err
+	}
+
+	var bound bool
+	if err := tx.QueryRowContext(ctx, roleBoundStatement, name).Scan(&bound); err != nil {
+		return err
+	}
+	if bound {
+		return status.Errorf(codes.FailedPrecondition, "role '%s' is bound to principals, delete its role bindings first", name)
+	}
+
+	if _, err := tx.ExecContext(ctx, deleteRoleStatement, name, usernameFromContext(ctx)); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	requestid.Logger(ctx, d.logger).Info("role deleted", zap.String("role", name), zap.String("updatedBy", usernameFromContext(ctx)))
+	d.refreshAfterChange(ctx)
+	return nil
+}
+
+// The versions of deleted roles are kept, so they can still be listed.
+const listPolicyVersionsStatement = `
+SELECT version, definition, created_by, created_at FROM authz_policies WHERE role_name = $1 ORDER BY version DESC
+`
+
+func (d *databaseImpl) ListPolicyVersions(ctx context.Context, roleName string) ([]*authzv1.PolicyVersion, error) {
+	rows, err := d.db.QueryContext(ctx, listPolicyVersionsStatement, roleName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var versions []*authzv1.PolicyVersion
+	for rows.Next() {
+		v := &authzv1.PolicyVersion{RoleName: roleName}
+		var definition string
+		var createdAt time.Time
+		if err := rows.Scan(&v.Version, &definition, &v.CreatedBy, &createdAt); err != nil {
+			return nil, err
+		}
+
+		role, err := unmarshalDefinition(definition)
+		if err != nil {
+			return nil, err
+		}
+		v.Policies = policiesFromConfig(role.Policies)
+		if v.CreateTime, err = ptypes.TimestampProto(createdAt); err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Every role has at least one version.
+	if len(versions) == 0 {
+		return nil, status.Errorf(codes.NotFound, "role '%s' not found", roleName)
+	}
+	return versions, nil
+}
+
+const listRoleBindingsStatement = `
+SELECT id, role_name, principal_type, principal, created_by, created_at FROM authz_role_bindings
+WHERE ($1 = '' OR role_name = $1)
+ORDER BY role_name, principal_type, principal
+`
+
+func (d *databaseImpl) ListRoleBindings(ctx context.Context, roleName string) ([]*authzv1.RoleBinding, error) {
+	rows, err := d.db.QueryContext(ctx, listRoleBindingsStatement, roleName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bindings []*authzv1.RoleBinding
+	for rows.Next() {
+		b := &authzv1.RoleBinding{}
+		var principalType, principal string
+		var createdAt time.Time
+		if err := rows.Scan(&b.Id, &b.RoleName, &principalType, &principal, &b.CreatedBy, &createdAt); err != nil {
+			return nil, err
+		}
+
+		if b.Principal, err = principalFromColumns(principalType, principal); err != nil {
+			return nil, err
+		}
+		if b.CreateTime, err = ptypes.TimestampProto(createdAt); err != nil {
+			return nil, err
+		}
+		bindings = append(bindings, b)
+	}
+	return bindings, rows.Err()
+}
+
+// The role is locked so that it can't be deleted while it is being bound. Nothing is inserted if the role doesn't
+// exist or has been deleted.
+const createRoleBindingStatement = `
+INSERT INTO authz_role_bindings (id, role_name, principal_type, principal, created_by)
+SELECT $1::uuid, name, $3, $4, $5 FROM authz_roles WHERE name = $2 AND deleted_at IS NULL FOR SHARE
+RETURNING created_at
+`
+
+func (d *databaseImpl) CreateRoleBinding(ctx context.Context, roleName string, principal *authzv1.Principal) (*authzv1.RoleBinding, error) {
+	principalType, name, err := principalToColumns(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &authzv1.RoleBinding{
+		Id:        uuid.New().String(),
+		RoleName:  roleName,
+		Principal: principal,
+		CreatedBy: usernameFromContext(ctx),
+	}
+	var createdAt time.Time
+	err = d.db.QueryRowContext(ctx, createRoleBindingStatement, b.Id, roleName, principalType, name, b.CreatedBy).Scan(&createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "role '%s' not found", roleName)
+	}
+	if errorCode(err) == uniqueViolation {
+		return nil, status.Errorf(codes.AlreadyExists, "role '%s' is already bound to %s '%s'", roleName, principalType, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if b.CreateTime, err = ptypes.TimestampProto(createdAt); err != nil {
+		return nil, err
+	}
+
+	requestid.Logger(ctx, d.logger).Info("role binding created",
+		zap.String("role", roleName),
+		zap.String("principal", principalType+":"+name),
+		zap.String("updatedBy", b.CreatedBy),
+	)
+	d.refreshAfterChange(ctx)
+	return b, nil
+}
+
+const deleteRoleBindingStatement = `DELETE FROM authz_role_bindings WHERE id = $1`
+
+func (d *databaseImpl) DeleteRoleBinding(ctx context.Context, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return status.Errorf(codes.NotFound, "role binding '%s' not found", id)
+	}
+
+	result, err := d.db.ExecContext(ctx, deleteRoleBindingStatement, id)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return status.Errorf(codes.NotFound, "role binding '%s' not found", id)
+	}
+
+	requestid.Logger(ctx, d.logger).Info("role binding deleted", zap.String("id", id), zap.String("updatedBy", usernameFromContext(ctx)))
+	d.refreshAfterChange(ctx)
+	return nil
+}
+
+func usernameFromContext(ctx context.Context) string {
+	if claims, err := authn.ClaimsFromContext(ctx); err == nil {
+		return claims.Subject
+	}
+	return "UNKNOWN"
+}
+
+// The Postgres error code for unique constraint violations.
+const uniqueViolation = "23505"
+
+func errorCode(err error) pq.ErrorCode {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code
+	}
+	return ""
+}
+
+// marshalDefinition returns the role in the format of the config, after validating it like the config.
+func marshalDefinition(name string, policies []*authzv1.Policy) (string, error) {
+	role := &authzcfgv1.Role{RoleName: name, Policies: policiesToConfig(policies)}
+	if err := role.Validate(); err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	return (&jsonpb.Marshaler{OrigName: true}).MarshalToString(role)
+}
+
+func unmarshalDefinition(definition string) (*authzcfgv1.Role, error) {
+	role := &authzcfgv1.Role{}
+	u := &jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err := u.Unmarshal(strings.NewReader(definition), role); err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+func policiesToConfig(policies []*authzv1.Policy) []*authzcfgv1.Policy {
+	ret := make([]*authzcfgv1.Policy, len(policies))
+	for i, p := range policies {
+		ret[i] = &authzcfgv1.Policy{
+			PolicyName:  p.PolicyName,
+			ActionTypes: p.ActionTypes,
+			Method:      p.Method,
+			Resources:   p.Resources,
+		}
+	}
+	return ret
+}
+
+func policiesFromConfig(policies []*authzcfgv1.Policy) []*authzv1.Policy {
+	ret := make([]*authzv1.Policy, len(policies))
+	for i, p := range policies {
+		ret[i] = &authzv1.Policy{
+			PolicyName:  p.PolicyName,
+			ActionTypes: p.ActionTypes,
+			Method:      p.Method,
+			Resources:   p.Resources,
+		}
+	}
+	return ret
+}
+
+const (
+	principalTypeUser  = "user"
+	principalTypeGroup = "group"
+)
+
+func principalToColumns(p *authzv1.Principal) (string, string, error) {
+	switch t := p.GetType().(type) {
+	case *authzv1.Principal_User:
+		return principalTypeUser, t.User, nil
+	case *authzv1.Principal_Group:
+		return principalTypeGroup, t.Group, nil
+	default:
+		return "", "", status.Error(codes.InvalidArgument, "principal must be a user or a group")
+	}
+}
+
+func principalFromColumns(principalType, name string) (*authzv1.Principal, error) {
+	switch principalType {
+	case principalTypeUser:
+		return &authzv1.Principal{Type: &authzv1.Principal_User{User: name}}, nil
+	case principalTypeGroup:
+		return &authzv1.Principal{Type: &authzv1.Principal_Group{Group: name}}, nil
+	default:
+		return nil, fmt.Errorf("unknown principal type '%s'", principalType)
+	}
+}
+
+func principalToConfig(p *authzv1.Principal) *authzcfgv1.Principal {
+	if user, ok := p.Type.(*authzv1.Principal_User); ok {
+		return &authzcfgv1.Principal{Type: &authzcfgv1.Principal_User{User: user.User}}
+	}
+	return &authzcfgv1.Principal{Type: &authzcfgv1.Principal_Group{Group: p.GetGroup()}}
+}
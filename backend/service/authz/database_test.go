@@ -0,0 +1,399 @@
+package authz
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
+	authzv1 "github.com/lyft/clutch/backend/api/authz/v1"
+	authzcfgv1 "github.com/lyft/clutch/backend/api/config/service/authz/v1"
+)
+
+const (
+	adminDefinition    = `{"role_name":"admin","policies":[{"policy_name":"all","method":"*"}]}`
+	readOnlyDefinition = `{"role_name":"read-only","policies":[{"policy_name":"read","action_types":["READ"],"method":"*"}]}`
+)
+
+func newTestDatabaseImpl(t *testing.T, config *authzcfgv1.Config) (*databaseImpl, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	static, err := newStaticImpl(zaptest.NewLogger(t), config)
+	assert.NoError(t, err)
+	return &databaseImpl{
+		logger:          zaptest.NewLogger(t),
+		scope:           tally.NewTestScope("", nil),
+		db:              db,
+		database:        config.Database,
+		refreshInterval: defaultRefreshInterval,
+		static:          static,
+		cache:           &staticImpl{},
+	}, mock
+}
+
+// expectLoad expects the roles and role bindings to be read, with the given definitions and bindings of principals of
+// the form type:name to roles.
+func expectLoad(mock sqlmock.Sqlmock, definitions []string, bindings map[string]string) {
+	mock.ExpectBegin()
+	roles := sqlmock.NewRows([]string{"definition"})
+	for _, d := range definitions {
+		roles.AddRow(d)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT p.definition FROM authz_roles")).WillReturnRows(roles)
+
+	rows := sqlmock.NewRows([]string{"role_name", "principal_type", "principal"})
+	for principal, role := range bindings {
+		m := regexp.MustCompile(`^(\w+):(.*)$`).FindStringSubmatch(principal)
+		rows.AddRow(role, m[1], m[2])
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(loadRoleBindingsStatement)).WillReturnRows(rows)
+	mock.ExpectCommit()
+}
+
+// capture matches any argument and keeps its value.
+type capture struct {
+	value driver.Value
+}
+
+func (c *capture) Match(v driver.Value) bool {
+	c.value = v
+	return true
+}
+
+func check(t *testing.T, c Client, user string, groups []string, actionType apiv1.ActionType) authzv1.Decision {
+	resp, err := c.Check(context.Background(), &authzv1.CheckRequest{
+		Subject:    &authzv1.Subject{User: user, Groups: groups},
+		Method:     "/clutch.k8s.v1.K8sAPI/DeletePod",
+		ActionType: actionType,
+	})
+	assert.NoError(t, err)
+	return resp.Decision
+}
+
+func TestDatabaseCheck(t *testing.T) {
+	d, mock := newTestDatabaseImpl(t, &authzcfgv1.Config{
+		Roles:        []*authzcfgv1.Role{{RoleName: "superuser", Policies: []*authzcfgv1.Policy{{PolicyName: "all", Method: "*"}}}},
+		RoleBindings: []*authzcfgv1.RoleBinding{{To: []string{"superuser"}, Principals: []*authzcfgv1.Principal{{Type: &authzcfgv1.Principal_User{User: "root"}}}}},
+		Database:     &authzcfgv1.Database{DbProvider: "clutch.service.db.postgres"},
+	})
+
+	// Until the database is read, only the roles in the config are in effect.
+	assert.Equal(t, authzv1.Decision_ALLOW, check(t, d, "root", nil, apiv1.ActionType_DELETE))
+	assert.Equal(t, authzv1.Decision_DENY, check(t, d, "alice", nil, apiv1.ActionType_DELETE))
+
+	expectLoad(mock, []string{adminDefinition, readOnlyDefinition}, map[string]string{"user:alice": "admin", "group:sre": "read-only"})
+	assert.NoError(t, d.refresh(context.Background()))
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	assert.Equal(t, authzv1.Decision_ALLOW, check(t, d, "root", nil, apiv1.ActionType_DELETE))
+	assert.Equal(t, authzv1.Decision_ALLOW, check(t, d, "alice", nil, apiv1.ActionType_DELETE))
+	assert.Equal(t, authzv1.Decision_ALLOW, check(t, d, "bob", []string{"sre"}, apiv1.ActionType_READ))
+	assert.Equal(t, authzv1.Decision_DENY, check(t, d, "bob", []string{"sre"}, apiv1.ActionType_DELETE))
+
+	// The last known roles are kept if the database can't be read.
+	mock.ExpectBegin().WillReturnError(errors.New("connection refused"))
+	assert.Error(t, d.refresh(context.Background()))
+	assert.Equal(t, authzv1.Decision_ALLOW, check(t, d, "alice", nil, apiv1.ActionType_DELETE))
+
+	// Removed bindings no longer apply.
+	expectLoad(mock, []string{adminDefinition, readOnlyDefinition}, map[string]string{"group:sre": "read-only"})
+	assert.NoError(t, d.refresh(context.Background()))
+	assert.Equal(t, authzv1.Decision_DENY, check(t, d, "alice", nil, apiv1.ActionType_DELETE))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateRole(t *testing.T) {
+	d, mock := newTestDatabaseImpl(t, &authzcfgv1.Config{
+		Roles:    []*authzcfgv1.Role{{RoleName: "superuser"}},
+		Database: &authzcfgv1.Database{DbProvider: "clutch.service.db.postgres"},
+	})
+	ctx := context.Background()
+	policies := []*authzv1.Policy{{PolicyName: "all", Method: "*"}}
+
+	definition := &capture{}
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO authz_roles")).
+		WithArgs("admin", "UNKNOWN").
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO authz_policies")).
+		WithArgs("admin", 1, definition, "UNKNOWN").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	expectLoad(mock, []string{adminDefinition}, nil)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT r.name, r.version")).
+		WithArgs("admin").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "version", "updated_by", "updated_at", "definition"}).
+			AddRow("admin", 1, "UNKNOWN", time.Now(), adminDefinition))
+
+	role, err := d.CreateRole(ctx, "admin", policies)
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", role.Name)
+	assert.Equal(t, int64(1), role.Version)
+	assert.Equal(t, "all", role.Policies[0].PolicyName)
+	assert.JSONEq(t, adminDefinition, definition.value.(string))
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	// Roles in the config can't be shadowed.
+	_, err = d.CreateRole(ctx, "superuser", policies)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// Policies are validated like the config.
+	_, err = d.CreateRole(ctx, "invalid", []*authzv1.Policy{{PolicyName: "no-method"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO authz_roles")).WillReturnRows(sqlmock.NewRows([]string{"version"}))
+	mock.ExpectRollback()
+	_, err = d.CreateRole(ctx, "admin", policies)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	// A deleted role is created again as its next version.
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO authz_roles")).
+		WithArgs("admin", "UNKNOWN").
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(3))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO authz_policies")).
+		WithArgs("admin", 3, sqlmock.AnyArg(), "UNKNOWN").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	expectLoad(mock, []string{adminDefinition}, nil)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT r.name, r.version")).
+		WithArgs("admin").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "version", "updated_by", "updated_at", "definition"}).
+			AddRow("admin", 3, "UNKNOWN", time.Now(), adminDefinition))
+	role, err = d.CreateRole(ctx, "admin", policies)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), role.Version)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateRole(t *testing.T) {
+	d, mock := newTestDatabaseImpl(t, &authzcfgv1.Config{Database: &authzcfgv1.Database{DbProvider: "clutch.service.db.postgres"}})
+	ctx := context.Background()
+	policies := []*authzv1.Policy{{PolicyName: "read", ActionTypes: []apiv1.ActionType{apiv1.ActionType_READ}, Method: "*"}}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(lockRoleStatement)).
+		WithArgs("admin").
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(3))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE authz_roles")).
+		WithArgs("admin", 4, "UNKNOWN").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO authz_policies")).
+		WithArgs("admin", 4, sqlmock.AnyArg(), "UNKNOWN").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	expectLoad(mock, []string{adminDefinition}, nil)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT r.name, r.version")).
+		WithArgs("admin").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "version", "updated_by", "updated_at", "definition"}).
+			AddRow("admin", 4, "UNKNOWN", time.Now(), readOnlyDefinition))
+
+	role, err := d.UpdateRole(ctx, "admin", policies, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), role.Version)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	// Changes made since the role was read aren't overwritten.
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(lockRoleStatement)).
+		WithArgs("admin").
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(4))
+	mock.ExpectRollback()
+	_, err = d.UpdateRole(ctx, "admin", policies, 3)
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(lockRoleStatement)).
+		WithArgs("missing").
+		WillReturnRows(sqlmock.NewRows([]string{"version"}))
+	mock.ExpectRollback()
+	_, err = d.UpdateRole(ctx, "missing", policies, 0)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRollbackRole(t *testing.T) {
+	d, mock := newTestDatabaseImpl(t, &authzcfgv1.Config{Database: &authzcfgv1.Database{DbProvider: "clutch.service.db.postgres"}})
+	ctx := context.Background()
+
+	// The policies of the old version are stored as a new version.
+	mock.ExpectQuery(regexp.QuoteMeta(getPolicyStatement)).
+		WithArgs("admin", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"definition"}).AddRow(adminDefinition))
+	definition := &capture{}
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(lockRoleStatement)).
+		WithArgs("admin").
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE authz_roles")).
+		WithArgs("admin", 3, "UNKNOWN").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO authz_policies")).
+		WithArgs("admin", 3, definition, "UNKNOWN").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	expectLoad(mock, []string{adminDefinition}, nil)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT r.name, r.version")).
+		WithArgs("admin").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "version", "updated_by", "updated_at", "definition"}).
+			AddRow("admin", 3, "UNKNOWN", time.Now(), adminDefinition))
+
+	role, err := d.RollbackRole(ctx, "admin", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), role.Version)
+	assert.JSONEq(t, adminDefinition, definition.value.(string))
+
+	mock.ExpectQuery(regexp.QuoteMeta(getPolicyStatement)).
+		WithArgs("admin", 5).
+		WillReturnRows(sqlmock.NewRows([]string{"definition"}))
+	_, err = d.RollbackRole(ctx, "admin", 5)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListPolicyVersions(t *testing.T) {
+	d, mock := newTestDatabaseImpl(t, &authzcfgv1.Config{Database: &authzcfgv1.Database{DbProvider: "clutch.service.db.postgres"}})
+	ctx := context.Background()
+
+	createdAt := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT version, definition, created_by, created_at FROM authz_policies")).
+		WithArgs("admin").
+		WillReturnRows(sqlmock.NewRows([]string{"version", "definition", "created_by", "created_at"}).
+			AddRow(2, readOnlyDefinition, "bob", createdAt).
+			AddRow(1, adminDefinition, "alice", createdAt))
+
+	versions, err := d.ListPolicyVersions(ctx, "admin")
+	assert.NoError(t, err)
+	assert.Len(t, versions, 2)
+	assert.Equal(t, int64(2), versions[0].Version)
+	assert.Equal(t, "bob", versions[0].CreatedBy)
+	assert.Equal(t, []apiv1.ActionType{apiv1.ActionType_READ}, versions[0].Policies[0].ActionTypes)
+	assert.Equal(t, "admin", versions[1].RoleName)
+	ts, _ := ptypes.TimestampProto(createdAt)
+	assert.Equal(t, ts, versions[1].CreateTime)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT version, definition, created_by, created_at FROM authz_policies")).
+		WithArgs("missing").
+		WillReturnRows(sqlmock.NewRows([]string{"version", "definition", "created_by", "created_at"}))
+	_, err = d.ListPolicyVersions(ctx, "missing")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteRole(t *testing.T) {
+	d, mock := newTestDatabaseImpl(t, &authzcfgv1.Config{Database: &authzcfgv1.Database{DbProvider: "clutch.service.db.postgres"}})
+	ctx := context.Background()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(lockRoleStatement)).WithArgs("admin").WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
+	mock.ExpectQuery(regexp.QuoteMeta(roleBoundStatement)).WithArgs("admin").WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+	assert.Equal(t, codes.FailedPrecondition, status.Code(d.DeleteRole(ctx, "admin")))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(lockRoleStatement)).WithArgs("missing").WillReturnRows(sqlmock.NewRows([]string{"version"}))
+	mock.ExpectRollback()
+	assert.Equal(t, codes.NotFound, status.Code(d.DeleteRole(ctx, "missing")))
+
+	// The role is only marked as deleted, which keeps its policy versions.
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(lockRoleStatement)).WithArgs("admin").WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
+	mock.ExpectQuery(regexp.QuoteMeta(roleBoundStatement)).WithArgs("admin").WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectExec(regexp.QuoteMeta(deleteRoleStatement)).WithArgs("admin", "UNKNOWN").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	expectLoad(mock, nil, nil)
+	assert.NoError(t, d.DeleteRole(ctx, "admin"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRoleBindings(t *testing.T) {
+	d, mock := newTestDatabaseImpl(t, &authzcfgv1.Config{Database: &authzcfgv1.Database{DbProvider: "clutch.service.db.postgres"}})
+	ctx := context.Background()
+	sre := &authzv1.Principal{Type: &authzv1.Principal_Group{Group: "sre"}}
+
+	id := &capture{}
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO authz_role_bindings")).
+		WithArgs(id, "admin", "group", "sre", "UNKNOWN").
+		WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(time.Now()))
+	expectLoad(mock, []string{adminDefinition}, map[string]string{"group:sre": "admin"})
+
+	binding, err := d.CreateRoleBinding(ctx, "admin", sre)
+	assert.NoError(t, err)
+	assert.Equal(t, id.value, binding.Id)
+	assert.Equal(t, "sre", binding.Principal.GetGroup())
+	assert.Equal(t, authzv1.Decision_ALLOW, check(t, d, "bob", []string{"sre"}, apiv1.ActionType_DELETE))
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO authz_role_bindings")).WillReturnError(&pq.Error{Code: uniqueViolation})
+	_, err = d.CreateRoleBinding(ctx, "admin", sre)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO authz_role_bindings")).WillReturnRows(sqlmock.NewRows([]string{"created_at"}))
+	_, err = d.CreateRoleBinding(ctx, "missing", sre)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, role_name, principal_type, principal")).
+		WithArgs("").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "role_name", "principal_type", "principal", "created_by", "created_at"}).
+			AddRow(id.value, "admin", "group", "sre", "alice", time.Now()).
+			AddRow("b1a8b52f-6ea1-4c2e-b5cb-6c1b2bd4a0b1", "admin", "user", "bob", "alice", time.Now()))
+	bindings, err := d.ListRoleBindings(ctx, "")
+	assert.NoError(t, err)
+	assert.Len(t, bindings, 2)
+	assert.Equal(t, "bob", bindings[1].Principal.GetUser())
+
+	mock.ExpectExec(regexp.QuoteMeta(deleteRoleBindingStatement)).WithArgs(id.value).WillReturnResult(sqlmock.NewResult(0, 1))
+	expectLoad(mock, []string{adminDefinition}, nil)
+	assert.NoError(t, d.DeleteRoleBinding(ctx, id.value.(string)))
+	assert.Equal(t, authzv1.Decision_DENY, check(t, d, "bob", []string{"sre"}, apiv1.ActionType_DELETE))
+
+	assert.Equal(t, codes.NotFound, status.Code(d.DeleteRoleBinding(ctx, "not-a-uuid")))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDatabaseReconfigure(t *testing.T) {
+	database := &authzcfgv1.Database{DbProvider: "clutch.service.db.postgres"}
+	d, _ := newTestDatabaseImpl(t, &authzcfgv1.Config{Database: database})
+
+	cfg, err := ptypes.MarshalAny(&authzcfgv1.Config{
+		Roles:        []*authzcfgv1.Role{{RoleName: "superuser", Policies: []*authzcfgv1.Policy{{PolicyName: "all", Method: "*"}}}},
+		RoleBindings: []*authzcfgv1.RoleBinding{{To: []string{"superuser"}, Principals: []*authzcfgv1.Principal{{Type: &authzcfgv1.Principal_User{User: "root"}}}}},
+		Database:     database,
+	})
+	assert.NoError(t, err)
+	apply, err := d.Reconfigure(cfg)
+	assert.NoError(t, err)
+	apply()
+	assert.Equal(t, authzv1.Decision_ALLOW, check(t, d, "root", nil, apiv1.ActionType_DELETE))
+
+	cfg, err = ptypes.MarshalAny(&authzcfgv1.Config{Database: &authzcfgv1.Database{DbProvider: "other"}})
+	assert.NoError(t, err)
+	_, err = d.Reconfigure(cfg)
+	assert.Error(t, err)
+}
+
+func TestDependencies(t *testing.T) {
+	deps, err := Dependencies(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, deps)
+
+	cfg, err := ptypes.MarshalAny(&authzcfgv1.Config{Database: &authzcfgv1.Database{DbProvider: "clutch.service.db.postgres"}})
+	assert.NoError(t, err)
+	deps, err = Dependencies(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"clutch.service.db.postgres"}, deps)
+}
@@ -8,8 +8,10 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
@@ -17,16 +19,21 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/kinesis"
 	"github.com/aws/aws-sdk-go/service/kinesis/kinesisiface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/iancoleman/strcase"
 	"github.com/uber-go/tally"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	ec2v1 "github.com/lyft/clutch/backend/api/aws/ec2/v1"
 	kinesisv1 "github.com/lyft/clutch/backend/api/aws/kinesis/v1"
 	awsv1 "github.com/lyft/clutch/backend/api/config/service/aws/v1"
 	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/tracing"
 )
 
 const (
@@ -52,18 +59,52 @@ func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service,
 		if err != nil {
 			return nil, err
 		}
+		addTracingHandlers(&awsSession.Handlers)
 
 		c.clients[region] = &regionalClient{
 			region:      region,
 			kinesis:     kinesis.New(awsSession),
 			ec2:         ec2.New(awsSession),
 			autoscaling: autoscaling.New(awsSession),
+			sts:         sts.New(awsSession),
 		}
 	}
 
 	return c, nil
 }
 
+type spanKey struct{}
+
+// Record a span for each API call. Handlers are copied to clients when they are created, so this must be called on the
+// session beforehand.
+func addTracingHandlers(handlers *request.Handlers) {
+	handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "clutch.tracing.Start",
+		Fn: func(r *request.Request) {
+			name := fmt.Sprintf("aws.%s.%s", r.ClientInfo.ServiceName, r.Operation.Name)
+			ctx, span := tracing.Start(r.Context(), name,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(semconv.CloudRegion(aws.StringValue(r.Config.Region))),
+			)
+			r.SetContext(context.WithValue(ctx, spanKey{}, span))
+		},
+	})
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "clutch.tracing.End",
+		Fn: func(r *request.Request) {
+			span, ok := r.Context().Value(spanKey{}).(trace.Span)
+			if !ok {
+				return
+			}
+			if r.HTTPResponse != nil {
+				span.SetAttributes(semconv.HTTPResponseStatusCode(r.HTTPResponse.StatusCode))
+			}
+			tracing.SetError(span, r.Error)
+			span.End()
+		},
+	})
+}
+
 type Client interface {
 	DescribeInstances(ctx context.Context, region string, ids []string) ([]*ec2v1.Instance, error)
 	TerminateInstances(ctx context.Context, region string, ids []string) error
@@ -194,12 +235,34 @@ func (c *client) Regions() []string {
 	return regions
 }
 
+// HealthCheck gets the caller identity in each region, which fails if the credentials are invalid or expired or STS
+// can't be reached.
+func (c *client) HealthCheck(ctx context.Context) map[string]error {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	ret := make(map[string]error, len(c.clients))
+	for region, rc := range c.clients {
+		wg.Add(1)
+		go func(region string, rc *regionalClient) {
+			defer wg.Done()
+			_, err := rc.sts.GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
+
+			mu.Lock()
+			defer mu.Unlock()
+			ret[region] = err
+		}(region, rc)
+	}
+	wg.Wait()
+	return ret
+}
+
 type regionalClient struct {
 	region string
 
 	kinesis     kinesisiface.KinesisAPI
 	ec2         ec2iface.EC2API
 	autoscaling autoscalingiface.AutoScalingAPI
+	sts         stsiface.STSAPI
 }
 
 func (c *client) DescribeInstances(ctx context.Context, region string, ids []string) ([]*ec2v1.Instance, error) {
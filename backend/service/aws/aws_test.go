@@ -11,6 +11,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/stretchr/testify/assert"
@@ -184,3 +186,30 @@ func (m *mockEC2) TerminateInstancesWithContext(ctx context.Context, input *ec2.
 	}
 	return ret, nil
 }
+
+func TestHealthCheck(t *testing.T) {
+	c := &client{
+		clients: map[string]*regionalClient{
+			"us-east-1": {region: "us-east-1", sts: &mockSTS{}},
+			"us-west-2": {region: "us-west-2", sts: &mockSTS{err: errors.New("ExpiredToken")}},
+		},
+	}
+
+	result := c.HealthCheck(context.Background())
+	assert.Len(t, result, 2)
+	assert.NoError(t, result["us-east-1"])
+	assert.EqualError(t, result["us-west-2"], "ExpiredToken")
+}
+
+type mockSTS struct {
+	stsiface.STSAPI
+
+	err error
+}
+
+func (m *mockSTS) GetCallerIdentityWithContext(aws.Context, *sts.GetCallerIdentityInput, ...request.Option) (*sts.GetCallerIdentityOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &sts.GetCallerIdentityOutput{}, nil
+}
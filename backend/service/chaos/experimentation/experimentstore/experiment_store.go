@@ -23,6 +23,8 @@ import (
 
 const Name = "clutch.service.chaos.experimentation.store"
 
+var Dependencies = service.Requires(pgservice.Name)
+
 // ExperimentStore stores experiment data
 type ExperimentStore interface {
 	CreateExperiment(context.Context, *any.Any, *time.Time, *time.Time) (*experimentation.Experiment, error)
@@ -38,7 +40,7 @@ type experimentStore struct {
 
 // New returns a new NewExperimentStore instance.
 func New(_ *any.Any, _ *zap.Logger, _ tally.Scope) (service.Service, error) {
-	p, ok := service.Registry[pgservice.Name]
+	p, ok := service.Registry.Get(pgservice.Name)
 	if !ok {
 		return nil, errors.New("could not find database service")
 	}
@@ -5,15 +5,18 @@ package postgres
 // <!-- END clutchdoc -->
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/XSAM/otelsql"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/uber-go/tally"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
 	"go.uber.org/zap"
 
 	postgresv1 "github.com/lyft/clutch/backend/api/config/service/db/postgres/v1"
@@ -34,6 +37,14 @@ type Client interface {
 
 func (c *client) DB() *sql.DB { return c.sqlDB }
 
+// HealthCheck pings the database.
+func (c *client) HealthCheck(ctx context.Context) map[string]error {
+	return map[string]error{"": c.sqlDB.PingContext(ctx)}
+}
+
+// Stop closes the connection pool. Components using the pool are stopped first since they depend on this service.
+func (c *client) Stop(context.Context) error { return c.sqlDB.Close() }
+
 func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service, error) {
 	pgcfg := &postgresv1.Config{}
 	err := ptypes.UnmarshalAny(cfg, pgcfg)
@@ -46,10 +57,20 @@ func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service,
 		return nil, err
 	}
 
-	sqlDB, err := sql.Open("postgres", connection)
+	connector, err := pq.NewConnector(connection)
 	if err != nil {
 		return nil, err
 	}
+	// Spans are recorded for statements and transactions, but not for each connection or row.
+	sqlDB := otelsql.OpenDB(connector,
+		otelsql.WithAttributes(semconv.DBSystemPostgreSQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			DisableErrSkip:       true,
+			OmitConnResetSession: true,
+			OmitConnectorConnect: true,
+			OmitRows:             true,
+		}),
+	)
 
 	return &client{logger: logger, scope: scope, sqlDB: sqlDB}, nil
 }
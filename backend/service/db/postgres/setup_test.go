@@ -1,8 +1,11 @@
 package postgres
 
 import (
+	"context"
+	"errors"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/stretchr/testify/assert"
@@ -85,3 +88,18 @@ func TestConnString(t *testing.T) {
 		})
 	}
 }
+
+func TestHealthCheck(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	assert.NoError(t, err)
+	defer db.Close()
+	c := &client{sqlDB: db}
+
+	mock.ExpectPing()
+	assert.Equal(t, map[string]error{"": nil}, c.HealthCheck(context.Background()))
+
+	mock.ExpectPing().WillReturnError(errors.New("connection refused"))
+	assert.EqualError(t, c.HealthCheck(context.Background())[""], "connection refused")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -18,13 +18,15 @@ import (
 
 	envoyadminv1 "github.com/lyft/clutch/backend/api/config/service/envoyadmin/v1"
 	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
+	"github.com/lyft/clutch/backend/requestid"
 	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/tracing"
 )
 
 const Name = "clutch.service.envoyadmin"
 
 func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service, error) {
-	return NewWithHTTPClient(cfg, logger, scope, &http.Client{})
+	return NewWithHTTPClient(cfg, logger, scope, &http.Client{Transport: requestid.NewTransport(tracing.NewTransport(nil))})
 }
 
 func NewWithHTTPClient(cfg *any.Any, logger *zap.Logger, scope tally.Scope, httpClient *http.Client) (service.Service, error) {
@@ -31,7 +31,9 @@ import (
 	githubv1 "github.com/lyft/clutch/backend/api/config/service/github/v1"
 	scgithubv1 "github.com/lyft/clutch/backend/api/sourcecontrol/github/v1"
 	sourcecontrolv1 "github.com/lyft/clutch/backend/api/sourcecontrol/v1"
+	"github.com/lyft/clutch/backend/requestid"
 	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/tracing"
 )
 
 const Name = "clutch.service.github"
@@ -222,6 +224,7 @@ func newService(config *githubv1.Config) Client {
 		&oauth2.Token{AccessToken: token},
 	)
 	httpClient := oauth2.NewClient(context.Background(), tokenSource)
+	httpClient.Transport = requestid.NewTransport(tracing.NewTransport(httpClient.Transport))
 
 	rest := githubv3.NewClient(httpClient)
 	return &svc{
@@ -230,6 +233,7 @@ func newService(config *githubv1.Config) Client {
 			Repositories: rest.Repositories,
 			PullRequests: rest.PullRequests,
 			Issues:       rest.Issues,
+			RateLimits:   rest,
 		},
 		rawAuth: &gittransport.BasicAuth{
 			Username: "token",
@@ -238,6 +242,16 @@ func newService(config *githubv1.Config) Client {
 	}
 }
 
+// HealthCheck gets the rate limits of the token, which fails if GitHub can't be reached or the token is invalid, and
+// reports the core rate limit being exhausted as a failure.
+func (s *svc) HealthCheck(ctx context.Context) map[string]error {
+	limits, _, err := s.rest.RateLimits.RateLimits(ctx)
+	if err == nil && limits.GetCore() != nil && limits.GetCore().Remaining == 0 {
+		err = fmt.Errorf("rate limit exhausted until %s", limits.GetCore().Reset.Format(time.RFC3339))
+	}
+	return map[string]error{"": err}
+}
+
 func (s *svc) GetFile(ctx context.Context, ref *RemoteRef, path string) (*File, error) {
 	q := &getFileQuery{}
 	params := map[string]interface{}{
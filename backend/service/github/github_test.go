@@ -350,3 +350,54 @@ func TestGetCommit(t *testing.T) {
 		})
 	}
 }
+
+type mockRateLimits struct {
+	limits *githubv3.RateLimits
+	err    error
+}
+
+func (m *mockRateLimits) RateLimits(ctx context.Context) (*githubv3.RateLimits, *githubv3.Response, error) {
+	return m.limits, nil, m.err
+}
+
+func TestHealthCheck(t *testing.T) {
+	reset := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		mock   *mockRateLimits
+		errMsg string
+	}{
+		{
+			name: "healthy",
+			mock: &mockRateLimits{limits: &githubv3.RateLimits{Core: &githubv3.Rate{Limit: 5000, Remaining: 4999}}},
+		},
+		{
+			name:   "error",
+			mock:   &mockRateLimits{err: errors.New("401 Bad credentials")},
+			errMsg: "401 Bad credentials",
+		},
+		{
+			name: "exhausted",
+			mock: &mockRateLimits{limits: &githubv3.RateLimits{
+				Core: &githubv3.Rate{Limit: 5000, Remaining: 0, Reset: githubv3.Timestamp{Time: reset}},
+			}},
+			errMsg: "rate limit exhausted until 2020-10-01T12:00:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := &svc{rest: v3client{RateLimits: tt.mock}}
+
+			result := s.HealthCheck(context.Background())
+			assert.Len(t, result, 1)
+			if tt.errMsg == "" {
+				assert.NoError(t, result[""])
+			} else {
+				assert.EqualError(t, result[""], tt.errMsg)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ type v3client struct {
 	Repositories v3repositories
 	PullRequests v3pullrequests
 	Issues       v3issues
+	RateLimits   v3ratelimits
 }
 
 // Interface for struct defined in https://github.com/google/go-github/blob/master/github/repos.go.
@@ -33,6 +34,13 @@ type v3pullrequests interface {
 	Create(ctx context.Context, owner string, repo string, pull *githubv3.NewPullRequest) (*githubv3.PullRequest, *githubv3.Response, error)
 }
 
+// Interface for the rate limit method of the client defined in https://github.com/google/go-github/blob/master/github/github.go.
+// Method comments below reproduced directly from original definition linked above.
+type v3ratelimits interface {
+	// RateLimits returns the rate limits for the current client.
+	RateLimits(ctx context.Context) (*githubv3.RateLimits, *githubv3.Response, error)
+}
+
 type v4client interface {
 	Query(ctx context.Context, q interface{}, variables map[string]interface{}) error
 	Mutate(ctx context.Context, m interface{}, input githubv4.Input, variables map[string]interface{}) error
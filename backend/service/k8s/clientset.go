@@ -10,8 +10,10 @@ import (
 	k8s "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/transport"
 
 	k8sconfigv1 "github.com/lyft/clutch/backend/api/config/service/k8s/v1"
+	"github.com/lyft/clutch/backend/tracing"
 )
 
 const (
@@ -68,6 +70,7 @@ func newClientsetManager(rules *clientcmd.ClientConfigLoadingRules, restClientCo
 		if err := ApplyRestClientConfig(restConfig, restClientConfig); err != nil {
 			return nil, err
 		}
+		traceTransport(restConfig)
 
 		clientset, err := k8s.NewForConfig(restConfig)
 		if err != nil {
@@ -94,6 +97,7 @@ func newClientsetManager(rules *clientcmd.ClientConfigLoadingRules, restClientCo
 		case rest.ErrNotInCluster:
 			logger.Warn("not in a kubernetes cluster, unable to configure kube clientset")
 		case nil:
+			traceTransport(restConfig)
 			clientset, err := k8s.NewForConfig(restConfig)
 			if err != nil {
 				return nil, fmt.Errorf("could not create k8s InClusterConfig: %w", err)
@@ -130,6 +134,11 @@ func ApplyRestClientConfig(restConfig *rest.Config, restClientConfig *k8sconfigv
 	return nil
 }
 
+// Record a span for each request to the API server.
+func traceTransport(restConfig *rest.Config) {
+	restConfig.WrapTransport = transport.Wrappers(restConfig.WrapTransport, tracing.NewTransport)
+}
+
 type managerImpl struct {
 	clientsets map[string]*ctxClientsetImpl
 }
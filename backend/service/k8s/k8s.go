@@ -6,6 +6,8 @@ package k8s
 
 import (
 	"context"
+	"errors"
+	"sync"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
@@ -84,3 +86,29 @@ func (s *svc) Clientsets() []string {
 	}
 	return ret
 }
+
+// HealthCheck requests the version of the API server of each clientset, which fails if the cluster is unreachable or
+// the credentials are no longer valid.
+func (s *svc) HealthCheck(ctx context.Context) map[string]error {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	ret := make(map[string]error)
+	for name, cs := range s.manager.Clientsets() {
+		wg.Add(1)
+		go func(name string, cs ContextClientset) {
+			defer wg.Done()
+
+			// A clientset without a REST client can't be checked, so it isn't reported as healthy.
+			err := errors.New("clientset has no REST client")
+			if rc := cs.Discovery().RESTClient(); rc != nil {
+				err = rc.Get().AbsPath("/version").Context(ctx).Do().Error()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			ret[name] = err
+		}(name, cs)
+	}
+	wg.Wait()
+	return ret
+}
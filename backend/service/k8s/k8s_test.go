@@ -1,7 +1,10 @@
 package k8s
 
 import (
+	"context"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
@@ -11,6 +14,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/uber-go/tally"
 	"go.uber.org/zap/zaptest"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
 	restclient "k8s.io/client-go/rest"
 
 	k8sv1 "github.com/lyft/clutch/backend/api/config/service/k8s/v1"
@@ -113,3 +118,36 @@ func TestApplyRestClientConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestHealthCheck(t *testing.T) {
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/version", r.URL.Path)
+		_, _ = w.Write([]byte(`{"major": "1", "minor": "17"}`))
+	}))
+	defer healthy.Close()
+	unhealthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer unhealthy.Close()
+
+	healthyClientset, err := k8s.NewForConfig(&restclient.Config{Host: healthy.URL})
+	assert.NoError(t, err)
+	unhealthyClientset, err := k8s.NewForConfig(&restclient.Config{Host: unhealthy.URL})
+	assert.NoError(t, err)
+
+	s := &svc{
+		manager: &managerImpl{
+			clientsets: map[string]*ctxClientsetImpl{
+				"staging":    {Interface: healthyClientset},
+				"production": {Interface: unhealthyClientset},
+				"fake":       {Interface: fake.NewSimpleClientset()},
+			},
+		},
+	}
+
+	result := s.HealthCheck(context.Background())
+	assert.Len(t, result, 3)
+	assert.NoError(t, result["staging"])
+	assert.Error(t, result["production"])
+	assert.EqualError(t, result["fake"], "clientset has no REST client")
+}
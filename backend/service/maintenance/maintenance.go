@@ -0,0 +1,255 @@
+package maintenance
+
+// <!-- START clutchdoc -->
+// description: Holds the maintenance mode state, which is used by the maintenance middleware to reject changes during incidents and freezes.
+// <!-- END clutchdoc -->
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+
+	maintenanceconfigv1 "github.com/lyft/clutch/backend/api/config/service/maintenance/v1"
+	maintenancev1 "github.com/lyft/clutch/backend/api/maintenance/v1"
+	"github.com/lyft/clutch/backend/requestid"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/authn"
+	"github.com/lyft/clutch/backend/service/db/postgres"
+)
+
+const Name = "clutch.service.maintenance"
+
+const defaultRefreshInterval = 10 * time.Second
+
+// Dependencies returns the database provider if one is configured.
+func Dependencies(cfg *any.Any) ([]string, error) {
+	config := &maintenanceconfigv1.Config{}
+	if cfg != nil {
+		if err := ptypes.UnmarshalAny(cfg, config); err != nil {
+			return nil, err
+		}
+	}
+
+	var deps []string
+	if config.DbProvider != "" {
+		deps = append(deps, config.DbProvider)
+	}
+	return deps, nil
+}
+
+type Service interface {
+	// Get returns the last known state. It doesn't read from the database, so it can be called on every request.
+	Get() *maintenancev1.Maintenance
+	// Update changes the state on behalf of the user in the context.
+	Update(ctx context.Context, enabled bool, message string) (*maintenancev1.Maintenance, error)
+}
+
+func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service, error) {
+	config := &maintenanceconfigv1.Config{}
+	if cfg != nil {
+		if err := ptypes.UnmarshalAny(cfg, config); err != nil {
+			return nil, err
+		}
+	}
+
+	c := &client{
+		logger:          logger,
+		scope:           scope.SubScope("maintenance"),
+		refreshInterval: defaultRefreshInterval,
+		state:           &maintenancev1.Maintenance{},
+	}
+
+	if config.RefreshInterval != nil {
+		interval, err := ptypes.Duration(config.RefreshInterval)
+		if err != nil {
+			return nil, err
+		}
+		c.refreshInterval = interval
+	}
+
+	if config.DbProvider != "" {
+		db, ok := service.Registry.Get(config.DbProvider)
+		if !ok {
+			return nil, fmt.Errorf("unable to get database provider '%s'", config.DbProvider)
+		}
+		sqlDB, ok := db.(postgres.Client)
+		if !ok {
+			return nil, errors.New("database in registry does not implement required interface")
+		}
+		c.db = sqlDB.DB()
+	}
+
+	return c, nil
+}
+
+type client struct {
+	logger *zap.Logger
+	scope  tally.Scope
+
+	// If nil, the state is only kept in memory.
+	db              *sql.DB
+	refreshInterval time.Duration
+
+	mu    sync.RWMutex
+	state *maintenancev1.Maintenance
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Start loads the state from the database and keeps refreshing it to pick up changes made by other gateway instances.
+func (c *client) Start(ctx context.Context) error {
+	if c.db == nil {
+		return nil
+	}
+
+	// A database outage shouldn't prevent the gateway from starting, so maintenance is disabled until the state can be
+	// read.
+	if err := c.refresh(ctx); err != nil {
+		c.logger.Error("error loading maintenance state", zap.Error(err))
+	}
+
+	ctx, c.cancel = context.WithCancel(ctx)
+	c.done = make(chan struct{})
+	go func() {
+		defer close(c.done)
+		c.poll(ctx)
+	}()
+	return nil
+}
+
+// Stop the refresh loop.
+func (c *client) Stop(ctx context.Context) error {
+	if c.cancel == nil {
+		return nil
+	}
+	c.cancel()
+	select {
+	case <-c.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *client) poll(ctx context.Context) {
+	ticker := time.NewTicker(c.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// The last known state is kept if the database can't be read.
+			if err := c.refresh(ctx); err != nil {
+				c.scope.Counter("refresh_error").Inc(1)
+				c.logger.Warn("error refreshing maintenance state", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (c *client) refresh(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+
+	state, err := c.load(ctx)
+	if err != nil {
+		return err
+	}
+	c.set(state)
+	return nil
+}
+
+const loadStatement = `SELECT enabled, message, updated_by, updated_at FROM maintenance WHERE id = 1`
+
+func (c *client) load(ctx context.Context) (*maintenancev1.Maintenance, error) {
+	state := &maintenancev1.Maintenance{}
+	var updatedAt sql.NullTime
+	err := c.db.QueryRowContext(ctx, loadStatement).Scan(&state.Enabled, &state.Message, &state.UpdatedBy, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		// The state has never been changed.
+		return state, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if updatedAt.Valid {
+		if state.UpdateTime, err = ptypes.TimestampProto(updatedAt.Time); err != nil {
+			return nil, err
+		}
+	}
+	return state, nil
+}
+
+const saveStatement = `
+INSERT INTO maintenance (id, enabled, message, updated_by, updated_at) VALUES (1, $1, $2, $3, $4)
+ON CONFLICT (id) DO UPDATE SET enabled = EXCLUDED.enabled, message = EXCLUDED.message, updated_by = EXCLUDED.updated_by, updated_at = EXCLUDED.updated_at
+`
+
+func (c *client) save(ctx context.Context, state *maintenancev1.Maintenance, updatedAt time.Time) error {
+	_, err := c.db.ExecContext(ctx, saveStatement, state.Enabled, state.Message, state.UpdatedBy, updatedAt)
+	return err
+}
+
+func (c *client) set(state *maintenancev1.Maintenance) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.state = state
+
+	enabled := 0.0
+	if state.Enabled {
+		enabled = 1
+	}
+	c.scope.Gauge("enabled").Update(enabled)
+}
+
+func (c *client) Get() *maintenancev1.Maintenance {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return proto.Clone(c.state).(*maintenancev1.Maintenance)
+}
+
+func (c *client) Update(ctx context.Context, enabled bool, message string) (*maintenancev1.Maintenance, error) {
+	username := "UNKNOWN"
+	if claims, err := authn.ClaimsFromContext(ctx); err == nil {
+		username = claims.Subject
+	}
+
+	now := time.Now()
+	updateTime, err := ptypes.TimestampProto(now)
+	if err != nil {
+		return nil, err
+	}
+	state := &maintenancev1.Maintenance{
+		Enabled:    enabled,
+		Message:    message,
+		UpdatedBy:  username,
+		UpdateTime: updateTime,
+	}
+
+	if c.db != nil {
+		if err := c.save(ctx, state, now); err != nil {
+			return nil, err
+		}
+	}
+	c.set(state)
+
+	requestid.Logger(ctx, c.logger).Info("maintenance state updated",
+		zap.Bool("enabled", enabled),
+		zap.String("message", message),
+		zap.String("updatedBy", username),
+	)
+
+	return proto.Clone(state).(*maintenancev1.Maintenance), nil
+}
@@ -0,0 +1,146 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap/zaptest"
+
+	maintenanceconfigv1 "github.com/lyft/clutch/backend/api/config/service/maintenance/v1"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/authn"
+)
+
+func TestDependencies(t *testing.T) {
+	deps, err := Dependencies(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, deps)
+
+	cfg, _ := ptypes.MarshalAny(&maintenanceconfigv1.Config{})
+	deps, err = Dependencies(cfg)
+	assert.NoError(t, err)
+	assert.Empty(t, deps)
+
+	cfg, _ = ptypes.MarshalAny(&maintenanceconfigv1.Config{DbProvider: "clutch.service.db.postgres"})
+	deps, err = Dependencies(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"clutch.service.db.postgres"}, deps)
+}
+
+func TestNew(t *testing.T) {
+	service.Registry.Reset()
+	defer service.Registry.Reset()
+
+	svc, err := New(nil, zaptest.NewLogger(t), tally.NoopScope)
+	assert.NoError(t, err)
+	c := svc.(*client)
+	assert.Nil(t, c.db)
+	assert.Equal(t, defaultRefreshInterval, c.refreshInterval)
+	assert.False(t, c.Get().Enabled)
+
+	cfg, _ := ptypes.MarshalAny(&maintenanceconfigv1.Config{DbProvider: "clutch.service.db.postgres"})
+	_, err = New(cfg, zaptest.NewLogger(t), tally.NoopScope)
+	assert.EqualError(t, err, "unable to get database provider 'clutch.service.db.postgres'")
+
+	cfg, _ = ptypes.MarshalAny(&maintenanceconfigv1.Config{RefreshInterval: ptypes.DurationProto(time.Minute)})
+	svc, err = New(cfg, zaptest.NewLogger(t), tally.NoopScope)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, svc.(*client).refreshInterval)
+}
+
+func newTestClient(t *testing.T) *client {
+	svc, err := New(nil, zaptest.NewLogger(t), tally.NewTestScope("", nil))
+	assert.NoError(t, err)
+	return svc.(*client)
+}
+
+func TestUpdateInMemory(t *testing.T) {
+	c := newTestClient(t)
+
+	ctx := authn.ContextWithClaims(context.Background(), &authn.Claims{StandardClaims: &jwt.StandardClaims{Subject: "foo@example.com"}})
+	state, err := c.Update(ctx, true, "change freeze")
+	assert.NoError(t, err)
+	assert.True(t, state.Enabled)
+	assert.Equal(t, "change freeze", state.Message)
+	assert.Equal(t, "foo@example.com", state.UpdatedBy)
+	assert.NotNil(t, state.UpdateTime)
+
+	assert.True(t, c.Get().Enabled)
+	assert.Equal(t, "change freeze", c.Get().Message)
+
+	// Changes to the returned state don't affect the service.
+	c.Get().Enabled = false
+	assert.True(t, c.Get().Enabled)
+
+	state, err = c.Update(context.Background(), false, "")
+	assert.NoError(t, err)
+	assert.False(t, state.Enabled)
+	assert.Equal(t, "UNKNOWN", state.UpdatedBy)
+	assert.False(t, c.Get().Enabled)
+}
+
+func TestLoad(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	c := newTestClient(t)
+	c.db = db
+
+	updatedAt := time.Unix(1600000000, 0)
+	mock.ExpectQuery(regexp.QuoteMeta(loadStatement)).WillReturnRows(
+		sqlmock.NewRows([]string{"enabled", "message", "updated_by", "updated_at"}).AddRow(true, "incident", "foo@example.com", updatedAt),
+	)
+	assert.NoError(t, c.refresh(context.Background()))
+	state := c.Get()
+	assert.True(t, state.Enabled)
+	assert.Equal(t, "incident", state.Message)
+	assert.Equal(t, "foo@example.com", state.UpdatedBy)
+	assert.Equal(t, updatedAt.Unix(), state.UpdateTime.Seconds)
+
+	// The last known state is kept if the database can't be read.
+	mock.ExpectQuery(regexp.QuoteMeta(loadStatement)).WillReturnError(errors.New("connection refused"))
+	assert.Error(t, c.refresh(context.Background()))
+	assert.True(t, c.Get().Enabled)
+
+	// No row means the state has never been changed.
+	mock.ExpectQuery(regexp.QuoteMeta(loadStatement)).WillReturnRows(
+		sqlmock.NewRows([]string{"enabled", "message", "updated_by", "updated_at"}),
+	)
+	assert.NoError(t, c.refresh(context.Background()))
+	assert.False(t, c.Get().Enabled)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePersisted(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	c := newTestClient(t)
+	c.db = db
+
+	mock.ExpectExec(regexp.QuoteMeta(saveStatement)).
+		WithArgs(true, "incident", "UNKNOWN", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	_, err = c.Update(context.Background(), true, "incident")
+	assert.NoError(t, err)
+	assert.True(t, c.Get().Enabled)
+
+	// The state isn't changed if it can't be saved.
+	mock.ExpectExec(regexp.QuoteMeta(saveStatement)).WillReturnError(errors.New("connection refused"))
+	_, err = c.Update(context.Background(), false, "")
+	assert.Error(t, err)
+	assert.True(t, c.Get().Enabled)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
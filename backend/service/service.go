@@ -1,6 +1,12 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
@@ -8,8 +14,96 @@ import (
 
 type Service interface{}
 
-type Factory map[string]func(*any.Any, *zap.Logger, tally.Scope) (Service, error)
+// HealthChecker is implemented by services that depend on external systems, e.g. a database, so that the gateway is
+// only reported as ready when they can be reached.
+type HealthChecker interface {
+	// HealthCheck returns the result of each check keyed by its name, e.g. one per cluster, or by the empty string if
+	// the service has a single check. A nil error means the check passed. Checks should stop when the context is done.
+	HealthCheck(ctx context.Context) map[string]error
+}
+
+// Factory maps each service's name to its registration.
+type Factory map[string]Registration
+
+// Registration is how the gateway instantiates a service.
+type Registration struct {
+	New func(*any.Any, *zap.Logger, tally.Scope) (Service, error)
+
+	// Dependencies declares the services the service requires. It can be omitted if it doesn't require any.
+	Dependencies DependencyFunc
+}
+
+// DependencyFunc returns the names of the services that a component requires, given the component's configuration. The
+// gateway uses it to instantiate services in dependency order and to fail fast when a dependency is not configured.
+type DependencyFunc func(cfg *any.Any) ([]string, error)
+
+// Requires returns a DependencyFunc for a component whose dependencies do not vary with its configuration.
+func Requires(names ...string) DependencyFunc {
+	return func(*any.Any) ([]string, error) {
+		return names, nil
+	}
+}
+
+var (
+	ErrDuplicate = errors.New("service already registered")
+	ErrLocked    = errors.New("service registry is locked")
+)
+
+var Registry = &registry{services: map[string]Service{}}
+
+// registry holds instantiated services by name. It rejects duplicates and becomes read-only once locked by the gateway.
+type registry struct {
+	mu       sync.RWMutex
+	locked   bool
+	services map[string]Service
+}
+
+func (r *registry) Register(name string, svc Service) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.locked {
+		return fmt.Errorf("could not register '%s': %w", name, ErrLocked)
+	}
+	if _, ok := r.services[name]; ok {
+		return fmt.Errorf("could not register '%s': %w", name, ErrDuplicate)
+	}
+	r.services[name] = svc
+	return nil
+}
+
+func (r *registry) Get(name string) (Service, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	svc, ok := r.services[name]
+	return svc, ok
+}
+
+// Names returns the names of all registered services in sorted order.
+func (r *registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ret := make([]string, 0, len(r.services))
+	for name := range r.services {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
 
-var Registry = map[string]Service{}
+// Lock prevents any further services from being registered.
+func (r *registry) Lock() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.locked = true
+}
 
-// TODO: create a one-way registry that errors on duplicates and can be locked after instantiation for additional safety.
+// Reset removes all services and unlocks the registry. It is intended for use in tests.
+func (r *registry) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.locked = false
+	r.services = map[string]Service{}
+}
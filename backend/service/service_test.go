@@ -0,0 +1,44 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	r := &registry{services: map[string]Service{}}
+
+	assert.NoError(t, r.Register("foo", "first"))
+	err := r.Register("foo", "second")
+	assert.True(t, errors.Is(err, ErrDuplicate))
+
+	svc, ok := r.Get("foo")
+	assert.True(t, ok)
+	assert.Equal(t, "first", svc)
+
+	_, ok = r.Get("bar")
+	assert.False(t, ok)
+
+	assert.NoError(t, r.Register("baz", "other"))
+	assert.Equal(t, []string{"baz", "foo"}, r.Names())
+
+	r.Lock()
+	err = r.Register("bar", "third")
+	assert.True(t, errors.Is(err, ErrLocked))
+	_, ok = r.Get("bar")
+	assert.False(t, ok)
+
+	r.Reset()
+	_, ok = r.Get("foo")
+	assert.False(t, ok)
+	assert.NoError(t, r.Register("bar", "third"))
+}
+
+func TestRequires(t *testing.T) {
+	deps, err := Requires("foo", "bar")((*any.Any)(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo", "bar"}, deps)
+}
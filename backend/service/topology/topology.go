@@ -16,6 +16,8 @@ import (
 
 const Name = "clutch.service.topology"
 
+var Dependencies = service.Requires(pgservice.Name)
+
 type Service interface{}
 
 type client struct {
@@ -33,7 +35,7 @@ func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service,
 		return nil, err
 	}
 
-	p, ok := service.Registry[pgservice.Name]
+	p, ok := service.Registry.Get(pgservice.Name)
 	if !ok {
 		return nil, errors.New("Please config the datastore [clutch.service.db.postgres] to use the topology service")
 	}
@@ -0,0 +1,293 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+)
+
+const defaultExportTimeout = time.Second * 10
+
+func NewExporter(config *gatewayv1.Tracing) (sdktrace.SpanExporter, error) {
+	switch e := config.Exporter.(type) {
+	case *gatewayv1.Tracing_OtlpExporter:
+		return newOTLPExporter(e.OtlpExporter)
+	case *gatewayv1.Tracing_FileExporter_:
+		if e.FileExporter.Path == "" {
+			return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		}
+		f, err := os.OpenFile(e.FileExporter.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, err
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			_ = f.Close()
+			return nil, err
+		}
+		return &fileExporter{SpanExporter: exporter, f: f}, nil
+	default:
+		return nil, fmt.Errorf("unsupported tracing exporter: %T", e)
+	}
+}
+
+// fileExporter closes the file when the exporter is shut down, which the stdout exporter leaves to the caller.
+type fileExporter struct {
+	sdktrace.SpanExporter
+	f *os.File
+}
+
+func (e *fileExporter) Shutdown(ctx context.Context) error {
+	if err := e.SpanExporter.Shutdown(ctx); err != nil {
+		return err
+	}
+	return e.f.Close()
+}
+
+// otlpExporter sends spans using the JSON encoding of OTLP/HTTP. The SDK's OTLP exporters require a newer gRPC than the
+// gateway is built with, so the request is encoded here from the SDK's span data.
+type otlpExporter struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func newOTLPExporter(config *gatewayv1.Tracing_OTLPExporter) (sdktrace.SpanExporter, error) {
+	timeout := defaultExportTimeout
+	if config.Timeout != nil {
+		d, err := ptypes.Duration(config.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		timeout = d
+	}
+
+	return &otlpExporter{
+		url:     strings.TrimSuffix(config.Endpoint, "/") + "/v1/traces",
+		headers: config.Headers,
+		client:  &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// The OTLP/HTTP JSON encoding of ExportTraceServiceRequest. IDs are hex encoded and 64-bit integers are strings, as
+// required by the OTLP specification.
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	SchemaURL  string           `json:"schemaUrl,omitempty"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope     otlpScope  `json:"scope"`
+	Spans     []otlpSpan `json:"spans"`
+	SchemaURL string     `json:"schemaUrl,omitempty"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	TraceState        string         `json:"traceState,omitempty"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Events            []otlpEvent    `json:"events,omitempty"`
+	Status            *otlpStatus    `json:"status,omitempty"`
+}
+
+type otlpEvent struct {
+	TimeUnixNano string         `json:"timeUnixNano"`
+	Name         string         `json:"name"`
+	Attributes   []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string                 `json:"key"`
+	Value map[string]interface{} `json:"value"`
+}
+
+type otlpStatus struct {
+	Message string `json:"message,omitempty"`
+	Code    int    `json:"code"`
+}
+
+// Values match the OpenTelemetry protocol, which differ from the API's.
+const (
+	otlpStatusCodeOk    = 1
+	otlpStatusCodeError = 2
+)
+
+func otlpValue(v attribute.Value) map[string]interface{} {
+	switch v.Type() {
+	case attribute.BOOL:
+		return map[string]interface{}{"boolValue": v.AsBool()}
+	case attribute.INT64:
+		return map[string]interface{}{"intValue": strconv.FormatInt(v.AsInt64(), 10)}
+	case attribute.FLOAT64:
+		return map[string]interface{}{"doubleValue": v.AsFloat64()}
+	case attribute.BOOLSLICE, attribute.INT64SLICE, attribute.FLOAT64SLICE, attribute.STRINGSLICE:
+		var values []map[string]interface{}
+		switch s := v.AsInterface().(type) {
+		case []bool:
+			for _, e := range s {
+				values = append(values, otlpValue(attribute.BoolValue(e)))
+			}
+		case []int64:
+			for _, e := range s {
+				values = append(values, otlpValue(attribute.Int64Value(e)))
+			}
+		case []float64:
+			for _, e := range s {
+				values = append(values, otlpValue(attribute.Float64Value(e)))
+			}
+		case []string:
+			for _, e := range s {
+				values = append(values, otlpValue(attribute.StringValue(e)))
+			}
+		}
+		return map[string]interface{}{"arrayValue": map[string]interface{}{"values": values}}
+	default:
+		return map[string]interface{}{"stringValue": v.Emit()}
+	}
+}
+
+func otlpAttributes(attributes []attribute.KeyValue) []otlpKeyValue {
+	ret := make([]otlpKeyValue, len(attributes))
+	for i, kv := range attributes {
+		ret[i] = otlpKeyValue{Key: string(kv.Key), Value: otlpValue(kv.Value)}
+	}
+	return ret
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func newOTLPSpan(s sdktrace.ReadOnlySpan) otlpSpan {
+	sc := s.SpanContext()
+	ret := otlpSpan{
+		TraceID:           sc.TraceID().String(),
+		SpanID:            sc.SpanID().String(),
+		TraceState:        sc.TraceState().String(),
+		Name:              s.Name(),
+		Kind:              int(s.SpanKind()),
+		StartTimeUnixNano: unixNano(s.StartTime()),
+		EndTimeUnixNano:   unixNano(s.EndTime()),
+		Attributes:        otlpAttributes(s.Attributes()),
+	}
+	if s.Parent().HasSpanID() {
+		ret.ParentSpanID = s.Parent().SpanID().String()
+	}
+	for _, e := range s.Events() {
+		ret.Events = append(ret.Events, otlpEvent{
+			TimeUnixNano: unixNano(e.Time),
+			Name:         e.Name,
+			Attributes:   otlpAttributes(e.Attributes),
+		})
+	}
+	switch s.Status().Code {
+	case codes.Ok:
+		ret.Status = &otlpStatus{Code: otlpStatusCodeOk}
+	case codes.Error:
+		ret.Status = &otlpStatus{Code: otlpStatusCodeError, Message: s.Status().Description}
+	}
+	return ret
+}
+
+// Spans from a tracer provider share its resource, but are grouped by the library that recorded them.
+func newOTLPRequest(spans []sdktrace.ReadOnlySpan) *otlpRequest {
+	if len(spans) == 0 {
+		return &otlpRequest{}
+	}
+
+	var scopes []otlpScopeSpans
+	index := make(map[instrumentation.Scope]int)
+	for _, s := range spans {
+		scope := s.InstrumentationScope()
+		i, ok := index[scope]
+		if !ok {
+			i = len(scopes)
+			index[scope] = i
+			scopes = append(scopes, otlpScopeSpans{
+				Scope:     otlpScope{Name: scope.Name, Version: scope.Version},
+				SchemaURL: scope.SchemaURL,
+			})
+		}
+		scopes[i].Spans = append(scopes[i].Spans, newOTLPSpan(s))
+	}
+
+	res := spans[0].Resource()
+	return &otlpRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource:   otlpResource{Attributes: otlpAttributes(res.Attributes())},
+			ScopeSpans: scopes,
+			SchemaURL:  res.SchemaURL(),
+		}},
+	}
+}
+
+func (e *otlpExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	body, err := json.Marshal(newOTLPRequest(spans))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range e.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("collector returned '%s': %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	return nil
+}
+
+func (e *otlpExporter) Shutdown(context.Context) error {
+	e.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,139 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+)
+
+func testSpans() []sdktrace.ReadOnlySpan {
+	traceState, _ := trace.ParseTraceState("vendor=value")
+	return tracetest.SpanStubs{{
+		Name: "clutch.k8s.v1.K8sAPI/DescribePod",
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1},
+			SpanID:     trace.SpanID{2},
+			TraceFlags: trace.FlagsSampled,
+			TraceState: traceState,
+		}),
+		Parent:     trace.NewSpanContext(trace.SpanContextConfig{TraceID: trace.TraceID{1}, SpanID: trace.SpanID{3}}),
+		SpanKind:   trace.SpanKindServer,
+		StartTime:  time.Unix(1, 0),
+		EndTime:    time.Unix(2, 0),
+		Attributes: []attribute.KeyValue{attribute.String("rpc.system", "grpc"), attribute.Int("rpc.grpc.status_code", 5)},
+		Events: []sdktrace.Event{{
+			Name:       "exception",
+			Time:       time.Unix(2, 0),
+			Attributes: []attribute.KeyValue{attribute.StringSlice("tags", []string{"a", "b"})},
+		}},
+		Status:                 sdktrace.Status{Code: codes.Error, Description: "boom"},
+		Resource:               resource.NewSchemaless(attribute.String("service.name", "my-clutch")),
+		InstrumentationLibrary: instrumentation.Scope{Name: InstrumentationName},
+	}}.Snapshots()
+}
+
+func TestFileExporter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clutch-tracing")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "spans.json")
+	e, err := NewExporter(&gatewayv1.Tracing{
+		Exporter: &gatewayv1.Tracing_FileExporter_{FileExporter: &gatewayv1.Tracing_FileExporter{Path: path}},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, e.ExportSpans(context.Background(), testSpans()))
+	assert.NoError(t, e.Shutdown(context.Background()))
+
+	contents, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(contents), `"Name":"clutch.k8s.v1.K8sAPI/DescribePod"`)
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var body map[string]interface{}
+	var header http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		header = r.Header
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+	}))
+	defer srv.Close()
+
+	e, err := NewExporter(&gatewayv1.Tracing{
+		Exporter: &gatewayv1.Tracing_OtlpExporter{OtlpExporter: &gatewayv1.Tracing_OTLPExporter{
+			Endpoint: srv.URL + "/",
+			Headers:  map[string]string{"Authorization": "secret"},
+		}},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, e.ExportSpans(context.Background(), testSpans()))
+	assert.NoError(t, e.Shutdown(context.Background()))
+
+	assert.Equal(t, "application/json", header.Get("Content-Type"))
+	assert.Equal(t, "secret", header.Get("Authorization"))
+
+	expected := `{
+  "resourceSpans": [{
+    "resource": {"attributes": [{"key": "service.name", "value": {"stringValue": "my-clutch"}}]},
+    "scopeSpans": [{
+      "scope": {"name": "github.com/lyft/clutch/backend/tracing"},
+      "spans": [{
+        "traceId": "01000000000000000000000000000000",
+        "spanId": "0200000000000000",
+        "traceState": "vendor=value",
+        "parentSpanId": "0300000000000000",
+        "name": "clutch.k8s.v1.K8sAPI/DescribePod",
+        "kind": 2,
+        "startTimeUnixNano": "1000000000",
+        "endTimeUnixNano": "2000000000",
+        "attributes": [
+          {"key": "rpc.system", "value": {"stringValue": "grpc"}},
+          {"key": "rpc.grpc.status_code", "value": {"intValue": "5"}}
+        ],
+        "events": [{
+          "timeUnixNano": "2000000000",
+          "name": "exception",
+          "attributes": [{"key": "tags", "value": {"arrayValue": {"values": [{"stringValue": "a"}, {"stringValue": "b"}]}}}]
+        }],
+        "status": {"code": 2, "message": "boom"}
+      }]
+    }]
+  }]
+}`
+	actual, err := json.Marshal(body)
+	assert.NoError(t, err)
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func TestOTLPExporterError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	e, err := NewExporter(&gatewayv1.Tracing{
+		Exporter: &gatewayv1.Tracing_OtlpExporter{OtlpExporter: &gatewayv1.Tracing_OTLPExporter{Endpoint: srv.URL}},
+	})
+	assert.NoError(t, err)
+	err = e.ExportSpans(context.Background(), testSpans())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unavailable")
+}
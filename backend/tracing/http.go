@@ -0,0 +1,59 @@
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type transport struct {
+	base http.RoundTripper
+}
+
+// NewTransport wraps an HTTP transport so that a client span is recorded for each request and the span context is
+// propagated to the server. If base is nil, http.DefaultTransport is used.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := Start(req.Context(), fmt.Sprintf("HTTP %s", req.Method),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.HTTPRequestMethodKey.String(req.Method), semconv.URLFull(redactedURL(req))),
+	)
+	defer span.End()
+	if !span.SpanContext().IsValid() {
+		return t.base.RoundTrip(req)
+	}
+
+	// Round trippers must not modify the original request.
+	req = req.Clone(ctx)
+	Propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		SetError(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	if resp.StatusCode >= 500 {
+		SetError(span, fmt.Errorf("received status '%s'", resp.Status))
+	}
+	return resp, nil
+}
+
+// The query string and credentials are left out of the span since they may contain secrets.
+func redactedURL(req *http.Request) string {
+	u := *req.URL
+	u.User = nil
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
@@ -0,0 +1,57 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func TestTransport(t *testing.T) {
+	var traceParent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceParent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: NewTransport(nil)}
+
+	// Requests are passed through untouched when tracing is disabled.
+	SetTracer(nil)
+	resp, err := client.Get(srv.URL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Empty(t, traceParent)
+
+	tracer, exporter := newTestTracer(t, 1)
+	SetTracer(tracer)
+	defer SetTracer(nil)
+
+	ctx, parent := Start(context.Background(), "parent")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/clusters?secret=foo", nil)
+	assert.NoError(t, err)
+	resp, err = client.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	parent.End()
+
+	// The original request is not modified.
+	assert.Empty(t, req.Header.Get("traceparent"))
+
+	assert.NoError(t, tracer.Stop(context.Background()))
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+	span := spans[0]
+	assert.Equal(t, "HTTP GET", span.Name)
+	assert.Equal(t, parent.SpanContext().SpanID(), span.Parent.SpanID())
+	assert.Equal(t, fmt.Sprintf("00-%s-%s-01", span.SpanContext.TraceID(), span.SpanContext.SpanID()), traceParent)
+	assert.Contains(t, span.Attributes, attribute.String("url.full", srv.URL+"/clusters"))
+	assert.Contains(t, span.Attributes, attribute.Int("http.response.status_code", 500))
+	assert.Equal(t, codes.Error, span.Status.Code)
+}
@@ -0,0 +1,102 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber-go/tally"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+)
+
+const (
+	DefaultServiceName = "clutch"
+
+	queueSize      = 2048
+	maxBatchSize   = 512
+	exportInterval = time.Second * 5
+)
+
+// Tracer owns the tracer provider for the gateway. Spans are exported in batches in the background and are dropped
+// rather than blocking requests if the exporter can't keep up.
+type Tracer struct {
+	provider *sdktrace.TracerProvider
+}
+
+func New(config *gatewayv1.Tracing, logger *zap.Logger, scope tally.Scope) (*Tracer, error) {
+	exporter, err := NewExporter(config)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceName := config.ServiceName
+	if serviceName == "" {
+		serviceName = DefaultServiceName
+	}
+	ratio := 1.0
+	if config.SampleRatio != nil {
+		ratio = config.SampleRatio.Value
+	}
+	return NewWithExporter(exporter, serviceName, ratio, logger, scope), nil
+}
+
+// NewWithExporter creates a tracer that samples new traces by trace ID at the given ratio and otherwise follows the
+// caller's sampling decision.
+func NewWithExporter(exporter sdktrace.SpanExporter, serviceName string, sampleRatio float64, logger *zap.Logger, scope tally.Scope) *Tracer {
+	exporter = &instrumentedExporter{
+		SpanExporter: exporter,
+		logger:       logger,
+		exported:     scope.Counter("spans_exported"),
+		failures:     scope.Counter("export_failure"),
+	}
+
+	return &Tracer{
+		provider: sdktrace.NewTracerProvider(
+			sdktrace.WithBatcher(exporter,
+				sdktrace.WithMaxQueueSize(queueSize),
+				sdktrace.WithMaxExportBatchSize(maxBatchSize),
+				sdktrace.WithBatchTimeout(exportInterval),
+			),
+			sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+			sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))),
+		),
+	}
+}
+
+// StartSpan begins a span using the tracer's provider rather than the global one.
+func (t *Tracer) StartSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return t.provider.Tracer(InstrumentationName).Start(ctx, name, opts...)
+}
+
+// Start is a no-op since spans are exported from the time the tracer is created.
+func (t *Tracer) Start(context.Context) error { return nil }
+
+// Stop exports any queued spans and shuts down the exporter. The tracer is stopped after all other components since it
+// is instantiated first, so spans from their shutdown are included.
+func (t *Tracer) Stop(ctx context.Context) error {
+	return t.provider.Shutdown(ctx)
+}
+
+// instrumentedExporter reports export results, which the SDK would otherwise only pass to the global error handler.
+type instrumentedExporter struct {
+	sdktrace.SpanExporter
+	logger *zap.Logger
+
+	exported tally.Counter
+	failures tally.Counter
+}
+
+func (e *instrumentedExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if err := e.SpanExporter.ExportSpans(ctx, spans); err != nil {
+		e.failures.Inc(1)
+		e.logger.Warn("failed to export spans", zap.Int("count", len(spans)), zap.Error(err))
+		return err
+	}
+	e.exported.Inc(int64(len(spans)))
+	return nil
+}
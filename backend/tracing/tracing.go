@@ -0,0 +1,46 @@
+// Package tracing records spans for RPCs served by the gateway and the outbound calls made by services while handling
+// them, using the OpenTelemetry SDK. Span context is propagated using the W3C Trace Context format, so traces can be
+// joined with those of callers and downstream systems instrumented with OpenTelemetry.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// InstrumentationName identifies spans recorded by the gateway and built-in services.
+const InstrumentationName = "github.com/lyft/clutch/backend/tracing"
+
+// Propagator reads and writes the span context using the W3C traceparent and tracestate headers.
+var Propagator propagation.TextMapPropagator = propagation.TraceContext{}
+
+// SetTracer installs the tracer's provider and the propagator as the OpenTelemetry globals, so that spans started with
+// Start and by third-party instrumentation are recorded. Passing nil disables tracing.
+func SetTracer(t *Tracer) {
+	if t == nil {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		return
+	}
+	otel.SetTracerProvider(t.provider)
+	otel.SetTextMapPropagator(Propagator)
+}
+
+// Start begins a span as a child of the span in the context, using the global tracer provider. If tracing is disabled,
+// the span is a no-op that still carries the span context from the parent, if any.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(InstrumentationName).Start(ctx, name, opts...)
+}
+
+// SetError records the error on the span and marks it as failed. A nil error is ignored.
+func SetError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
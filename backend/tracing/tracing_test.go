@@ -0,0 +1,128 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap/zaptest"
+)
+
+// memoryExporter keeps spans after the tracer is stopped, unlike the in-memory exporter it wraps.
+type memoryExporter struct {
+	*tracetest.InMemoryExporter
+}
+
+func (e *memoryExporter) Shutdown(context.Context) error { return nil }
+
+func newTestTracer(t *testing.T, ratio float64) (*Tracer, *memoryExporter) {
+	exporter := &memoryExporter{InMemoryExporter: tracetest.NewInMemoryExporter()}
+	tracer := NewWithExporter(exporter, "clutch", ratio, zaptest.NewLogger(t), tally.NoopScope)
+	assert.NoError(t, tracer.Start(context.Background()))
+	return tracer, exporter
+}
+
+// Returns a context carrying the remote span context in the headers.
+func remoteContext(t *testing.T, header map[string]string) context.Context {
+	ctx := Propagator.Extract(context.Background(), propagation.MapCarrier(header))
+	assert.True(t, trace.SpanContextFromContext(ctx).IsRemote())
+	return ctx
+}
+
+func TestStartDisabled(t *testing.T) {
+	SetTracer(nil)
+
+	_, span := Start(context.Background(), "noop")
+	assert.False(t, span.IsRecording())
+	assert.False(t, span.SpanContext().IsValid())
+
+	// The caller's span context is kept so that it can still be propagated.
+	ctx := remoteContext(t, map[string]string{"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"})
+	_, span = Start(ctx, "noop")
+	assert.False(t, span.IsRecording())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+}
+
+func TestSpanHierarchy(t *testing.T) {
+	tracer, exporter := newTestTracer(t, 1)
+
+	ctx := remoteContext(t, map[string]string{
+		"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"tracestate":  "vendor=value",
+	})
+
+	ctx, parent := tracer.StartSpan(ctx, "parent", trace.WithSpanKind(trace.SpanKindServer))
+	_, child := tracer.StartSpan(ctx, "child")
+	child.SetAttributes(attribute.String("foo", "bar"))
+	SetError(child, errors.New("boom"))
+	child.End()
+	parent.End()
+
+	assert.NoError(t, tracer.Stop(context.Background()))
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+
+	c, p := spans[0], spans[1]
+	assert.Equal(t, "child", c.Name)
+	assert.Equal(t, trace.SpanKindInternal, c.SpanKind)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("foo", "bar")}, c.Attributes)
+	assert.Equal(t, codes.Error, c.Status.Code)
+	assert.Equal(t, "boom", c.Status.Description)
+	assert.Len(t, c.Events, 1)
+	assert.Equal(t, p.SpanContext.SpanID(), c.Parent.SpanID())
+
+	assert.Equal(t, "parent", p.Name)
+	assert.Equal(t, trace.SpanKindServer, p.SpanKind)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", p.SpanContext.TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", p.Parent.SpanID().String())
+	assert.Equal(t, p.SpanContext.TraceID(), c.SpanContext.TraceID())
+
+	// The caller's trace state is carried by all spans in the trace.
+	assert.Equal(t, "vendor=value", c.SpanContext.TraceState().String())
+	assert.Contains(t, p.Resource.Attributes(), attribute.String("service.name", "clutch"))
+}
+
+func TestSampling(t *testing.T) {
+	tracer, exporter := newTestTracer(t, 0)
+
+	// New traces are not sampled, but span context is still available for propagation.
+	ctx, span := tracer.StartSpan(context.Background(), "unsampled")
+	assert.True(t, span.SpanContext().IsValid())
+	assert.False(t, span.SpanContext().IsSampled())
+	_, child := tracer.StartSpan(ctx, "child")
+	assert.Equal(t, span.SpanContext().TraceID(), child.SpanContext().TraceID())
+	child.End()
+	span.End()
+
+	// The caller's sampling decision is honored.
+	ctx = remoteContext(t, map[string]string{"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"})
+	_, span = tracer.StartSpan(ctx, "sampled")
+	assert.True(t, span.SpanContext().IsSampled())
+	span.End()
+
+	assert.NoError(t, tracer.Stop(context.Background()))
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "sampled", spans[0].Name)
+}
+
+func TestSetTracer(t *testing.T) {
+	tracer, exporter := newTestTracer(t, 1)
+	SetTracer(tracer)
+	defer SetTracer(nil)
+
+	ctx, span := Start(context.Background(), "global")
+	assert.True(t, span.IsRecording())
+	assert.Equal(t, span, trace.SpanFromContext(ctx))
+	span.End()
+
+	assert.NoError(t, tracer.Stop(context.Background()))
+	assert.Len(t, exporter.GetSpans(), 1)
+}
@@ -0,0 +1,24 @@
+// Package version reports the version of the running gateway.
+package version
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// Version is set at build time, e.g. with -ldflags "-X github.com/lyft/clutch/backend/version.Version=1.0.0".
+var Version = "dev"
+
+// ModuleVersion returns the version of the main module, e.g. the custom gateway's module, or an empty string if the
+// binary was not built with module support.
+func ModuleVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	return info.Main.Version
+}
+
+func GoVersion() string {
+	return runtime.Version()
+}
@@ -13,6 +13,7 @@ func main() {
 	flags := gateway.ParseFlags()
 	components := gateway.CoreComponentFactory
 	components.Modules[amiibomod.Name] = amiibomod.New
+	components.Dependencies[amiibomod.Name] = amiibomod.Dependencies
 	components.Services[amiiboservice.Name] = amiiboservice.New
 	gateway.Run(flags, components, assets.VirtualFS)
 }
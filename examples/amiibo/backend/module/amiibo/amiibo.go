@@ -18,8 +18,10 @@ import (
 
 const Name = "clutch.module.amiibo"
 
+var Dependencies = service.Requires("clutch.service.amiibo")
+
 func New(*any.Any, *zap.Logger, tally.Scope) (module.Module, error) {
-	svc, ok := service.Registry["clutch.service.amiibo"]
+	svc, ok := service.Registry.Get("clutch.service.amiibo")
 	if !ok {
 		return nil, errors.New("no amiibo service was registered")
 	}
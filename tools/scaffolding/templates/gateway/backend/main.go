@@ -2,6 +2,7 @@ package main
 
 import (
 	"github.com/lyft/clutch/backend/gateway"
+	"github.com/lyft/clutch/backend/module"
 
 	"{{ .RepoProvider}}/{{ .RepoOwner }}/{{ .RepoName }}/backend/cmd/assets"
 	"{{ .RepoProvider}}/{{ .RepoOwner }}/{{ .RepoName }}/backend/module/echo"
@@ -13,7 +14,7 @@ func main() {
 	components := gateway.CoreComponentFactory
 
 	// Add custom components.
-	components.Modules[echo.Name] = echo.New
+	components.Modules[echo.Name] = module.Registration{New: echo.New}
 
 	gateway.Run(flags, components, assets.VirtualFS)
 }